@@ -103,6 +103,7 @@ import (
 	// PROVENANCE
 	appparams "github.com/provenance-io/provenance/app/params"
 	"github.com/provenance-io/provenance/x/marker"
+	markeribc "github.com/provenance-io/provenance/x/marker/ibc"
 	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
 	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	markerwasm "github.com/provenance-io/provenance/x/marker/wasm"
@@ -113,6 +114,7 @@ import (
 	attributewasm "github.com/provenance-io/provenance/x/attribute/wasm"
 
 	"github.com/provenance-io/provenance/x/name"
+	nameclient "github.com/provenance-io/provenance/x/name/client"
 	namekeeper "github.com/provenance-io/provenance/x/name/keeper"
 	nametypes "github.com/provenance-io/provenance/x/name/types"
 	namewasm "github.com/provenance-io/provenance/x/name/wasm"
@@ -166,6 +168,7 @@ var (
 			upgradeclient.CancelProposalHandler,
 			ibcclientclient.UpdateClientProposalHandler,
 			ibcclientclient.UpgradeProposalHandler,
+			nameclient.ModifyNameProposalHandler,
 		)...,
 		),
 		params.AppModuleBasic{},
@@ -228,6 +231,7 @@ type App struct {
 	legacyAmino       *codec.LegacyAmino
 	appCodec          codec.Codec
 	interfaceRegistry types.InterfaceRegistry
+	txConfig          client.TxConfig
 
 	invCheckPeriod uint
 
@@ -327,6 +331,7 @@ func New(
 		legacyAmino:       legacyAmino,
 		appCodec:          appCodec,
 		interfaceRegistry: interfaceRegistry,
+		txConfig:          encodingConfig.TxConfig,
 		invCheckPeriod:    invCheckPeriod,
 		keys:              keys,
 		tkeys:             tkeys,
@@ -357,9 +362,11 @@ func New(
 		appCodec, keys[authtypes.StoreKey], app.GetSubspace(authtypes.ModuleName), authtypes.ProtoBaseAccount, maccPerms,
 	)
 
-	app.BankKeeper = bankkeeper.NewBaseKeeper(
+	baseBankKeeper := bankkeeper.NewBaseKeeper(
 		appCodec, keys[banktypes.StoreKey], app.AccountKeeper, app.GetSubspace(banktypes.ModuleName), app.ModuleAccountAddrs(),
 	)
+	// the bank keeper is wrapped so the marker module can maintain its index of marker holders.
+	app.BankKeeper = markerkeeper.NewMarkerBankKeeper(baseBankKeeper, keys[markertypes.StoreKey], appCodec)
 	stakingKeeper := stakingkeeper.NewKeeper(
 		appCodec, keys[stakingtypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName),
 	)
@@ -395,12 +402,8 @@ func New(
 		appCodec, keys[metadatatypes.StoreKey], app.GetSubspace(metadatatypes.ModuleName), app.AccountKeeper, app.AuthzKeeper,
 	)
 
-	app.MarkerKeeper = markerkeeper.NewKeeper(
-		appCodec, keys[markertypes.StoreKey], app.GetSubspace(markertypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.AuthzKeeper, keys[banktypes.StoreKey],
-	)
-
 	app.NameKeeper = namekeeper.NewKeeper(
-		appCodec, keys[nametypes.StoreKey], app.GetSubspace(nametypes.ModuleName),
+		appCodec, keys[nametypes.StoreKey], app.GetSubspace(nametypes.ModuleName), app.BankKeeper, app.MetadataKeeper,
 	)
 
 	app.AttributeKeeper = attributekeeper.NewKeeper(
@@ -419,6 +422,15 @@ func New(
 		app.AccountKeeper, app.BankKeeper, scopedTransferKeeper,
 	)
 
+	app.MarkerKeeper = markerkeeper.NewKeeper(
+		appCodec, keys[markertypes.StoreKey], app.GetSubspace(markertypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.AuthzKeeper, app.AttributeKeeper, keys[banktypes.StoreKey],
+		app.TransferKeeper,
+	)
+	// The wasm keeper is created below, the marker keeper only uses it while processing transactions.
+	app.MarkerKeeper.SetTransferRestrictionHooks(markerwasm.NewTransferRestrictionHook(&app.WasmKeeper))
+	// The name keeper checks marker targets with the marker keeper, which is created after it.
+	app.NameKeeper.SetMarkerKeeper(app.MarkerKeeper)
+
 	// Init CosmWasm module
 	var wasmRouter = bApp.Router()
 	wasmDir := filepath.Join(homePath, "data", "wasm")
@@ -485,7 +497,8 @@ func New(
 		&stakingKeeper, govRouter,
 	)
 
-	transferModule := transfer.NewAppModule(app.TransferKeeper)
+	// The transfer module is wrapped so that restricted marker coin can only be sent over ibc with transfer access.
+	transferModule := markeribc.NewTransferMiddleware(app.MarkerKeeper, app.TransferKeeper)
 
 	// Create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
@@ -516,7 +529,7 @@ func New(
 		),
 		auth.NewAppModule(appCodec, app.AccountKeeper, nil),
 		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
-		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		newBankModule(appCodec, app.BankKeeper, baseBankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
@@ -562,6 +575,8 @@ func New(
 		crisistypes.ModuleName,
 		govtypes.ModuleName,
 		stakingtypes.ModuleName,
+		markertypes.ModuleName,
+		nametypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -748,6 +763,41 @@ func (app *App) InterfaceRegistry() types.InterfaceRegistry {
 	return app.interfaceRegistry
 }
 
+// GetBaseApp returns the base application of the App.
+//
+// NOTE: This is solely to be used for testing purposes (ibc-go testing package).
+func (app *App) GetBaseApp() *baseapp.BaseApp {
+	return app.BaseApp
+}
+
+// GetStakingKeeper returns the staking keeper of the App.
+//
+// NOTE: This is solely to be used for testing purposes (ibc-go testing package).
+func (app *App) GetStakingKeeper() stakingkeeper.Keeper {
+	return app.StakingKeeper
+}
+
+// GetIBCKeeper returns the IBC keeper of the App.
+//
+// NOTE: This is solely to be used for testing purposes (ibc-go testing package).
+func (app *App) GetIBCKeeper() *ibckeeper.Keeper {
+	return app.IBCKeeper
+}
+
+// GetScopedIBCKeeper returns the scoped IBC keeper of the App.
+//
+// NOTE: This is solely to be used for testing purposes (ibc-go testing package).
+func (app *App) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper {
+	return app.ScopedIBCKeeper
+}
+
+// GetTxConfig returns the TxConfig the App was created with.
+//
+// NOTE: This is solely to be used for testing purposes (ibc-go testing package).
+func (app *App) GetTxConfig() client.TxConfig {
+	return app.txConfig
+}
+
 // GetKey returns the KVStoreKey for the provided store key.
 //
 // NOTE: This is solely to be used for testing purposes.
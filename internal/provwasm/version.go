@@ -0,0 +1,80 @@
+// Package provwasm allows CosmWasm smart contracts to communicate with custom provenance modules.
+package provwasm
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Version is the semantic data format version of the provenance rust bindings sent with each request.
+type Version struct {
+	Major uint64
+	Minor uint64
+	Patch uint64
+}
+
+// ParseVersion parses a version in the form major.minor.patch.  Requests made by contracts built against bindings that
+// do not send a version have an empty version, it is treated as 0.0.0.
+func ParseVersion(version string) (Version, error) {
+	v := Version{}
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	// pre-release and build metadata do not change the data format.
+	if i := strings.IndexAny(version, "-+"); i >= 0 {
+		version = version[:i]
+	}
+	if version == "" {
+		return v, nil
+	}
+	parts := strings.Split(version, ".")
+	if len(parts) > 3 {
+		return v, fmt.Errorf("wasm: invalid version %q", version)
+	}
+	fields := []*uint64{&v.Major, &v.Minor, &v.Patch}
+	for i, part := range parts {
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return v, fmt.Errorf("wasm: invalid version %q: %w", version, err)
+		}
+		*fields[i] = n
+	}
+	return v, nil
+}
+
+// MustParseVersion parses a version in the form major.minor.patch, panicking on error.
+func MustParseVersion(version string) Version {
+	v, err := ParseVersion(version)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// LessThan returns true if this version is before the other version.
+func (v Version) LessThan(other Version) bool {
+	if v.Major != other.Major {
+		return v.Major < other.Major
+	}
+	if v.Minor != other.Minor {
+		return v.Minor < other.Minor
+	}
+	return v.Patch < other.Patch
+}
+
+// String returns the version in the form major.minor.patch.
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
+
+// RequireVersion returns an error if the version sent with a request is before the version that introduced the
+// requested message or query.
+func RequireVersion(version string, required Version, request string) error {
+	v, err := ParseVersion(version)
+	if err != nil {
+		return err
+	}
+	if v.LessThan(required) {
+		return fmt.Errorf("wasm: %s requires bindings version %s or later, got %q", request, required, version)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package provwasm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVersion(t *testing.T) {
+	cases := []struct {
+		name    string
+		version string
+		want    Version
+		wantErr string
+	}{
+		{"empty", "", Version{}, ""},
+		{"whitespace", "  ", Version{}, ""},
+		{"full", "1.2.3", Version{1, 2, 3}, ""},
+		{"v prefix", "v2.0.1", Version{2, 0, 1}, ""},
+		{"major only", "2", Version{2, 0, 0}, ""},
+		{"major minor", "1.4", Version{1, 4, 0}, ""},
+		{"pre-release", "2.0.0-rc1", Version{2, 0, 0}, ""},
+		{"build metadata", "v1.1.0+abc", Version{1, 1, 0}, ""},
+		{"too many parts", "1.2.3.4", Version{}, `wasm: invalid version "1.2.3.4"`},
+		{"not a number", "1.x.0", Version{}, `wasm: invalid version "1.x.0"`},
+		{"empty part", "1..0", Version{}, `wasm: invalid version "1..0"`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			v, err := ParseVersion(tc.version)
+			if tc.wantErr != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, v)
+		})
+	}
+}
+
+func TestMustParseVersion(t *testing.T) {
+	require.Equal(t, Version{2, 0, 0}, MustParseVersion("2.0.0"))
+	require.Panics(t, func() { MustParseVersion("1.2.3.4") })
+}
+
+func TestVersionLessThan(t *testing.T) {
+	cases := []struct {
+		name  string
+		v     string
+		other string
+		want  bool
+	}{
+		{"equal", "1.2.3", "1.2.3", false},
+		{"empty before 1.0.0", "", "1.0.0", true},
+		{"empty equals 0.0.0", "", "0.0.0", false},
+		{"major before", "1.9.9", "2.0.0", true},
+		{"major after", "2.0.0", "1.9.9", false},
+		{"minor before", "1.1.9", "1.2.0", true},
+		{"minor after", "1.2.0", "1.1.9", false},
+		{"patch before", "1.2.2", "1.2.3", true},
+		{"patch after", "1.2.3", "1.2.2", false},
+		{"pre-release is not before release", "2.0.0-rc1", "2.0.0", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, MustParseVersion(tc.v).LessThan(MustParseVersion(tc.other)))
+		})
+	}
+}
+
+func TestRequireVersion(t *testing.T) {
+	v2 := MustParseVersion("2.0.0")
+	cases := []struct {
+		name    string
+		version string
+		wantErr string
+	}{
+		{"empty version", "", `wasm: freeze_account requires bindings version 2.0.0 or later, got ""`},
+		{"1.x version", "1.5.2", `wasm: freeze_account requires bindings version 2.0.0 or later, got "1.5.2"`},
+		{"v prefixed 1.x version", "v1.0.0", `wasm: freeze_account requires bindings version 2.0.0 or later, got "v1.0.0"`},
+		{"required version", "2.0.0", ""},
+		{"v prefixed required version", "v2.0.0", ""},
+		{"pre-release of required version", "2.0.0-beta.1", ""},
+		{"later version", "2.3.0", ""},
+		{"later major version", "3.0.0", ""},
+		{"too many parts", "2.0.0.1", `wasm: invalid version "2.0.0.1"`},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := RequireVersion(tc.version, v2, "freeze_account")
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -17,9 +17,17 @@ import (
 // BeginBlocker returns the begin blocker for the marker module.
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper, bk bankkeeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
-	// Iterate through all marker accounts and check for supply above or below expected targets.
-	var err error
-	k.IterateMarkers(ctx, func(record types.MarkerAccountI) bool {
+	// Remove the access grants that have expired.
+	k.RemoveExpiredGrants(ctx)
+
+	// Check the markers queued by a change in supply or status for supply above or below expected targets.
+	for _, addr := range k.GetQueuedSupplyChecks(ctx) {
+		record, err := k.GetMarker(ctx, addr)
+		if err != nil || record == nil {
+			// the queued marker is no longer present so there is nothing to check.
+			k.DequeueSupplyCheck(ctx, addr)
+			continue
+		}
 		// Supply checks are only done against active markers with a fixed supply.
 		if record.GetStatus() == types.StatusActive && record.HasFixedSupply() {
 			requiredSupply := record.GetSupply()
@@ -30,7 +38,13 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper,
 				ctx.Logger().Error(
 					fmt.Sprintf("Current %s supply is NOT at the required amount, adjusting %s to required supply level",
 						record.GetDenom(), currentSupply))
-				err = k.AdjustCirculation(ctx, record, requiredSupply)
+				// We have no way of dealing with this and the invariant will fail soon from mismatch halting the chain.
+				if err = k.AdjustCirculation(ctx, record, requiredSupply); err != nil {
+					panic(err)
+				}
+				// keep a record of the adjustment so the drift can be seen with the supply report query.
+				k.SetSupplyAdjustment(ctx, types.NewMarkerSupplyAdjustment(
+					record.GetDenom(), currentSupply.Amount, requiredSupply.Amount, ctx.BlockHeight(), ctx.BlockTime()))
 			}
 			// else supply is equal, nothing to do here.
 		}
@@ -46,10 +60,16 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k keeper.Keeper,
 				),
 			)
 		}
-		return err != nil
-	})
-	// We have no way of dealing with this and the invariant will fail soon from mismatch halting the chain.
-	if err != nil {
-		panic(err)
+		// adjustments made above queue the marker again, it has been checked so remove it.
+		k.DequeueSupplyCheck(ctx, addr)
 	}
 }
+
+// EndBlocker returns the end blocker for the marker module.
+func EndBlocker(ctx sdk.Context, req abci.RequestEndBlock, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+	// Pay out a batch of holders for the distributions of escrowed coin that are in progress.
+	k.ProcessDistributions(ctx, types.MaxDistributionHoldersPerBlock)
+	// Release the scheduled withdrawals of escrowed coin that are due.
+	k.ReleaseWithdrawSchedules(ctx, types.MaxWithdrawSchedulesPerBlock)
+}
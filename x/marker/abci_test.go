@@ -67,3 +67,52 @@ func TestBeginBlocker(t *testing.T) {
 	require.NoError(t, err)
 	require.Nil(t, deleted)
 }
+
+func TestBeginBlockerSupplyCheckQueue(t *testing.T) {
+	app := app.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	user := types.MustGetMarkerAddress("user")
+
+	testburn := types.NewEmptyMarkerAccount("testburn", user.String(), []types.AccessGrant{*types.NewAccessGrant(user,
+		[]types.Access{types.Access_Mint, types.Access_Withdraw})})
+	require.NoError(t, testburn.SetSupply(sdk.NewCoin("testburn", sdk.NewInt(100))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, testburn))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user, "testburn"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, user, "testburn"))
+	require.Equal(t, []sdk.AccAddress{testburn.GetAddress()}, app.MarkerKeeper.GetQueuedSupplyChecks(ctx))
+
+	marker.BeginBlocker(ctx, abci.RequestBeginBlock{}, app.MarkerKeeper, app.BankKeeper)
+	require.Empty(t, app.MarkerKeeper.GetQueuedSupplyChecks(ctx), "checked markers must be removed from the queue")
+	require.Equal(t, sdk.NewInt(100), app.BankKeeper.GetSupply(ctx, "testburn").Amount)
+
+	// Burning coin outside of the marker module (as in a slash) queues the marker for a supply check.
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, user, user, "testburn", sdk.NewCoins(sdk.NewInt64Coin("testburn", 10))))
+	require.NoError(t, app.BankKeeper.SendCoinsFromAccountToModule(ctx, user, types.CoinPoolName, sdk.NewCoins(sdk.NewInt64Coin("testburn", 10))))
+	marker.BeginBlocker(ctx, abci.RequestBeginBlock{}, app.MarkerKeeper, app.BankKeeper)
+	require.NoError(t, app.BankKeeper.BurnCoins(ctx, types.CoinPoolName, sdk.NewCoins(sdk.NewInt64Coin("testburn", 10))))
+	require.Equal(t, sdk.NewInt(90), app.BankKeeper.GetSupply(ctx, "testburn").Amount)
+	require.Equal(t, []sdk.AccAddress{testburn.GetAddress()}, app.MarkerKeeper.GetQueuedSupplyChecks(ctx))
+
+	// the drift is visible in the supply report until the marker is checked.
+	report := app.MarkerKeeper.GetSupplyReport(ctx, testburn)
+	require.Equal(t, sdk.NewInt(100), report.RequiredSupply)
+	require.Equal(t, sdk.NewInt(90), report.TotalSupply)
+	require.Nil(t, report.LastAdjustment)
+
+	ctx = ctx.WithBlockHeight(5)
+	marker.BeginBlocker(ctx, abci.RequestBeginBlock{}, app.MarkerKeeper, app.BankKeeper)
+	require.Equal(t, sdk.NewInt(100), app.BankKeeper.GetSupply(ctx, "testburn").Amount)
+	require.Empty(t, app.MarkerKeeper.GetQueuedSupplyChecks(ctx))
+
+	res, err := app.MarkerKeeper.SupplyReport(sdk.WrapSDKContext(ctx), &types.QuerySupplyReportRequest{Id: "testburn"})
+	require.NoError(t, err)
+	require.Len(t, res.Reports, 1)
+	report = res.Reports[0]
+	require.Equal(t, sdk.NewInt(100), report.TotalSupply)
+	require.Equal(t, sdk.NewInt(100), report.Escrow, "the adjustment is minted into the marker's escrow")
+	require.True(t, report.Circulation.IsZero())
+	require.NotNil(t, report.LastAdjustment)
+	require.Equal(t, sdk.NewInt(90), report.LastAdjustment.PreviousSupply)
+	require.Equal(t, sdk.NewInt(100), report.LastAdjustment.RequiredSupply)
+	require.Equal(t, int64(5), report.LastAdjustment.Height)
+}
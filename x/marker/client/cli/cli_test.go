@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"sort"
 	"strings"
 	"testing"
@@ -392,7 +393,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 			[]string{
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"max_total_supply":"1000000","enable_governance":true,"unrestricted_denom_regex":""}`,
+			`{"max_total_supply":"1000000","enable_governance":true,"unrestricted_denom_regex":"","enable_force_transfer":false}`,
 		},
 		{
 			"get testcoin marker json",
@@ -401,7 +402,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 				"testcoin",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos1p3sl9tll0ygj3flwt5r2w0n6fx9p5ngq2tu6mq","pub_key":null,"account_number":"11","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"testcoin","supply":"1000","marker_type":"MARKER_TYPE_COIN","supply_fixed":true,"allow_governance_control":false}}`,
+			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos1p3sl9tll0ygj3flwt5r2w0n6fx9p5ngq2tu6mq","pub_key":null,"account_number":"11","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"testcoin","supply":"1000","marker_type":"MARKER_TYPE_COIN","supply_fixed":true,"allow_governance_control":false,"required_attributes":[],"max_supply":"0","transfer_restriction_contract":"","ibc_enabled":false}}`,
 		},
 		{
 			"get testcoin marker test",
@@ -420,11 +421,15 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
     pub_key: null
     sequence: "0"
   denom: testcoin
+  ibc_enabled: false
   manager: ""
   marker_type: MARKER_TYPE_COIN
+  max_supply: "0"
+  required_attributes: []
   status: MARKER_STATUS_ACTIVE
   supply: "1000"
-  supply_fixed: true`,
+  supply_fixed: true
+  transfer_restriction_contract: ""`,
 		},
 		{
 			"query non existent marker",
@@ -441,7 +446,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 				"lockedcoin",
 				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
 			},
-			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos16437wt0xtqtuw0pn4vt8rlf8gr2plz2det0mt2","pub_key":null,"account_number":"12","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"lockedcoin","supply":"1000","marker_type":"MARKER_TYPE_RESTRICTED","supply_fixed":true,"allow_governance_control":false}}`,
+			`{"marker":{"@type":"/provenance.marker.v1.MarkerAccount","base_account":{"address":"cosmos16437wt0xtqtuw0pn4vt8rlf8gr2plz2det0mt2","pub_key":null,"account_number":"12","sequence":"0"},"manager":"","access_control":[],"status":"MARKER_STATUS_ACTIVE","denom":"lockedcoin","supply":"1000","marker_type":"MARKER_TYPE_RESTRICTED","supply_fixed":true,"allow_governance_control":false,"required_attributes":[],"max_supply":"0","transfer_restriction_contract":"","ibc_enabled":false}}`,
 		},
 		{
 			"query access",
@@ -467,6 +472,52 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 			},
 			fmt.Sprintf("amount:\n  amount: \"%s\"\n  denom: %s", s.cfg.BondedTokens.Mul(sdk.NewInt(int64(s.cfg.NumValidators))), s.cfg.BondDenom),
 		},
+		{
+			"query frozen accounts",
+			markercli.FrozenAccountsCmd(),
+			[]string{
+				"lockedcoin",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			`{"addresses":[],"pagination":{"next_key":null,"total":"0"}}`,
+		},
+		{
+			"query markers by grantee",
+			markercli.MarkersByGranteeCmd(),
+			[]string{
+				s.accountAddresses[3].String(),
+				"admin",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			`{"markers":[],"pagination":{"next_key":null,"total":"0"}}`,
+		},
+		{
+			"query marker history",
+			markercli.MarkerHistoryCmd(),
+			[]string{
+				"testcoin",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			`{"entries":[],"pagination":{"next_key":null,"total":"0"}}`,
+		},
+		{
+			"query supply report",
+			markercli.SupplyReportCmd(),
+			[]string{
+				s.holderDenom,
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			`{"reports":[{"denom":"hodlercoin","status":"MARKER_STATUS_ACTIVE","supply_fixed":false,"required_supply":"3000","total_supply":"1158","escrow":"0","circulation":"1158","last_adjustment":null}],"pagination":null}`,
+		},
+		{
+			"query withdraw schedules",
+			markercli.WithdrawSchedulesCmd(),
+			[]string{
+				"testcoin",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			`{"schedules":[],"pagination":{"next_key":null,"total":"0"}}`,
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -520,6 +571,87 @@ func (s *IntegrationTestSuite) TestMarkerTxCommands() {
 			},
 			false, &sdk.TxResponse{}, 0,
 		},
+		{
+			"create a new restricted marker with required attributes",
+			markercli.GetCmdAddMarker(),
+			[]string{
+				"1000kyccoin",
+				fmt.Sprintf("--%s=%s", markercli.FlagType, "RESTRICTED"),
+				fmt.Sprintf("--%s=%s", markercli.FlagRequiredAttributes, "kyc.provenance.io,accredited.provenance.io"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"fail to create a coin marker with required attributes",
+			markercli.GetCmdAddMarker(),
+			[]string{
+				"1000kycplaincoin",
+				fmt.Sprintf("--%s=%s", markercli.FlagType, "COIN"),
+				fmt.Sprintf("--%s=%s", markercli.FlagRequiredAttributes, "kyc.provenance.io"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"create, finalize, and activate a marker, fail to parse access grants",
+			markercli.GetCmdAddFinalizeActivateMarker(),
+			[]string{
+				"1000activecoin",
+				fmt.Sprintf("%s;mint", s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"create, finalize, and activate a marker",
+			markercli.GetCmdAddFinalizeActivateMarker(),
+			[]string{
+				"1000activecoin",
+				fmt.Sprintf("%s,mint,admin", s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=%s", markercli.FlagSupplyFixed, "true"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"create a new marker with a max supply",
+			markercli.GetCmdAddMarker(),
+			[]string{
+				"1000cappedcoin",
+				fmt.Sprintf("--%s=%s", markercli.FlagMaxSupply, "5000"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"fail to create a marker with supply above its max supply",
+			markercli.GetCmdAddMarker(),
+			[]string{
+				"1000overcappedcoin",
+				fmt.Sprintf("--%s=%s", markercli.FlagMaxSupply, "500"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
 		{
 			"fail to create add marker, incorrect allow governance value",
 			markercli.GetCmdAddMarker(),
@@ -578,6 +710,96 @@ func (s *IntegrationTestSuite) TestMarkerTxCommands() {
 			},
 			false, &sdk.TxResponse{}, 0,
 		},
+		{
+			"set transfer restriction contract",
+			markercli.GetCmdSetTransferRestrictionContract(),
+			[]string{
+				"hotdog",
+				s.accountAddresses[0].String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"clear transfer restriction contract",
+			markercli.GetCmdSetTransferRestrictionContract(),
+			[]string{
+				"hotdog",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"set transfer restriction contract invalid address",
+			markercli.GetCmdSetTransferRestrictionContract(),
+			[]string{
+				"hotdog",
+				"notanaddress",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"set transfer restriction contract without admin access fails",
+			markercli.GetCmdSetTransferRestrictionContract(),
+			[]string{
+				"kyccoin",
+				s.accountAddresses[0].String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 4,
+		},
+		{
+			"change manager",
+			markercli.GetCmdChangeManager(),
+			[]string{
+				"cat-scratch-fever.bobcat",
+				s.accountAddresses[0].String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"change manager by previous manager fails",
+			markercli.GetCmdChangeManager(),
+			[]string{
+				"cat-scratch-fever.bobcat",
+				s.testnet.Validators[0].Address.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 4,
+		},
+		{
+			"change manager invalid address",
+			markercli.GetCmdChangeManager(),
+			[]string{
+				"cat-scratch-fever.bobcat",
+				"notanaddress",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
 		{
 			"mint supply",
 			markercli.GetCmdMint(),
@@ -680,6 +902,87 @@ func (s *IntegrationTestSuite) TestMarkerTxCommands() {
 			},
 			false, &sdk.TxResponse{}, 0,
 		},
+		{
+			"schedule withdraw, fail to parse release time",
+			markercli.GetCmdScheduleWithdraw(),
+			[]string{
+				"hotdog",
+				"10hotdog",
+				"tomorrow",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"schedule withdraw, successful schedule to a recipient",
+			markercli.GetCmdScheduleWithdraw(),
+			[]string{
+				"hotdog",
+				"10hotdog",
+				"4102444800",
+				s.accountAddresses[0].String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"burn from, fail to parse from address",
+			markercli.GetCmdBurnFrom(),
+			[]string{
+				"notanaddress",
+				"10hotdog",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"burn from, fail without burn from access",
+			markercli.GetCmdBurnFrom(),
+			[]string{
+				s.testnet.Validators[0].Address.String(),
+				"10hotdog",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 18,
+		},
+		{
+			"cancel scheduled withdraw, fail to parse id",
+			markercli.GetCmdCancelScheduledWithdraw(),
+			[]string{
+				"hotdog",
+				"first",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"cancel scheduled withdraw, successful cancel",
+			markercli.GetCmdCancelScheduledWithdraw(),
+			[]string{
+				"hotdog",
+				"1",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
 		{
 			"transfer, fail to transfer invalid from address",
 			markercli.GetNewTransferCmd(),
@@ -785,6 +1088,16 @@ func (s *IntegrationTestSuite) TestMarkerTxCommands() {
 }
 
 func (s *IntegrationTestSuite) TestMarkerAuthzTxCommands() {
+	transfersDir := s.T().TempDir()
+	csvTransfers := filepath.Join(transfersDir, "transfers.csv")
+	s.Require().NoError(ioutil.WriteFile(csvTransfers, []byte(fmt.Sprintf("from,to,amount\n%s,%s,3authzhotdog\n%s,%s,2authzhotdog\n",
+		s.accountAddresses[0], s.accountAddresses[2], s.accountAddresses[0], s.accountAddresses[3])), 0600))
+	jsonTransfers := filepath.Join(transfersDir, "transfers.json")
+	s.Require().NoError(ioutil.WriteFile(jsonTransfers, []byte(fmt.Sprintf(`[
+		{"from_address": "%s", "to_address": "%s", "amount": "1authzhotdog"},
+		{"from_address": "%s", "to_address": "%s", "amount": "1authzhotdog"}
+	]`, s.accountAddresses[0], s.accountAddresses[2], s.accountAddresses[1], s.accountAddresses[3])), 0600))
+
 	testCases := []struct {
 		name         string
 		cmd          *cobra.Command
@@ -904,6 +1217,85 @@ func (s *IntegrationTestSuite) TestMarkerAuthzTxCommands() {
 			},
 			false, &sdk.TxResponse{}, 1,
 		},
+		{
+			"grant authz transfer permissions to account 0 for account 1 with an allow list of account 2",
+			markercli.GetCmdGrantAuthorization(),
+			[]string{
+				s.accountAddresses[0].String(),
+				"transfer",
+				fmt.Sprintf("--%s=%s", markercli.FlagTransferLimit, "10authzhotdog"),
+				fmt.Sprintf("--%s=%s", markercli.FlagAllowList, s.accountAddresses[2].String()),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddresses[1].String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"marker transfer failed, account 3 not in allow list",
+			markercli.GetNewTransferCmd(),
+			[]string{
+				s.accountAddresses[1].String(),
+				s.accountAddresses[3].String(),
+				"5authzhotdog",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddresses[0].String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 4,
+		},
+		{
+			"marker transfer successful, account 2 in allow list",
+			markercli.GetNewTransferCmd(),
+			[]string{
+				s.accountAddresses[1].String(),
+				s.accountAddresses[2].String(),
+				"5authzhotdog",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddresses[0].String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"multi transfer successful from csv file",
+			markercli.GetCmdMultiTransfer(),
+			[]string{
+				csvTransfers,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddresses[0].String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"multi transfer failed from json file, account 3 not in allow list of account 1",
+			markercli.GetCmdMultiTransfer(),
+			[]string{
+				jsonTransfers,
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddresses[0].String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 4,
+		},
+		{
+			"multi transfer failed, transfers file does not exist",
+			markercli.GetCmdMultiTransfer(),
+			[]string{
+				filepath.Join(transfersDir, "missing.csv"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.accountAddresses[0].String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1042,7 +1434,7 @@ func (s *IntegrationTestSuite) TestMarkerGetTxCmd() {
 	s.Run("marker cli tx commands not nil", func() {
 		tx := markercli.NewTxCmd()
 		s.Require().NotNil(tx)
-		s.Require().Equal(len(tx.Commands()), 14)
+		s.Require().Equal(len(tx.Commands()), 25)
 		s.Require().Equal(tx.Use, markertypes.ModuleName)
 		s.Require().Equal(tx.Short, "Transaction commands for the marker module")
 	})
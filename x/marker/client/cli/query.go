@@ -33,6 +33,11 @@ func GetQueryCmd() *cobra.Command {
 		MarkerAccessCmd(),
 		MarkerEscrowCmd(),
 		MarkerSupplyCmd(),
+		FrozenAccountsCmd(),
+		MarkersByGranteeCmd(),
+		MarkerHistoryCmd(),
+		SupplyReportCmd(),
+		WithdrawSchedulesCmd(),
 	)
 	return queryCmd
 }
@@ -152,6 +157,220 @@ func AllHoldersCmd() *cobra.Command {
 	return cmd
 }
 
+// FrozenAccountsCmd is the CLI command for querying the accounts frozen for a restricted marker.
+func FrozenAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "frozen [address|denom]",
+		Aliases: []string{"freeze", "fa"},
+		Short:   "List all accounts frozen for the given restricted marker",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query marker frozen restrictedcoin`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			id := strings.TrimSpace(args[0])
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.FrozenAccounts(
+				context.Background(),
+				&types.QueryFrozenAccountsRequest{
+					Id:         id,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "frozen accounts")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// MarkerHistoryCmd is the CLI command for querying the recorded status transitions and supply changes of a marker.
+func MarkerHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "history [address|denom]",
+		Aliases: []string{"hist"},
+		Short:   "List the recorded status transitions and supply changes of a marker, oldest first",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query marker history nhash`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			id := strings.TrimSpace(args[0])
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.MarkerHistory(
+				context.Background(),
+				&types.QueryMarkerHistoryRequest{
+					Id:         id,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "marker history")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// SupplyReportCmd is the CLI command for reconciling the required supply of markers against the bank module supply.
+func SupplyReportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "supply-report [address|denom]",
+		Aliases: []string{"sr"},
+		Short:   "Compare the required supply of one or all markers with the bank supply, escrow, and circulation",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %[1]s query marker supply-report nhash
+$ %[1]s query marker supply-report --limit 10`, version.AppName)),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			var id string
+			if len(args) > 0 {
+				id = strings.TrimSpace(args[0])
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.SupplyReport(
+				context.Background(),
+				&types.QuerySupplyReportRequest{
+					Id:         id,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "supply reports")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// WithdrawSchedulesCmd is the CLI command for listing the withdrawals scheduled against markers.
+func WithdrawSchedulesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "withdraw-schedules [address|denom]",
+		Aliases: []string{"ws"},
+		Short:   "List the scheduled withdrawals of one or all markers that have not been released",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %[1]s query marker withdraw-schedules nhash
+$ %[1]s query marker withdraw-schedules --limit 10`, version.AppName)),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			var id string
+			if len(args) > 0 {
+				id = strings.TrimSpace(args[0])
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.WithdrawSchedules(
+				context.Background(),
+				&types.QueryWithdrawSchedulesRequest{
+					Id:         id,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "withdraw schedules")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// MarkersByGranteeCmd is the CLI command for listing the markers an address manages or holds access grants on.
+func MarkersByGranteeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "grantee [address] [permission, optional]",
+		Aliases: []string{"grants", "by-grantee"},
+		Short:   "List all markers the given address manages or holds access grants on",
+		Long: strings.TrimSpace(`List all markers the given address manages or holds access grants on.  If a permission is
+given only the markers the address holds that permission on are listed.  Permissions are one of [mint, burn, deposit,
+withdraw, delete, admin, transfer, freeze, force_transfer, burn_from].`),
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`$ %s query marker grantee pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj mint`, version.AppName)),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			address := strings.TrimSpace(args[0])
+			var permission types.Access
+			if len(args) > 1 {
+				permission = types.AccessByName(args[1])
+				if permission == types.Access_Unknown {
+					return fmt.Errorf("invalid permission: %s", args[1])
+				}
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.MarkersByGrantee(
+				context.Background(),
+				&types.QueryMarkersByGranteeRequest{
+					Address:    address,
+					Permission: permission,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "markers")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // MarkerCmd is the CLI command for querying marker module registrations.
 func MarkerCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -1,8 +1,11 @@
 package cli
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -17,6 +20,7 @@ import (
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/spf13/cobra"
@@ -28,6 +32,11 @@ const (
 	FlagAllowGovernanceControl = "allowGovernanceControl"
 	FlagTransferLimit          = "transfer-limit"
 	FlagExpiration             = "expiration"
+	FlagRequiredAttributes     = "required-attributes"
+	FlagMaxSupply              = "max-supply"
+	FlagAllowList              = "allow-list"
+	FlagTransferRestriction    = "transfer-restriction-contract"
+	FlagMetadata               = "metadata"
 )
 
 // NewTxCmd returns the top-level command for marker CLI transactions.
@@ -49,11 +58,22 @@ func NewTxCmd() *cobra.Command {
 		GetCmdAddAccess(),
 		GetCmdDeleteAccess(),
 		GetCmdWithdrawCoins(),
+		GetCmdScheduleWithdraw(),
+		GetCmdCancelScheduledWithdraw(),
+		GetCmdDistribute(),
 		GetNewTransferCmd(),
+		GetCmdMultiTransfer(),
+		GetCmdForceTransfer(),
+		GetCmdBurnFrom(),
 		GetCmdAddMarker(),
+		GetCmdAddFinalizeActivateMarker(),
 		GetCmdMarkerProposal(),
 		GetCmdGrantAuthorization(),
 		GetCmdRevokeAuthorization(),
+		GetCmdFreezeAccount(),
+		GetCmdUnfreezeAccount(),
+		GetCmdChangeManager(),
+		GetCmdSetTransferRestrictionContract(),
 	)
 	return txCmd
 }
@@ -103,6 +123,9 @@ Valid Proposal Types (and associated parameters):
 - ChangeStatus
 	"new_status": "MARKER_STATUS_ACTIVE" // [finalized, active, cancelled, destroyed]
 
+- ChangeManager
+	"new_manager": "pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk"
+
 - WithdrawEscrow
 	"amount": "100coin"
 	"target_address": "pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk"
@@ -152,6 +175,8 @@ Valid Proposal Types (and associated parameters):
 				proposal = &types.WithdrawEscrowProposal{}
 			case types.ProposalTypeSetDenomMetadata:
 				proposal = &types.SetDenomMetadataProposal{}
+			case types.ProposalTypeChangeManager:
+				proposal = &types.ChangeManagerProposal{}
 			default:
 				return fmt.Errorf("unknown proposal type %s", args[0])
 			}
@@ -218,7 +243,131 @@ with the given supply amount and denomination provided in the coin argument
 			if err != nil {
 				return fmt.Errorf("incorrect value for %s flag.  Accepted: true,false Error: %s", FlagAllowGovernanceControl, err)
 			}
+			requiredAttributes, err := cmd.Flags().GetStringSlice(FlagRequiredAttributes)
+			if err != nil {
+				return fmt.Errorf("incorrect value for %s flag.  Error: %s", FlagRequiredAttributes, err)
+			}
+			maxSupplyStr, err := cmd.Flags().GetString(FlagMaxSupply)
+			if err != nil {
+				return fmt.Errorf("incorrect value for %s flag.  Error: %s", FlagMaxSupply, err)
+			}
+			transferRestriction, err := cmd.Flags().GetString(FlagTransferRestriction)
+			if err != nil {
+				return fmt.Errorf("incorrect value for %s flag.  Error: %s", FlagTransferRestriction, err)
+			}
 			msg := types.NewMsgAddMarkerRequest(coin.Denom, coin.Amount, callerAddr, callerAddr, typeValue, supplyFixed, allowGovernanceControl)
+			msg.RequiredAttributes = requiredAttributes
+			msg.TransferRestrictionContract = transferRestriction
+			if len(maxSupplyStr) > 0 {
+				maxSupply, ok := sdk.NewIntFromString(maxSupplyStr)
+				if !ok {
+					return fmt.Errorf("invalid value for %s flag: %s", FlagMaxSupply, maxSupplyStr)
+				}
+				msg.MaxSupply = maxSupply
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(FlagType, "COIN", "a marker type to assign (default is COIN)")
+	cmd.Flags().Bool(FlagSupplyFixed, false, "a true or false value to denote if a supply is fixed (default is false)")
+	cmd.Flags().Bool(FlagAllowGovernanceControl, false, "a true or false value to denote if marker is allowed governance control (default is false)")
+	cmd.Flags().StringSlice(FlagRequiredAttributes, []string{}, "comma separated list of attribute names a recipient must hold to receive a RESTRICTED marker's coin")
+	cmd.Flags().String(FlagMaxSupply, "", "an upper limit on the total supply of the marker (default is no limit)")
+	cmd.Flags().String(FlagTransferRestriction, "", "address of a smart contract that must approve each transfer of a RESTRICTED marker's coin")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdAddFinalizeActivateMarker implements the create, finalize, and activate a marker in one step command.
+func GetCmdAddFinalizeActivateMarker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create-finalize-activate [coin] [access-grants]",
+		Aliases: []string{"cfa"},
+		Args:    cobra.ExactArgs(2),
+		Short:   "Create, finalize, and activate a new marker in a single transaction",
+		Long: strings.TrimSpace(`Creates a new marker managed by the from address with the given supply amount and
+denomination provided in the coin argument, then finalizes and activates it.  The access grants are given as a
+semicolon separated list of entries in the form address,permission[,permission...].  Valid permissions are one of
+[mint, burn, deposit, withdraw, delete, admin, transfer, freeze, force_transfer, burn_from].  Denom metadata for the
+marker's denom can optionally be given as a JSON file in the format of the bank module's metadata.
+`),
+		Example: fmt.Sprintf(`$ %s tx marker create-finalize-activate 1000hotdogcoin "pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj,mint,admin;pb1z6403t8z42fpl760zguuf2pc24g5gq96sez0k4,withdraw" --%s=metadata.json --from=mykey`,
+			version.AppName, FlagMetadata),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid coin %s", args[0])
+			}
+			accessGrants, err := parseAccessGrants(args[1])
+			if err != nil {
+				return err
+			}
+			markerType, err := cmd.Flags().GetString(FlagType)
+			if err != nil {
+				return fmt.Errorf("invalid marker type: %w", err)
+			}
+			typeValue := types.MarkerType_Coin
+			if len(markerType) > 0 {
+				typeValue = types.MarkerType(types.MarkerType_value["MARKER_TYPE_"+markerType])
+				if typeValue < 1 {
+					return fmt.Errorf("invalid marker type: %s; expected COIN|RESTRICTED", markerType)
+				}
+			}
+			supplyFixed, err := cmd.Flags().GetBool(FlagSupplyFixed)
+			if err != nil {
+				return fmt.Errorf("incorrect value for %s flag.  Accepted: true,false Error: %s", FlagSupplyFixed, err)
+			}
+			allowGovernanceControl, err := cmd.Flags().GetBool(FlagAllowGovernanceControl)
+			if err != nil {
+				return fmt.Errorf("incorrect value for %s flag.  Accepted: true,false Error: %s", FlagAllowGovernanceControl, err)
+			}
+			requiredAttributes, err := cmd.Flags().GetStringSlice(FlagRequiredAttributes)
+			if err != nil {
+				return fmt.Errorf("incorrect value for %s flag.  Error: %s", FlagRequiredAttributes, err)
+			}
+			maxSupplyStr, err := cmd.Flags().GetString(FlagMaxSupply)
+			if err != nil {
+				return fmt.Errorf("incorrect value for %s flag.  Error: %s", FlagMaxSupply, err)
+			}
+			transferRestriction, err := cmd.Flags().GetString(FlagTransferRestriction)
+			if err != nil {
+				return fmt.Errorf("incorrect value for %s flag.  Error: %s", FlagTransferRestriction, err)
+			}
+			metadataFile, err := cmd.Flags().GetString(FlagMetadata)
+			if err != nil {
+				return fmt.Errorf("incorrect value for %s flag.  Error: %s", FlagMetadata, err)
+			}
+			msg := types.NewMsgAddFinalizeActivateMarkerRequest(
+				coin.Denom, coin.Amount, clientCtx.GetFromAddress(), typeValue, supplyFixed, allowGovernanceControl, accessGrants,
+			)
+			msg.RequiredAttributes = requiredAttributes
+			msg.TransferRestrictionContract = transferRestriction
+			if len(maxSupplyStr) > 0 {
+				maxSupply, ok := sdk.NewIntFromString(maxSupplyStr)
+				if !ok {
+					return fmt.Errorf("invalid value for %s flag: %s", FlagMaxSupply, maxSupplyStr)
+				}
+				msg.MaxSupply = maxSupply
+			}
+			if len(metadataFile) > 0 {
+				contents, err := ioutil.ReadFile(metadataFile)
+				if err != nil {
+					return err
+				}
+				var metadata banktypes.Metadata
+				if err = clientCtx.Codec.UnmarshalJSON(contents, &metadata); err != nil {
+					return fmt.Errorf("invalid metadata file %s: %w", metadataFile, err)
+				}
+				msg.Metadata = &metadata
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -226,10 +375,35 @@ with the given supply amount and denomination provided in the coin argument
 	cmd.Flags().String(FlagType, "COIN", "a marker type to assign (default is COIN)")
 	cmd.Flags().Bool(FlagSupplyFixed, false, "a true or false value to denote if a supply is fixed (default is false)")
 	cmd.Flags().Bool(FlagAllowGovernanceControl, false, "a true or false value to denote if marker is allowed governance control (default is false)")
+	cmd.Flags().StringSlice(FlagRequiredAttributes, []string{}, "comma separated list of attribute names a recipient must hold to receive a RESTRICTED marker's coin")
+	cmd.Flags().String(FlagMaxSupply, "", "an upper limit on the total supply of the marker (default is no limit)")
+	cmd.Flags().String(FlagTransferRestriction, "", "address of a smart contract that must approve each transfer of a RESTRICTED marker's coin")
+	cmd.Flags().String(FlagMetadata, "", "a JSON file with the denom metadata to set for the marker's denom")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
+// parseAccessGrants reads access grants from a semicolon separated list of address,permission[,permission...] entries.
+func parseAccessGrants(grants string) ([]types.AccessGrant, error) {
+	var accessGrants []types.AccessGrant
+	for _, entry := range strings.Split(grants, ";") {
+		parts := strings.Split(strings.TrimSpace(entry), ",")
+		if len(parts) < 2 {
+			return nil, fmt.Errorf("invalid access grant %q: expected address,permission[,permission...]", entry)
+		}
+		addr, err := sdk.AccAddressFromBech32(strings.TrimSpace(parts[0]))
+		if err != nil {
+			return nil, sdkErrors.Wrapf(err, "invalid access grant address %s", parts[0])
+		}
+		grant := types.NewAccessGrant(addr, types.AccessListByNames(strings.Join(parts[1:], ",")))
+		if err = grant.Validate(); err != nil {
+			return nil, sdkErrors.Wrapf(err, "invalid access grant permissions: %s", strings.Join(parts[1:], ","))
+		}
+		accessGrants = append(accessGrants, *grant)
+	}
+	return accessGrants, nil
+}
+
 // GetCmdMint implements the mint additional supply for marker command.
 func GetCmdMint() *cobra.Command {
 	cmd := &cobra.Command{
@@ -397,9 +571,10 @@ func GetCmdAddAccess() *cobra.Command {
 		Args:    cobra.ExactArgs(3),
 		Short:   "Grant access to a marker for the address coins from the marker",
 		Long: strings.TrimSpace(`Grant administrative access to a marker.  From Address must have appropriate
-existing access.  Permissions are appended to any existing access grant.  Valid permissions
-are one of [mint, burn, deposit, withdraw, delete, admin, transfer].`),
-		Example: fmt.Sprintf(`$ %s tx marker grant pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj coindenom burn --from mykey`, version.AppName),
+existing access.  Permissions are appended to any existing access grant with the same expiration.  Valid permissions
+are one of [mint, burn, deposit, withdraw, delete, admin, transfer, freeze, force_transfer, burn_from].
+An optional expiration can be given after which the address no longer has the granted permissions on the marker.`),
+		Example: fmt.Sprintf(`$ %s tx marker grant pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj coindenom burn --%s=1672531200 --from mykey`, version.AppName, FlagExpiration),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -414,12 +589,21 @@ are one of [mint, burn, deposit, withdraw, delete, admin, transfer].`),
 			if err = grant.Validate(); err != nil {
 				return sdkErrors.Wrapf(err, "invalid access grant permission: %s", args[2])
 			}
+			exp, err := cmd.Flags().GetInt64(FlagExpiration)
+			if err != nil {
+				return err
+			}
+			if exp > 0 {
+				expiration := time.Unix(exp, 0).UTC()
+				grant.Expiration = &expiration
+			}
 			callerAddr := clientCtx.GetFromAddress()
 			msg := types.NewMsgAddAccessRequest(args[1], callerAddr, *grant)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Int64(FlagExpiration, 0, "The Unix timestamp after which the grant expires. Default is no expiration.")
 	return cmd
 }
 
@@ -488,6 +672,101 @@ func GetCmdWithdrawCoins() *cobra.Command {
 	return cmd
 }
 
+// GetCmdScheduleWithdraw implements the scheduled withdrawal of coin from a marker command.
+func GetCmdScheduleWithdraw() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "schedule-withdraw [marker-denom] [coins] [release-time] [(optional) recipient address]",
+		Aliases: []string{"sw"},
+		Args:    cobra.RangeArgs(3, 4),
+		Short:   "Schedule a withdrawal of coins from the marker at a future time",
+		Long: strings.TrimSpace(`Schedule a withdrawal of coins from the marker escrow account that is executed once the release time
+(a Unix timestamp) is reached.  Must be called by a user with the withdraw permission, which must still be held when
+the withdrawal is released.  If the recipient is not provided then the withdrawn amount is deposited in the caller's
+account.`),
+		Example: fmt.Sprintf(`$ %s tx marker schedule-withdraw coindenom 100coindenom 1893456000 pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkErrors.Wrapf(sdkErrors.ErrInvalidCoins, "invalid coins %s", args[1])
+			}
+			release, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid release time %s: %w", args[2], err)
+			}
+			recipientAddr := sdk.AccAddress{}
+			if len(args) == 4 {
+				recipientAddr, err = sdk.AccAddressFromBech32(args[3])
+				if err != nil {
+					return sdkErrors.Wrapf(err, "invalid recipient address %s", args[3])
+				}
+			}
+			msg := types.NewMsgScheduleWithdrawRequest(
+				clientCtx.GetFromAddress(), recipientAddr, args[0], coins, time.Unix(release, 0).UTC(),
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdCancelScheduledWithdraw implements the cancellation of a scheduled withdrawal command.
+func GetCmdCancelScheduledWithdraw() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-scheduled-withdraw [marker-denom] [id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Cancel a scheduled withdrawal of coins from the marker that has not been released",
+		Long: strings.TrimSpace(`Remove a scheduled withdrawal before its release time.  Must be called by the user that scheduled the
+withdrawal or a user with the withdraw permission.`),
+		Example: fmt.Sprintf(`$ %s tx marker cancel-scheduled-withdraw coindenom 1 --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid scheduled withdrawal id %s: %w", args[1], err)
+			}
+			msg := types.NewMsgCancelScheduledWithdrawRequest(args[0], clientCtx.GetFromAddress(), id)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdDistribute implements the distribution of escrowed coin to marker holders command.
+func GetCmdDistribute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribute [marker-denom] [coins]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Distribute coins from the marker to all holders of the marker",
+		Long: strings.TrimSpace(`Distribute coins from the marker escrow account to every account holding the marker's denom in
+proportion to their balances.  Must be called by a user with the withdraw permission.  Holders are paid over one or
+more blocks and any amount left over from rounding is returned to the marker escrow account.`),
+		Example: fmt.Sprintf(`$ %s tx marker distribute fundcoin 1000000nhash --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkErrors.Wrapf(sdkErrors.ErrInvalidCoins, "invalid coins %s", args[1])
+			}
+			msg := types.NewMsgDistributeRequest(clientCtx.GetFromAddress(), args[0], coins)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // Transfer handles a message to send coins from one account to another
 func GetNewTransferCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -525,6 +804,166 @@ func GetNewTransferCmd() *cobra.Command {
 	return cmd
 }
 
+// GetCmdMultiTransfer implements the command to transfer restricted coin between many accounts at once.
+func GetCmdMultiTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "multi-transfer [transfers-file]",
+		Aliases: []string{"mt"},
+		Short:   "Transfer restricted coins between many accounts in a single atomic transaction",
+		Long: strings.TrimSpace(`Transfer restricted coins between many accounts in a single atomic transaction.  If any
+of the transfers fail then none of them are made.  The transfers are read from a file.  A file with a .json extension
+must contain a list of transfers with from_address, to_address and amount fields.  Any other file is read as CSV
+with one transfer per line in the form: from,to,amount.  A leading header line starting with "from" is ignored.
+
+Example JSON:
+[
+  {"from_address": "tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx", "to_address": "tp1z6403t8z42fpl760zguuf2pc24g5gq96sez0k4", "amount": "100coindenom"}
+]
+
+Example CSV:
+from,to,amount
+tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx,tp1z6403t8z42fpl760zguuf2pc24g5gq96sez0k4,100coindenom
+`),
+		Example: fmt.Sprintf(`$ %s tx marker multi-transfer transfers.csv --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			legs, err := parseTransferLegs(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgMultiTransferRequest(clientCtx.GetFromAddress(), legs)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseTransferLegs reads the transfers of a multi transfer from a JSON or CSV file.
+func parseTransferLegs(file string) ([]types.TransferLeg, error) {
+	contents, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows [][]string
+	if strings.EqualFold(filepath.Ext(file), ".json") {
+		var transfers []struct {
+			FromAddress string `json:"from_address"`
+			ToAddress   string `json:"to_address"`
+			Amount      string `json:"amount"`
+		}
+		if err = json.Unmarshal(contents, &transfers); err != nil {
+			return nil, fmt.Errorf("invalid transfers file %s: %w", file, err)
+		}
+		for _, t := range transfers {
+			rows = append(rows, []string{t.FromAddress, t.ToAddress, t.Amount})
+		}
+	} else {
+		reader := csv.NewReader(strings.NewReader(string(contents)))
+		reader.FieldsPerRecord = 3
+		reader.TrimLeadingSpace = true
+		if rows, err = reader.ReadAll(); err != nil {
+			return nil, fmt.Errorf("invalid transfers file %s: %w", file, err)
+		}
+		if len(rows) > 0 && strings.HasPrefix(strings.ToLower(rows[0][0]), "from") {
+			rows = rows[1:]
+		}
+	}
+
+	legs := make([]types.TransferLeg, len(rows))
+	for i, row := range rows {
+		from, err := sdk.AccAddressFromBech32(strings.TrimSpace(row[0]))
+		if err != nil {
+			return nil, sdkErrors.Wrapf(err, "invalid from address %s in transfer %d", row[0], i+1)
+		}
+		to, err := sdk.AccAddressFromBech32(strings.TrimSpace(row[1]))
+		if err != nil {
+			return nil, sdkErrors.Wrapf(err, "invalid recipient address %s in transfer %d", row[1], i+1)
+		}
+		coin, err := sdk.ParseCoinNormalized(strings.TrimSpace(row[2]))
+		if err != nil {
+			return nil, sdkErrors.Wrapf(sdkErrors.ErrInvalidCoins, "invalid coin %s in transfer %d", row[2], i+1)
+		}
+		legs[i] = types.NewTransferLeg(from, to, coin)
+	}
+	return legs, nil
+}
+
+// GetCmdForceTransfer implements the forced transfer of restricted coin command.
+func GetCmdForceTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "force-transfer [from] [to] [coins]",
+		Short: "Transfer restricted coins out of an account without the holder's approval",
+		Long: strings.TrimSpace(`Transfer restricted coins out of any non-module account without the approval of the holder.
+From Address must have the force_transfer access on the marker and forced transfers must be enabled.`),
+		Example: fmt.Sprintf(`$ %s tx marker force-transfer tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx tp1z6403t8z42fpl760zguuf2pc24g5gq96sez0k4 100coindenom --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			from, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return sdkErrors.Wrapf(err, "invalid from address %s", args[0])
+			}
+			to, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return sdkErrors.Wrapf(err, "invalid recipient address %s", args[1])
+			}
+			coins, err := sdk.ParseCoinsNormalized(args[2])
+			if err != nil || len(coins) != 1 {
+				return sdkErrors.Wrapf(sdkErrors.ErrInvalidCoins, "invalid coin %s", args[2])
+			}
+			msg := types.NewMsgForceTransferRequest(clientCtx.GetFromAddress(), from, to, coins[0])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdBurnFrom implements the burn restricted coin from a holder's balance command.
+func GetCmdBurnFrom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn-from [from] [coins]",
+		Short: "Burn restricted coins from the balance of an account holding them",
+		Long: strings.TrimSpace(`Burn restricted coins directly from the balance of any non-module account holding them,
+reducing the supply of the marker.  From Address must have the burn_from access on the marker.`),
+		Example: fmt.Sprintf(`$ %s tx marker burn-from tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx 100coindenom --from mykey`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			from, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return sdkErrors.Wrapf(err, "invalid from address %s", args[0])
+			}
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil || len(coins) != 1 {
+				return sdkErrors.Wrapf(sdkErrors.ErrInvalidCoins, "invalid coin %s", args[1])
+			}
+			msg := types.NewMsgBurnFromRequest(clientCtx.GetFromAddress(), from, coins[0])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func GetCmdGrantAuthorization() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "grant-authz [grantee] [authorization_type]",
@@ -532,7 +971,7 @@ func GetCmdGrantAuthorization() *cobra.Command {
 		Args:    cobra.ExactArgs(2),
 		Short:   "Grant authorization to an address",
 		Long:    strings.TrimSpace(`grant authorization to an address to execute an authorization type [transfer]`),
-		Example: fmt.Sprintf(`$ %s tx marker grant-authz tp1skjw.. transfer --transfer-limit=1000nhash`, version.AppName),
+		Example: fmt.Sprintf(`$ %s tx marker grant-authz tp1skjw.. transfer --transfer-limit=1000nhash --allow-list=tp1pdzm..,tp1x3ae..`, version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -566,7 +1005,20 @@ func GetCmdGrantAuthorization() *cobra.Command {
 					return fmt.Errorf("transfer-limit should be greater than zero")
 				}
 
-				authorization = types.NewMarkerTransferAuthorization(spendLimit)
+				allowList, terr := cmd.Flags().GetStringSlice(FlagAllowList)
+				if terr != nil {
+					return terr
+				}
+
+				allowed := make([]sdk.AccAddress, len(allowList))
+				for i, addr := range allowList {
+					allowed[i], terr = sdk.AccAddressFromBech32(addr)
+					if terr != nil {
+						return terr
+					}
+				}
+
+				authorization = types.NewMarkerTransferAuthorization(spendLimit, allowed)
 			default:
 				return fmt.Errorf("invalid authorization type, %s", args[1])
 			}
@@ -581,6 +1033,7 @@ func GetCmdGrantAuthorization() *cobra.Command {
 	}
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().String(FlagTransferLimit, "", "The total amount an account is allowed to tranfer on granter's behalf")
+	cmd.Flags().StringSlice(FlagAllowList, []string{}, "Allowed addresses grantee is allowed to transfer the granter's coin to")
 	cmd.Flags().Int64(FlagExpiration, time.Now().AddDate(1, 0, 0).Unix(), "The Unix timestamp. Default is one year.")
 	return cmd
 }
@@ -623,3 +1076,109 @@ func GetCmdRevokeAuthorization() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdFreezeAccount implements the freeze account command for a restricted marker.
+func GetCmdFreezeAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze [denom] [address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Freeze an account holding a restricted marker's coin",
+		Long: strings.TrimSpace(`Prevent the account from transferring the restricted marker's coin.
+From Address must have the freeze access on the marker.`),
+		Example: fmt.Sprintf(`$ %s tx marker freeze restrictedcoin pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			targetAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return sdkErrors.Wrapf(err, "freeze invalid address %s", args[1])
+			}
+			msg := types.NewMsgFreezeAccountRequest(args[0], clientCtx.GetFromAddress(), targetAddr)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdUnfreezeAccount implements the unfreeze account command for a restricted marker.
+func GetCmdUnfreezeAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze [denom] [address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Unfreeze an account holding a restricted marker's coin",
+		Long: strings.TrimSpace(`Allow a frozen account to transfer the restricted marker's coin again.
+From Address must have the freeze access on the marker.`),
+		Example: fmt.Sprintf(`$ %s tx marker unfreeze restrictedcoin pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			targetAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return sdkErrors.Wrapf(err, "unfreeze invalid address %s", args[1])
+			}
+			msg := types.NewMsgUnfreezeAccountRequest(args[0], clientCtx.GetFromAddress(), targetAddr)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdChangeManager implements the change manager command for a marker.
+func GetCmdChangeManager() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-manager [denom] [new-manager]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Assign a new manager to a marker",
+		Long: strings.TrimSpace(`Replace the manager of a marker with the given address, such as when the key of the
+current manager is rotated.  Active markers have no manager until one is assigned by an account with the admin access.
+From Address must be the current manager or have the admin access on the marker.`),
+		Example: fmt.Sprintf(`$ %s tx marker change-manager hotdogcoin pb1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			newManager, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return sdkErrors.Wrapf(err, "change manager invalid address %s", args[1])
+			}
+			msg := types.NewMsgChangeManagerRequest(args[0], clientCtx.GetFromAddress(), newManager)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetTransferRestrictionContract implements the set transfer restriction contract command for a marker.
+func GetCmdSetTransferRestrictionContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-transfer-restriction-contract [denom] [contract]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Set or clear the transfer restriction contract of a restricted marker",
+		Long: strings.TrimSpace(`Set the smart contract that is queried to approve each transfer of restricted coin from the
+marker, or clear it when no contract is given.  From Address must have the admin access on the marker.`),
+		Example: fmt.Sprintf(`$ %s tx marker set-transfer-restriction-contract hotdogcoin pb14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s96lrg8 --from mykey
+$ %[1]s tx marker set-transfer-restriction-contract hotdogcoin --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			contract := ""
+			if len(args) > 1 {
+				contract = args[1]
+			}
+			msg := types.NewMsgSetTransferRestrictionContractRequest(args[0], clientCtx.GetFromAddress(), contract)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
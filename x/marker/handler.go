@@ -53,11 +53,53 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgTransferRequest:
 			res, err := msgServer.Transfer(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgForceTransferRequest:
+			res, err := msgServer.ForceTransfer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgDistributeRequest:
+			res, err := msgServer.Distribute(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgMultiTransferRequest:
+			res, err := msgServer.MultiTransfer(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 
 		case *types.MsgSetDenomMetadataRequest:
 			res, err := msgServer.SetDenomMetadata(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgFreezeAccountRequest:
+			res, err := msgServer.FreezeAccount(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgUnfreezeAccountRequest:
+			res, err := msgServer.UnfreezeAccount(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgChangeManagerRequest:
+			res, err := msgServer.ChangeManager(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgScheduleWithdrawRequest:
+			res, err := msgServer.ScheduleWithdraw(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgCancelScheduledWithdrawRequest:
+			res, err := msgServer.CancelScheduledWithdraw(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgAddFinalizeActivateMarkerRequest:
+			res, err := msgServer.AddFinalizeActivateMarker(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgBurnFromRequest:
+			res, err := msgServer.BurnFrom(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
+		case *types.MsgSetTransferRestrictionContractRequest:
+			res, err := msgServer.SetTransferRestrictionContract(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
@@ -83,6 +125,8 @@ func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
 			return keeper.HandleWithdrawEscrowProposal(ctx, k, c)
 		case *types.SetDenomMetadataProposal:
 			return keeper.HandleSetDenomMetadataProposal(ctx, k, c)
+		case *types.ChangeManagerProposal:
+			return keeper.HandleChangeManagerProposal(ctx, k, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized marker proposal content type: %T", c)
 		}
@@ -0,0 +1,157 @@
+package ibc
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/cosmos/ibc-go/modules/apps/transfer"
+	ibctransferkeeper "github.com/cosmos/ibc-go/modules/apps/transfer/keeper"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/modules/core/exported"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// MarkerKeeper is the marker functionality needed to check outbound transfers of marker coin.
+type MarkerKeeper interface {
+	GetMarkerByDenom(ctx sdk.Context, denom string) (types.MarkerAccountI, error)
+	IsAccountFrozen(ctx sdk.Context, denom string, addr sdk.AccAddress) bool
+}
+
+// TransferMiddleware wraps the ibc transfer module so that restricted marker coin can only be sent over ibc by
+// accounts holding the transfer access right on the marker, and can only be received back from another chain by
+// accounts holding the same access.  Outbound transfers are checked by the transfer msg server and inbound transfers
+// in OnRecvPacket.  Refunds of failed or timed out transfers return the coin to the sender that was checked when the
+// transfer was sent and are left to the wrapped module along with all other transfer module functionality.
+type TransferMiddleware struct {
+	transfer.AppModule
+
+	markerKeeper   MarkerKeeper
+	transferKeeper ibctransferkeeper.Keeper
+}
+
+var (
+	_ module.AppModule    = TransferMiddleware{}
+	_ porttypes.IBCModule = TransferMiddleware{}
+)
+
+// NewTransferMiddleware creates a transfer module that checks outbound transfers of marker coin.
+func NewTransferMiddleware(markerKeeper MarkerKeeper, transferKeeper ibctransferkeeper.Keeper) TransferMiddleware {
+	return TransferMiddleware{
+		AppModule:      transfer.NewAppModule(transferKeeper),
+		markerKeeper:   markerKeeper,
+		transferKeeper: transferKeeper,
+	}
+}
+
+// RegisterServices registers the checked transfer msg server in place of the one provided by the transfer module.
+func (am TransferMiddleware) RegisterServices(cfg module.Configurator) {
+	ibctransfertypes.RegisterMsgServer(cfg.MsgServer(), NewMsgServerImpl(am.markerKeeper, am.transferKeeper))
+	ibctransfertypes.RegisterQueryServer(cfg.QueryServer(), am.transferKeeper)
+}
+
+// OnRecvPacket rejects restricted marker coin returning to this chain when the receiver does not hold the transfer
+// access right on the marker, acknowledging the packet with an error so the coin is refunded on the sending chain.
+// All other packets are handled by the transfer module.
+func (am TransferMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data ibctransfertypes.FungibleTokenPacketData
+	if err := ibctransfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err == nil {
+		if err = checkRecvPacket(ctx, am.markerKeeper, packet, data); err != nil {
+			return channeltypes.NewErrorAcknowledgement(err.Error())
+		}
+	}
+	return am.AppModule.OnRecvPacket(ctx, packet, relayer)
+}
+
+// checkRecvPacket returns an error if the packet returns restricted marker coin to this chain for a receiver that is
+// not allowed to receive it.  Vouchers minted for coin from other chains never belong to a restricted marker.
+func checkRecvPacket(ctx sdk.Context, markerKeeper MarkerKeeper, packet channeltypes.Packet, data ibctransfertypes.FungibleTokenPacketData) error {
+	if !ibctransfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		return nil
+	}
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		// left for the transfer module to reject.
+		return nil
+	}
+	// the denom is unescrowed on this chain without the prefix added by the sending chain.
+	denom := data.Denom[len(ibctransfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())):]
+	if trace := ibctransfertypes.ParseDenomTrace(denom); trace.Path != "" {
+		denom = trace.IBCDenom()
+	}
+	return CanReceiveCoin(ctx, markerKeeper, receiver, denom)
+}
+
+type msgServer struct {
+	markerKeeper   MarkerKeeper
+	transferKeeper ibctransferkeeper.Keeper
+}
+
+var _ ibctransfertypes.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the ibc transfer MsgServer interface that rejects transfers of
+// restricted marker coin before passing them on to the transfer keeper.
+func NewMsgServerImpl(markerKeeper MarkerKeeper, transferKeeper ibctransferkeeper.Keeper) ibctransfertypes.MsgServer {
+	return msgServer{markerKeeper: markerKeeper, transferKeeper: transferKeeper}
+}
+
+// Transfer checks the sender is allowed to move the coin out of the chain and then sends it with the transfer keeper.
+func (s msgServer) Transfer(goCtx context.Context, msg *ibctransfertypes.MsgTransfer) (*ibctransfertypes.MsgTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	if err = CanTransferCoin(ctx, s.markerKeeper, sender, msg.Token); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+	return s.transferKeeper.Transfer(goCtx, msg)
+}
+
+// CanTransferCoin returns an error if the coin is restricted marker coin that the sender is not allowed to send over
+// ibc.  Coin that does not belong to a marker, or belongs to an unrestricted marker, can always be sent.
+func CanTransferCoin(ctx sdk.Context, markerKeeper MarkerKeeper, sender sdk.AccAddress, token sdk.Coin) error {
+	m, err := markerKeeper.GetMarkerByDenom(ctx, token.Denom)
+	if err != nil || m == nil || m.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return nil
+	}
+	if m.GetStatus() != types.StatusActive {
+		return fmt.Errorf("marker %s is not active, ibc transfers are not allowed", token.Denom)
+	}
+	if markerKeeper.IsAccountFrozen(ctx, token.Denom, sender) {
+		return sdkerrors.Wrapf(types.ErrAccountFrozen, "%s is frozen for %s", sender, token.Denom)
+	}
+	if !m.AddressHasAccess(sender, types.Access_Transfer, ctx.BlockTime()) {
+		return fmt.Errorf("%s does not have %s on %s markeraccount, ibc transfer not allowed",
+			sender, types.Access_Transfer, token.Denom)
+	}
+	return nil
+}
+
+// CanReceiveCoin returns an error if the denom is restricted marker coin that the receiver is not allowed to receive
+// over ibc.  Coin that does not belong to a marker, or belongs to an unrestricted marker, can always be received.
+func CanReceiveCoin(ctx sdk.Context, markerKeeper MarkerKeeper, receiver sdk.AccAddress, denom string) error {
+	m, err := markerKeeper.GetMarkerByDenom(ctx, denom)
+	if err != nil || m == nil || m.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return nil
+	}
+	if m.GetStatus() != types.StatusActive {
+		return fmt.Errorf("marker %s is not active, ibc transfers are not allowed", denom)
+	}
+	if !m.AddressHasAccess(receiver, types.Access_Transfer, ctx.BlockTime()) {
+		return fmt.Errorf("%s does not have %s on %s markeraccount, ibc transfer not allowed",
+			receiver, types.Access_Transfer, denom)
+	}
+	return nil
+}
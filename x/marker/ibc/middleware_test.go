@@ -0,0 +1,233 @@
+package ibc_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	sdksim "github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/testing"
+
+	provapp "github.com/provenance-io/provenance/app"
+	markeribc "github.com/provenance-io/provenance/x/marker/ibc"
+	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func init() {
+	ibctesting.DefaultTestingAppInit = func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		encCdc := provapp.MakeEncodingConfig()
+		app := provapp.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{},
+			provapp.DefaultNodeHome, 5, encCdc, sdksim.EmptyAppOptions{})
+		return app, provapp.NewDefaultGenesisState(encCdc.Marshaler)
+	}
+}
+
+type MiddlewareTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	path   *ibctesting.Path
+}
+
+func (s *MiddlewareTestSuite) SetupTest() {
+	s.coordinator = ibctesting.NewCoordinator(s.T(), 2)
+	s.chainA = s.coordinator.GetChain(ibctesting.GetChainID(0))
+	s.chainB = s.coordinator.GetChain(ibctesting.GetChainID(1))
+
+	s.path = ibctesting.NewPath(s.chainA, s.chainB)
+	s.path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	s.path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	s.path.EndpointA.ChannelConfig.Version = ibctransfertypes.Version
+	s.path.EndpointB.ChannelConfig.Version = ibctransfertypes.Version
+	s.coordinator.Setup(s.path)
+}
+
+func TestMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(MiddlewareTestSuite))
+}
+
+func getApp(chain *ibctesting.TestChain) *provapp.App {
+	return chain.App.(*provapp.App)
+}
+
+// sendTransfer sends a transfer from the sender of the source endpoint and relays the packet to the counterparty.
+func (s *MiddlewareTestSuite) sendTransfer(src, dst *ibctesting.Endpoint, coin sdk.Coin) {
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	s.sendTransferTo(src, dst, coin, dst.Chain.SenderAccount.GetAddress(), ack)
+}
+
+// sendTransferTo sends a transfer from the sender of the source endpoint to the receiver and relays the packet to the
+// counterparty, which is expected to acknowledge it with the given acknowledgement.
+func (s *MiddlewareTestSuite) sendTransferTo(
+	src, dst *ibctesting.Endpoint, coin sdk.Coin, receiver sdk.AccAddress, ack channeltypes.Acknowledgement,
+) {
+	sender := src.Chain.SenderAccount.GetAddress()
+	timeoutHeight := clienttypes.NewHeight(0, 110)
+	sequence, found := getApp(src.Chain).IBCKeeper.ChannelKeeper.GetNextSequenceSend(
+		src.Chain.GetContext(), src.ChannelConfig.PortID, src.ChannelID,
+	)
+	s.Require().True(found)
+
+	msg := ibctransfertypes.NewMsgTransfer(
+		src.ChannelConfig.PortID, src.ChannelID, coin, sender.String(), receiver.String(), timeoutHeight, 0,
+	)
+	_, err := src.Chain.SendMsgs(msg)
+	s.Require().NoError(err)
+
+	fullDenomPath := coin.Denom
+	if trace, err := getApp(src.Chain).TransferKeeper.DenomPathFromHash(src.Chain.GetContext(), coin.Denom); err == nil {
+		fullDenomPath = trace
+	}
+	data := ibctransfertypes.NewFungibleTokenPacketData(
+		fullDenomPath, coin.Amount.Uint64(), sender.String(), receiver.String(),
+	)
+	packet := channeltypes.NewPacket(
+		data.GetBytes(), sequence,
+		src.ChannelConfig.PortID, src.ChannelID, dst.ChannelConfig.PortID, dst.ChannelID, timeoutHeight, 0,
+	)
+	s.Require().NoError(s.path.RelayPacket(packet, ack.Acknowledgement()))
+}
+
+// addMarker creates, finalizes, and activates a marker on the chain with the given access granted to the chain's sender.
+func (s *MiddlewareTestSuite) addMarker(
+	chain *ibctesting.TestChain, denom string, supply int64, markerType types.MarkerType, access string,
+) {
+	admin := chain.SenderAccount.GetAddress()
+	add := types.NewMsgAddMarkerRequest(denom, sdk.NewInt(supply), admin, admin, markerType, false, false)
+	add.AccessList = []types.AccessGrant{
+		*types.NewAccessGrant(admin, types.AccessListByNames(access)),
+	}
+	_, err := chain.SendMsgs(add, types.NewMsgFinalizeRequest(denom, admin), types.NewMsgActivateRequest(denom, admin))
+	s.Require().NoError(err)
+}
+
+func (s *MiddlewareTestSuite) TestRestrictedMarkerTransfer() {
+	app := getApp(s.chainA)
+	sender := s.chainA.SenderAccount.GetAddress()
+	denom := "restrictedcoin"
+	coin := sdk.NewInt64Coin(denom, 100)
+
+	s.addMarker(s.chainA, denom, 1000, types.MarkerType_RestrictedCoin, "mint,burn,withdraw,admin,freeze")
+	_, err := s.chainA.SendMsgs(types.NewMsgWithdrawRequest(sender, sender, denom, sdk.NewCoins(coin)))
+	s.Require().NoError(err)
+
+	msg := ibctransfertypes.NewMsgTransfer(
+		s.path.EndpointA.ChannelConfig.PortID, s.path.EndpointA.ChannelID, coin, sender.String(),
+		s.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0,
+	)
+	msgServer := markeribc.NewMsgServerImpl(app.MarkerKeeper, app.TransferKeeper)
+	_, err = msgServer.Transfer(sdk.WrapSDKContext(s.chainA.GetContext()), msg)
+	s.Require().Error(err, "transfer without transfer access")
+	s.Require().Contains(err.Error(), "does not have ACCESS_TRANSFER on restrictedcoin markeraccount")
+
+	// granting transfer access to the sender allows the coin to leave the chain.
+	_, err = s.chainA.SendMsgs(types.NewMsgAddAccessRequest(denom, sender,
+		*types.NewAccessGrant(sender, types.AccessListByNames("transfer"))))
+	s.Require().NoError(err)
+
+	// a frozen sender still can not send the coin.
+	ctx, _ := s.chainA.GetContext().CacheContext()
+	s.Require().NoError(app.MarkerKeeper.AddFrozenAccount(ctx, sender, denom, sender))
+	_, err = msgServer.Transfer(sdk.WrapSDKContext(ctx), msg)
+	s.Require().Error(err, "transfer from frozen account")
+	s.Require().Contains(err.Error(), "is frozen for restrictedcoin")
+
+	s.sendTransfer(s.path.EndpointA, s.path.EndpointB, coin)
+
+	voucher := ibctransfertypes.ParseDenomTrace(ibctransfertypes.GetPrefixedDenom(
+		s.path.EndpointB.ChannelConfig.PortID, s.path.EndpointB.ChannelID, denom,
+	)).IBCDenom()
+	balance := getApp(s.chainB).BankKeeper.GetBalance(s.chainB.GetContext(), s.chainB.SenderAccount.GetAddress(), voucher)
+	s.Require().Equal(coin.Amount, balance.Amount)
+	s.Require().True(app.BankKeeper.GetBalance(s.chainA.GetContext(), sender, denom).IsZero())
+
+	// the coin can not be returned to an account without transfer access, it is refunded on the sending chain.
+	other := sdk.AccAddress("other_______________")
+	errAck := channeltypes.NewErrorAcknowledgement(
+		other.String() + " does not have ACCESS_TRANSFER on restrictedcoin markeraccount, ibc transfer not allowed")
+	s.sendTransferTo(s.path.EndpointB, s.path.EndpointA, sdk.NewCoin(voucher, coin.Amount), other, errAck)
+	s.Require().True(app.BankKeeper.GetBalance(s.chainA.GetContext(), other, denom).IsZero())
+	balance = getApp(s.chainB).BankKeeper.GetBalance(s.chainB.GetContext(), s.chainB.SenderAccount.GetAddress(), voucher)
+	s.Require().Equal(coin.Amount, balance.Amount, "refunded voucher")
+
+	// but can be returned to an account holding transfer access.
+	s.sendTransfer(s.path.EndpointB, s.path.EndpointA, sdk.NewCoin(voucher, coin.Amount))
+	s.Require().Equal(coin.Amount, app.BankKeeper.GetBalance(s.chainA.GetContext(), sender, denom).Amount)
+}
+
+func (s *MiddlewareTestSuite) TestIbcEnabledMarker() {
+	app := getApp(s.chainB)
+	admin := s.chainB.SenderAccount.GetAddress()
+	coin := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+
+	s.sendTransfer(s.path.EndpointA, s.path.EndpointB, coin)
+	voucher := ibctransfertypes.ParseDenomTrace(ibctransfertypes.GetPrefixedDenom(
+		s.path.EndpointB.ChannelConfig.PortID, s.path.EndpointB.ChannelID, sdk.DefaultBondDenom,
+	)).IBCDenom()
+
+	// the voucher denom can only be wrapped by an ibc enabled marker.
+	add := types.NewMsgAddMarkerRequest(voucher, sdk.ZeroInt(), admin, admin, types.MarkerType_Coin, false, false)
+	s.Require().Error(add.ValidateBasic())
+
+	// ibc enabled markers can only be added by governance.
+	add.IbcEnabled = true
+	msgServer := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+	_, err := msgServer.AddMarker(sdk.WrapSDKContext(s.chainB.GetContext()), add)
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "ibc enabled markers can only be added by governance proposal")
+
+	// the voucher denom must have been created by the transfer module.
+	proposal := types.NewAddMarkerProposal("title", "description", "ibc/"+strings.Repeat("A", 64), sdk.ZeroInt(),
+		admin, types.StatusProposed, types.MarkerType_Coin,
+		[]types.AccessGrant{*types.NewAccessGrant(admin, types.AccessListByNames("mint,burn,withdraw,admin"))}, false, true)
+	proposal.IbcEnabled = true
+	s.Require().NoError(proposal.ValidateBasic())
+	s.Require().EqualError(markerkeeper.HandleAddMarkerProposal(s.chainB.GetContext(), app.MarkerKeeper, proposal),
+		"no ibc denom trace found for ibc/"+strings.Repeat("A", 64))
+
+	proposal.Amount = sdk.NewCoin(voucher, sdk.ZeroInt())
+	s.Require().NoError(markerkeeper.HandleAddMarkerProposal(s.chainB.GetContext(), app.MarkerKeeper, proposal))
+	_, err = s.chainB.SendMsgs(types.NewMsgFinalizeRequest(voucher, admin), types.NewMsgActivateRequest(voucher, admin))
+	s.Require().NoError(err)
+
+	ctx := s.chainB.GetContext()
+	m, err := app.MarkerKeeper.GetMarkerByDenom(ctx, voucher)
+	s.Require().NoError(err)
+	s.Require().True(m.HasIbcEnabled())
+	s.Require().Equal(types.StatusActive, m.GetStatus())
+	s.Require().Equal(coin.Amount, m.GetSupply().Amount, "supply is the amount received over ibc")
+	s.Require().Equal(coin.Amount, app.BankKeeper.GetBalance(ctx, admin, voucher).Amount)
+
+	// supply is managed by the transfer module.
+	_, err = msgServer.Mint(sdk.WrapSDKContext(ctx), types.NewMsgMintRequest(admin, sdk.NewInt64Coin(voucher, 10)))
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "is managed by ibc transfers")
+	_, err = msgServer.Burn(sdk.WrapSDKContext(ctx), types.NewMsgBurnRequest(admin, sdk.NewInt64Coin(voucher, 10)))
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "is managed by ibc transfers")
+
+	// the metadata of the voucher is left to governance.
+	err = app.MarkerKeeper.SetMarkerDenomMetadata(ctx, banktypes.Metadata{
+		Description: "relabeled", Base: voucher, Display: voucher, DenomUnits: []*banktypes.DenomUnit{{Denom: voucher}},
+	}, admin)
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "can only be set by governance proposal")
+
+	// the wrapped voucher can still be sent back to its source.
+	s.sendTransfer(s.path.EndpointB, s.path.EndpointA, sdk.NewCoin(voucher, coin.Amount))
+	s.Require().True(app.BankKeeper.GetBalance(s.chainB.GetContext(), admin, voucher).IsZero())
+}
@@ -0,0 +1,286 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// MarkerBankKeeper wraps a bank keeper to maintain the marker holder index for every balance change made through it,
+// to queue a supply check for markers when coin is minted or burned, to record holder balances for distributions in
+// progress and to reject sends out of accounts frozen for a marker denom.  It must be used in place of the bank keeper
+// by all modules for these to remain complete.
+type MarkerBankKeeper struct {
+	bankkeeper.Keeper
+
+	// Key to access the marker key-value store where the holder index, supply check queue, distributions and frozen
+	// accounts are kept.
+	markerStoreKey sdk.StoreKey
+
+	// The codec for the distribution records in the marker store.
+	cdc codec.BinaryCodec
+}
+
+var _ bankkeeper.Keeper = MarkerBankKeeper{}
+
+// NewMarkerBankKeeper returns a bank keeper that updates the marker holder index and supply check queue.
+func NewMarkerBankKeeper(bk bankkeeper.Keeper, markerStoreKey sdk.StoreKey, cdc codec.BinaryCodec) MarkerBankKeeper {
+	return MarkerBankKeeper{
+		Keeper:         bk,
+		markerStoreKey: markerStoreKey,
+		cdc:            cdc,
+	}
+}
+
+// InputOutputCoins performs a multi-send and updates the holder index for all inputs and outputs
+func (k MarkerBankKeeper) InputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error {
+	for _, in := range inputs {
+		addr, err := sdk.AccAddressFromBech32(in.Address)
+		if err != nil {
+			return err
+		}
+		if err = k.checkNotFrozen(ctx, in.Coins, addr); err != nil {
+			return err
+		}
+		k.recordDistributionBalances(ctx, in.Coins, addr)
+	}
+	for _, out := range outputs {
+		addr, err := sdk.AccAddressFromBech32(out.Address)
+		if err != nil {
+			return err
+		}
+		k.recordDistributionBalances(ctx, out.Coins, addr)
+	}
+	if err := k.Keeper.InputOutputCoins(ctx, inputs, outputs); err != nil {
+		return err
+	}
+	for _, in := range inputs {
+		addr, err := sdk.AccAddressFromBech32(in.Address)
+		if err != nil {
+			return err
+		}
+		k.updateHolders(ctx, in.Coins, addr)
+	}
+	for _, out := range outputs {
+		addr, err := sdk.AccAddressFromBech32(out.Address)
+		if err != nil {
+			return err
+		}
+		k.updateHolders(ctx, out.Coins, addr)
+	}
+	return nil
+}
+
+// SendCoins transfers coins between accounts and updates the holder index for both accounts
+func (k MarkerBankKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.checkNotFrozen(ctx, amt, fromAddr); err != nil {
+		return err
+	}
+	k.recordDistributionBalances(ctx, amt, fromAddr, toAddr)
+	if err := k.Keeper.SendCoins(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+	k.updateHolders(ctx, amt, fromAddr, toAddr)
+	return nil
+}
+
+// SendCoinsFromModuleToAccount transfers coins from a module account and updates the holder index
+func (k MarkerBankKeeper) SendCoinsFromModuleToAccount(
+	ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins,
+) error {
+	k.recordDistributionBalances(ctx, amt, authtypes.NewModuleAddress(senderModule), recipientAddr)
+	if err := k.Keeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt); err != nil {
+		return err
+	}
+	k.updateHolders(ctx, amt, authtypes.NewModuleAddress(senderModule), recipientAddr)
+	return nil
+}
+
+// SendCoinsFromModuleToModule transfers coins between module accounts and updates the holder index
+func (k MarkerBankKeeper) SendCoinsFromModuleToModule(
+	ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins,
+) error {
+	k.recordDistributionBalances(ctx, amt, authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule))
+	if err := k.Keeper.SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt); err != nil {
+		return err
+	}
+	k.updateHolders(ctx, amt, authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress(recipientModule))
+	return nil
+}
+
+// SendCoinsFromAccountToModule transfers coins to a module account and updates the holder index
+func (k MarkerBankKeeper) SendCoinsFromAccountToModule(
+	ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
+) error {
+	if err := k.checkNotFrozen(ctx, amt, senderAddr); err != nil {
+		return err
+	}
+	k.recordDistributionBalances(ctx, amt, senderAddr, authtypes.NewModuleAddress(recipientModule))
+	if err := k.Keeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt); err != nil {
+		return err
+	}
+	k.updateHolders(ctx, amt, senderAddr, authtypes.NewModuleAddress(recipientModule))
+	return nil
+}
+
+// DelegateCoinsFromAccountToModule delegates coins to a module account and updates the holder index
+func (k MarkerBankKeeper) DelegateCoinsFromAccountToModule(
+	ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins,
+) error {
+	if err := k.checkNotFrozen(ctx, amt, senderAddr); err != nil {
+		return err
+	}
+	k.recordDistributionBalances(ctx, amt, senderAddr, authtypes.NewModuleAddress(recipientModule))
+	if err := k.Keeper.DelegateCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt); err != nil {
+		return err
+	}
+	k.updateHolders(ctx, amt, senderAddr, authtypes.NewModuleAddress(recipientModule))
+	return nil
+}
+
+// UndelegateCoinsFromModuleToAccount undelegates coins from a module account and updates the holder index
+func (k MarkerBankKeeper) UndelegateCoinsFromModuleToAccount(
+	ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins,
+) error {
+	k.recordDistributionBalances(ctx, amt, authtypes.NewModuleAddress(senderModule), recipientAddr)
+	if err := k.Keeper.UndelegateCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt); err != nil {
+		return err
+	}
+	k.updateHolders(ctx, amt, authtypes.NewModuleAddress(senderModule), recipientAddr)
+	return nil
+}
+
+// MintCoins creates new coins in a module account, updates the holder index and queues marker supply checks
+func (k MarkerBankKeeper) MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+	k.recordDistributionBalances(ctx, amt, authtypes.NewModuleAddress(moduleName))
+	if err := k.Keeper.MintCoins(ctx, moduleName, amt); err != nil {
+		return err
+	}
+	k.updateHolders(ctx, amt, authtypes.NewModuleAddress(moduleName))
+	k.queueSupplyChecks(ctx, amt)
+	return nil
+}
+
+// BurnCoins removes coins from a module account, updates the holder index and queues marker supply checks
+func (k MarkerBankKeeper) BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error {
+	k.recordDistributionBalances(ctx, amt, authtypes.NewModuleAddress(moduleName))
+	if err := k.Keeper.BurnCoins(ctx, moduleName, amt); err != nil {
+		return err
+	}
+	k.updateHolders(ctx, amt, authtypes.NewModuleAddress(moduleName))
+	k.queueSupplyChecks(ctx, amt)
+	return nil
+}
+
+// DelegateCoins performs delegation by moving coins to a module account and updates the holder index
+func (k MarkerBankKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error {
+	if err := k.checkNotFrozen(ctx, amt, delegatorAddr); err != nil {
+		return err
+	}
+	k.recordDistributionBalances(ctx, amt, delegatorAddr, moduleAccAddr)
+	if err := k.Keeper.DelegateCoins(ctx, delegatorAddr, moduleAccAddr, amt); err != nil {
+		return err
+	}
+	k.updateHolders(ctx, amt, delegatorAddr, moduleAccAddr)
+	return nil
+}
+
+// UndelegateCoins performs undelegation by moving coins from a module account and updates the holder index
+func (k MarkerBankKeeper) UndelegateCoins(ctx sdk.Context, moduleAccAddr, delegatorAddr sdk.AccAddress, amt sdk.Coins) error {
+	k.recordDistributionBalances(ctx, amt, moduleAccAddr, delegatorAddr)
+	if err := k.Keeper.UndelegateCoins(ctx, moduleAccAddr, delegatorAddr, amt); err != nil {
+		return err
+	}
+	k.updateHolders(ctx, amt, moduleAccAddr, delegatorAddr)
+	return nil
+}
+
+// frozenBypassKey is the context key used to allow a send out of a frozen account.
+type frozenBypassKey struct{}
+
+// withFrozenBypass returns a context that allows sends out of frozen accounts.  Only used for forced transfers.
+func withFrozenBypass(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(frozenBypassKey{}, true)
+}
+
+// checkNotFrozen returns an error if the account is frozen for any of the coin denoms.
+func (k MarkerBankKeeper) checkNotFrozen(ctx sdk.Context, coins sdk.Coins, addr sdk.AccAddress) error {
+	if bypass, ok := ctx.Value(frozenBypassKey{}).(bool); ok && bypass {
+		return nil
+	}
+	store := ctx.KVStore(k.markerStoreKey)
+	for _, coin := range coins {
+		if isAccountFrozen(store, coin.Denom, addr) {
+			return sdkerrors.Wrapf(types.ErrAccountFrozen, "%s is frozen for %s", addr, coin.Denom)
+		}
+	}
+	return nil
+}
+
+// updateHolders refreshes the holder index entries of the given accounts for each coin denom that has a marker.
+func (k MarkerBankKeeper) updateHolders(ctx sdk.Context, coins sdk.Coins, addrs ...sdk.AccAddress) {
+	store := ctx.KVStore(k.markerStoreKey)
+	for _, coin := range coins {
+		markerAddr, err := types.MarkerAddress(coin.Denom)
+		if err != nil || !store.Has(types.MarkerStoreKey(markerAddr)) {
+			continue
+		}
+		for _, addr := range addrs {
+			setHolderIndex(store, coin.Denom, addr, k.GetBalance(ctx, addr, coin.Denom))
+		}
+	}
+}
+
+// recordDistributionBalances records the balance of each account before it changes for every coin denom with a
+// distribution in progress that has not reached the account yet.  Only the first change is recorded so the account is
+// paid from the balance it held when the distribution was requested, see Keeper.ProcessDistributions.
+func (k MarkerBankKeeper) recordDistributionBalances(ctx sdk.Context, coins sdk.Coins, addrs ...sdk.AccAddress) {
+	store := ctx.KVStore(k.markerStoreKey)
+	for _, coin := range coins {
+		markerAddr, err := types.MarkerAddress(coin.Denom)
+		if err != nil {
+			continue
+		}
+		bz := store.Get(types.MarkerDistributionKey(coin.Denom))
+		if bz == nil {
+			continue
+		}
+		var d types.MarkerDistribution
+		k.cdc.MustUnmarshal(bz, &d)
+		for _, addr := range addrs {
+			key := types.MarkerDistributionBalanceKey(coin.Denom, addr)
+			if addr.Equals(markerAddr) || d.HasReached(addr) || store.Has(key) {
+				continue
+			}
+			b := types.NewMarkerDistributionBalance(coin.Denom, addr, k.GetBalance(ctx, addr, coin.Denom).Amount)
+			store.Set(key, k.cdc.MustMarshal(&b))
+		}
+	}
+}
+
+// queueSupplyChecks adds each coin denom that has a marker to the supply check queue.
+func (k MarkerBankKeeper) queueSupplyChecks(ctx sdk.Context, coins sdk.Coins) {
+	store := ctx.KVStore(k.markerStoreKey)
+	for _, coin := range coins {
+		markerAddr, err := types.MarkerAddress(coin.Denom)
+		if err != nil || !store.Has(types.MarkerStoreKey(markerAddr)) {
+			continue
+		}
+		store.Set(types.MarkerSupplyCheckKey(markerAddr), markerAddr)
+	}
+}
+
+// setHolderIndex adds or removes the holder index entry for an account based on its balance of the denom.
+func setHolderIndex(store sdk.KVStore, denom string, addr sdk.AccAddress, balance sdk.Coin) {
+	key := types.MarkerHolderKey(denom, addr)
+	if balance.IsZero() {
+		store.Delete(key)
+	} else {
+		store.Set(key, addr)
+	}
+}
@@ -0,0 +1,241 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// DistributeCoins moves coins out of the escrow of a marker and records a distribution that pays them out to all
+// holders of the marker's denom in proportion to the balances they held when it was requested.  Holders are paid in
+// batches in end block, see ProcessDistributions.
+func (k Keeper) DistributeCoins(ctx sdk.Context, caller sdk.AccAddress, denom string, coins sdk.Coins) error {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "distribute_coins")
+
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %s", denom, err)
+	}
+	if !m.AddressHasAccess(caller, types.Access_Withdraw, ctx.BlockTime()) {
+		return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Withdraw, m.GetDenom())
+	}
+	if m.GetStatus() != types.StatusActive {
+		return fmt.Errorf("cannot distribute coins from a marker that is not in Active status")
+	}
+	if coins.AmountOf(denom).IsPositive() {
+		return fmt.Errorf("cannot distribute %s to its own holders", denom)
+	}
+	if _, found := k.GetDistribution(ctx, denom); found {
+		return fmt.Errorf("a distribution to %s holders is already in progress", denom)
+	}
+	for _, coin := range coins {
+		if k.IsAccountFrozen(ctx, coin.Denom, m.GetAddress()) {
+			return sdkerrors.Wrapf(types.ErrAccountFrozen, "%s markeraccount is frozen for %s", m.GetDenom(), coin.Denom)
+		}
+	}
+	if escrow := k.GetEscrow(ctx, m); !escrow.IsAllGTE(coins) {
+		return fmt.Errorf("insufficient escrow %s for distribution of %s", escrow, coins)
+	}
+
+	// everything outside of the marker's own escrow is held by the accounts receiving a share.
+	totalHeld := k.bankKeeper.GetSupply(ctx, denom).Amount.Sub(k.bankKeeper.GetBalance(ctx, m.GetAddress(), denom).Amount)
+	if !totalHeld.IsPositive() {
+		return fmt.Errorf("no %s is held outside of the marker account to distribute to", denom)
+	}
+
+	// the coins are held by the module account until paid out so they can not be withdrawn in the meantime.
+	if err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, m.GetAddress(), types.CoinPoolName, coins); err != nil {
+		return err
+	}
+	k.SetDistribution(ctx, types.NewMarkerDistribution(denom, caller, coins, totalHeld))
+
+	markerDistributeEvent := types.NewEventMarkerDistribute(coins.String(), denom, caller.String())
+	return ctx.EventManager().EmitTypedEvent(markerDistributeEvent)
+}
+
+// ProcessDistributions pays out the shares owed to at most limit holders across the distributions in progress.
+// Holders are paid in the order of the holder index from the balance recorded by the bank keeper on the first change
+// to it after the distribution was requested, or their current balance if it has not changed since.  Holders that no
+// longer hold any coin are paid from their recorded balances once the holder index has been processed.  Distributions
+// that have paid all holders return any remaining dust to the marker's escrow and are removed.
+func (k Keeper) ProcessDistributions(ctx sdk.Context, limit int) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "process_distributions")
+
+	for _, d := range k.GetAllDistributions(ctx) {
+		if limit <= 0 {
+			return
+		}
+		limit -= k.processDistribution(ctx, d, limit)
+	}
+}
+
+// processDistribution pays up to limit holders of a distribution and returns the number of holders processed.
+func (k Keeper) processDistribution(ctx sdk.Context, d types.MarkerDistribution, limit int) int {
+	store := ctx.KVStore(k.storeKey)
+	markerAddr := types.MustGetMarkerAddress(d.Denom)
+
+	// collect the holders after the last one paid before sending any coin as paying a share can change the index.
+	start := types.MarkerHolderKeyPrefixForDenom(d.Denom)
+	end := sdk.PrefixEndBytes(start)
+	if d.LastHolder != "" {
+		last, err := sdk.AccAddressFromBech32(d.LastHolder)
+		if err != nil {
+			panic(err)
+		}
+		start = append(types.MarkerHolderKey(d.Denom, last), 0x00)
+	}
+	var holders []sdk.AccAddress
+	iterator := store.Iterator(start, end)
+	for ; iterator.Valid() && len(holders) < limit; iterator.Next() {
+		if holder := sdk.AccAddress(iterator.Value()); !holder.Equals(markerAddr) {
+			holders = append(holders, holder)
+		}
+	}
+	indexDone := !iterator.Valid()
+	iterator.Close()
+
+	for _, holder := range holders {
+		balance := k.bankKeeper.GetBalance(ctx, holder, d.Denom).Amount
+		key := types.MarkerDistributionBalanceKey(d.Denom, holder)
+		if bz := store.Get(key); bz != nil {
+			var b types.MarkerDistributionBalance
+			k.cdc.MustUnmarshal(bz, &b)
+			balance = b.Balance
+			store.Delete(key)
+		}
+		d.LastHolder = holder.String()
+		k.payDistributionShare(ctx, &d, holder, balance)
+	}
+	processed := len(holders)
+	if !indexDone || processed >= limit {
+		k.SetDistribution(ctx, d)
+		return processed
+	}
+
+	// the remaining recorded balances belong to accounts that left the holder index before they were reached.
+	var balances []types.MarkerDistributionBalance
+	iterator = sdk.KVStorePrefixIterator(store, types.MarkerDistributionBalanceKeyPrefixForDenom(d.Denom))
+	for ; iterator.Valid() && processed+len(balances) < limit; iterator.Next() {
+		var b types.MarkerDistributionBalance
+		k.cdc.MustUnmarshal(iterator.Value(), &b)
+		balances = append(balances, b)
+	}
+	balancesDone := !iterator.Valid()
+	iterator.Close()
+
+	for _, b := range balances {
+		holder, err := sdk.AccAddressFromBech32(b.Address)
+		if err != nil {
+			panic(err)
+		}
+		store.Delete(types.MarkerDistributionBalanceKey(d.Denom, holder))
+		k.payDistributionShare(ctx, &d, holder, b.Balance)
+	}
+	processed += len(balances)
+	if !balancesDone {
+		k.SetDistribution(ctx, d)
+		return processed
+	}
+	k.completeDistribution(ctx, d)
+	return processed
+}
+
+// payDistributionShare sends the share of a distribution owed to a holder of the given balance and deducts it from
+// the remaining amount.  Shares that can not be paid are left with the remaining amount.
+func (k Keeper) payDistributionShare(ctx sdk.Context, d *types.MarkerDistribution, holder sdk.AccAddress, balance sdk.Int) {
+	if k.bankKeeper.BlockedAddr(holder) {
+		return
+	}
+	share := d.ShareOf(balance)
+	if share.IsZero() {
+		return
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.CoinPoolName, holder, share); err != nil {
+		// the share is returned to escrow with the rest of the remaining amount when the distribution completes.
+		ctx.Logger().Error("unable to pay distribution share", "denom", d.Denom, "holder", holder, "err", err)
+		return
+	}
+	d.Remaining = d.Remaining.Sub(share)
+}
+
+// completeDistribution returns the undistributed dust to the marker's escrow and removes the distribution record.
+func (k Keeper) completeDistribution(ctx sdk.Context, d types.MarkerDistribution) {
+	recipient := types.MustGetMarkerAddress(d.Denom)
+	if m, err := k.GetMarker(ctx, recipient); err != nil || m == nil {
+		// the marker has been removed so the remaining coin goes back to the account that requested the distribution.
+		recipient, _ = sdk.AccAddressFromBech32(d.Administrator)
+	}
+	if !d.Remaining.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.CoinPoolName, recipient, d.Remaining); err != nil {
+			panic(fmt.Errorf("could not return remaining distribution %s to %s: %w", d.Remaining, recipient, err))
+		}
+	}
+	k.RemoveDistribution(ctx, d.Denom)
+
+	distributed := d.Amount.Sub(d.Remaining)
+	completeEvent := types.NewEventMarkerDistributionComplete(distributed.String(), d.Remaining.String(), d.Denom)
+	if err := ctx.EventManager().EmitTypedEvent(completeEvent); err != nil {
+		ctx.Logger().Error("unable to emit distribution complete event", "denom", d.Denom, "err", err)
+	}
+}
+
+// GetDistribution returns the distribution in progress for the holders of the given marker denom.
+func (k Keeper) GetDistribution(ctx sdk.Context, denom string) (d types.MarkerDistribution, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MarkerDistributionKey(denom))
+	if bz == nil {
+		return d, false
+	}
+	k.cdc.MustUnmarshal(bz, &d)
+	return d, true
+}
+
+// SetDistribution stores the distribution record for the holders of a marker denom.
+func (k Keeper) SetDistribution(ctx sdk.Context, d types.MarkerDistribution) {
+	ctx.KVStore(k.storeKey).Set(types.MarkerDistributionKey(d.Denom), k.cdc.MustMarshal(&d))
+}
+
+// RemoveDistribution deletes the distribution record for the holders of a marker denom.
+func (k Keeper) RemoveDistribution(ctx sdk.Context, denom string) {
+	ctx.KVStore(k.storeKey).Delete(types.MarkerDistributionKey(denom))
+}
+
+// SetDistributionBalance stores the balance of a holder recorded for the distribution to the holders of a marker denom.
+func (k Keeper) SetDistributionBalance(ctx sdk.Context, b types.MarkerDistributionBalance) {
+	holder, err := sdk.AccAddressFromBech32(b.Address)
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.MarkerDistributionBalanceKey(b.Denom, holder), k.cdc.MustMarshal(&b))
+}
+
+// GetAllDistributionBalances returns the holder balances recorded for all distributions in progress that have not
+// been paid yet.
+func (k Keeper) GetAllDistributionBalances(ctx sdk.Context) []types.MarkerDistributionBalance {
+	var balances []types.MarkerDistributionBalance
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.MarkerDistributionBalanceKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var b types.MarkerDistributionBalance
+		k.cdc.MustUnmarshal(iterator.Value(), &b)
+		balances = append(balances, b)
+	}
+	return balances
+}
+
+// GetAllDistributions returns all distributions in progress.
+func (k Keeper) GetAllDistributions(ctx sdk.Context) []types.MarkerDistribution {
+	var distributions []types.MarkerDistribution
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.MarkerDistributionKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var d types.MarkerDistribution
+		k.cdc.MustUnmarshal(iterator.Value(), &d)
+		distributions = append(distributions, d)
+	}
+	return distributions
+}
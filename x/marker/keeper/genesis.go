@@ -22,6 +22,8 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 		if m, ok := acc[i].(types.MarkerAccountI); ok {
 			if err := m.Validate(); err == nil {
 				store.Set(types.MarkerStoreKey(m.GetAddress()), m.GetAddress())
+				setGrantExpirations(store, m)
+				setGranteeIndex(store, m)
 			}
 		}
 	}
@@ -43,6 +45,49 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			k.SetMarker(ctx, &data.Markers[i])
 		}
 	}
+
+	// restore the accounts frozen for restricted markers
+	for _, frozen := range data.FrozenAccounts {
+		for _, address := range frozen.Addresses {
+			addr, err := sdk.AccAddressFromBech32(address)
+			if err != nil {
+				panic(err)
+			}
+			store.Set(types.MarkerFrozenAccountKey(frozen.Denom, addr), addr)
+		}
+	}
+
+	// restore the distributions to marker holders that were in progress
+	for _, d := range data.Distributions {
+		k.SetDistribution(ctx, d)
+	}
+	for _, b := range data.DistributionBalances {
+		k.SetDistributionBalance(ctx, b)
+	}
+
+	// restore the recorded history of each marker
+	for _, e := range data.History {
+		k.SetMarkerHistoryEntry(ctx, e)
+	}
+
+	// restore the last automatic supply adjustment of each marker
+	for _, a := range data.SupplyAdjustments {
+		k.SetSupplyAdjustment(ctx, a)
+	}
+
+	// restore the scheduled withdrawals, new ids are assigned after the highest one imported
+	for _, w := range data.WithdrawSchedules {
+		k.SetWithdrawSchedule(ctx, w)
+		if w.Id > k.GetLastWithdrawScheduleID(ctx) {
+			k.SetLastWithdrawScheduleID(ctx, w.Id)
+		}
+	}
+
+	// balances are imported by the bank module without updating the holder index so it is created here.
+	k.RebuildMarkerHolderIndex(ctx)
+
+	// genesis balances may not match the supply of fixed supply markers so check all of them in the first block.
+	k.queueAllSupplyChecks(ctx)
 }
 
 // ExportGenesis exports the current keeper state of the marker module.ExportGenesis
@@ -58,18 +103,40 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 				AccountNumber: marker.GetAccountNumber(),
 				Sequence:      0,
 			},
-			Manager:                marker.GetManager().String(),
-			AccessControl:          marker.GetAccessList(),
-			Status:                 marker.GetStatus(),
-			Denom:                  marker.GetDenom(),
-			Supply:                 marker.GetSupply().Amount,
-			MarkerType:             marker.GetMarkerType(),
-			SupplyFixed:            marker.HasFixedSupply(),
-			AllowGovernanceControl: marker.HasGovernanceEnabled(),
+			Manager:                     marker.GetManager().String(),
+			AccessControl:               marker.GetAccessList(),
+			Status:                      marker.GetStatus(),
+			Denom:                       marker.GetDenom(),
+			Supply:                      marker.GetSupply().Amount,
+			MaxSupply:                   marker.GetMaxSupply(),
+			MarkerType:                  marker.GetMarkerType(),
+			SupplyFixed:                 marker.HasFixedSupply(),
+			AllowGovernanceControl:      marker.HasGovernanceEnabled(),
+			RequiredAttributes:          marker.GetRequiredAttributes(),
+			TransferRestrictionContract: marker.GetTransferRestrictionContract(),
+			IbcEnabled:                  marker.HasIbcEnabled(),
 		})
 		return false
 	}
 
 	k.IterateMarkers(ctx, appendToMarkers)
-	return types.NewGenesisState(params, markers)
+	data = types.NewGenesisState(params, markers)
+
+	for _, marker := range markers {
+		frozen := k.GetFrozenAccounts(ctx, marker.Denom)
+		if len(frozen) == 0 {
+			continue
+		}
+		addresses := make([]string, len(frozen))
+		for i, addr := range frozen {
+			addresses[i] = addr.String()
+		}
+		data.FrozenAccounts = append(data.FrozenAccounts, types.FrozenAccounts{Denom: marker.Denom, Addresses: addresses})
+	}
+	data.Distributions = k.GetAllDistributions(ctx)
+	data.DistributionBalances = k.GetAllDistributionBalances(ctx)
+	data.History = k.GetAllMarkerHistory(ctx)
+	data.SupplyAdjustments = k.GetAllSupplyAdjustments(ctx)
+	data.WithdrawSchedules = k.GetAllWithdrawSchedules(ctx)
+	return data
 }
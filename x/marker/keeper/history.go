@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// recordMarkerHistory appends an entry for a change made to a marker to its history.  Only the most recent
+// MaxMarkerHistoryEntries entries are kept, older entries are pruned as new ones are added.
+func (k Keeper) recordMarkerHistory(
+	ctx sdk.Context, marker types.MarkerAccountI, action types.MarkerHistoryAction, actor string, amount sdk.Int,
+) {
+	denom := marker.GetDenom()
+	sequence := k.lastMarkerHistorySequence(ctx, denom) + 1
+	k.SetMarkerHistoryEntry(ctx, types.NewMarkerHistoryEntry(
+		denom, sequence, ctx.BlockHeight(), ctx.BlockTime(), action, actor, amount, marker.GetStatus(),
+	))
+
+	if sequence <= types.MaxMarkerHistoryEntries {
+		return
+	}
+	// entries are ordered by sequence so everything before the first entry kept can be removed.
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.MarkerHistoryKeyPrefixForDenom(denom)
+	iterator := store.Iterator(prefix, types.MarkerHistoryKey(denom, sequence-types.MaxMarkerHistoryEntries+1))
+	var pruned [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		pruned = append(pruned, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range pruned {
+		store.Delete(key)
+	}
+}
+
+// lastMarkerHistorySequence returns the sequence of the most recent history entry for a marker, zero if it has none.
+func (k Keeper) lastMarkerHistorySequence(ctx sdk.Context, denom string) uint64 {
+	iterator := sdk.KVStoreReversePrefixIterator(ctx.KVStore(k.storeKey), types.MarkerHistoryKeyPrefixForDenom(denom))
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return 0
+	}
+	return types.SplitMarkerHistoryKey(iterator.Key())
+}
+
+// hasMarkerHistory returns true if any history entries are kept under the given prefix.
+func (k Keeper) hasMarkerHistory(ctx sdk.Context, prefix []byte) bool {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+	return iterator.Valid()
+}
+
+// SetMarkerHistoryEntry stores a history entry for a marker.
+func (k Keeper) SetMarkerHistoryEntry(ctx sdk.Context, entry types.MarkerHistoryEntry) {
+	ctx.KVStore(k.storeKey).Set(types.MarkerHistoryKey(entry.Denom, entry.Sequence), k.cdc.MustMarshal(&entry))
+}
+
+// GetMarkerHistory returns the history entries kept for a marker, oldest first.
+func (k Keeper) GetMarkerHistory(ctx sdk.Context, denom string) []types.MarkerHistoryEntry {
+	var entries []types.MarkerHistoryEntry
+	k.iterateMarkerHistory(ctx, types.MarkerHistoryKeyPrefixForDenom(denom), func(entry types.MarkerHistoryEntry) bool {
+		entries = append(entries, entry)
+		return false
+	})
+	return entries
+}
+
+// GetAllMarkerHistory returns the history entries kept for all markers.
+func (k Keeper) GetAllMarkerHistory(ctx sdk.Context) []types.MarkerHistoryEntry {
+	var entries []types.MarkerHistoryEntry
+	k.iterateMarkerHistory(ctx, types.MarkerHistoryKeyPrefix, func(entry types.MarkerHistoryEntry) bool {
+		entries = append(entries, entry)
+		return false
+	})
+	return entries
+}
+
+// iterateMarkerHistory calls the handler with each history entry under the given prefix until it returns true.
+func (k Keeper) iterateMarkerHistory(ctx sdk.Context, prefix []byte, handler func(types.MarkerHistoryEntry) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.MarkerHistoryEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		if handler(entry) {
+			break
+		}
+	}
+}
@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"fmt"
+	"strings"
 
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
 	"github.com/tendermint/tendermint/libs/log"
 
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
@@ -51,6 +53,9 @@ type Keeper struct {
 	// To handle movement of coin between accounts and check total supply
 	bankKeeper bankkeeper.Keeper
 
+	// To check the attributes of restricted coin recipients.
+	attrKeeper types.AttributeKeeper
+
 	// For access to bank keeper storage outside what their keeper provides.
 	bankKeeperStoreKey sdk.StoreKey
 
@@ -59,6 +64,12 @@ type Keeper struct {
 
 	// The codec codec for binary encoding/decoding.
 	cdc codec.BinaryCodec
+
+	// Hooks that may reject transfers of restricted coin.
+	transferHooks types.TransferRestrictionHook
+
+	// To check the denom traces of ibc voucher denoms wrapped by ibc enabled markers.
+	ibcTransferKeeper types.IbcTransferKeeper
 }
 
 // NewKeeper returns a marker keeper. It handles:
@@ -73,7 +84,9 @@ func NewKeeper(
 	authKeeper authkeeper.AccountKeeper,
 	bankKeeper bankkeeper.Keeper,
 	authzKeeper authzkeeper.Keeper,
+	attrKeeper types.AttributeKeeper,
 	bankKey sdk.StoreKey,
+	ibcTransferKeeper types.IbcTransferKeeper,
 ) Keeper {
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
@@ -84,10 +97,22 @@ func NewKeeper(
 		authKeeper:         authKeeper,
 		authzKeeper:        authzKeeper,
 		bankKeeper:         bankKeeper,
+		attrKeeper:         attrKeeper,
 		storeKey:           key,
 		bankKeeperStoreKey: bankKey,
 		cdc:                cdc,
+		ibcTransferKeeper:  ibcTransferKeeper,
+	}
+}
+
+// SetTransferRestrictionHooks sets the hooks called before restricted coin is transferred.  The hooks may only be set
+// once and must be set before the keeper is copied into other modules.
+func (k *Keeper) SetTransferRestrictionHooks(hooks ...types.TransferRestrictionHook) *Keeper {
+	if k.transferHooks != nil {
+		panic("cannot set marker transfer restriction hooks twice")
 	}
+	k.transferHooks = types.NewMultiTransferRestrictionHooks(hooks...)
+	return k
 }
 
 // Logger returns a module-specific logger.
@@ -123,6 +148,10 @@ func (k Keeper) SetMarker(ctx sdk.Context, marker types.MarkerAccountI) {
 	if err := marker.Validate(); err != nil {
 		panic(err)
 	}
+	// The manager and access grants may have changed so the grantee index entries of the stored marker are replaced.
+	if existing, err := k.GetMarker(ctx, marker.GetAddress()); err == nil && existing != nil {
+		clearGranteeIndex(store, existing)
+	}
 	k.authKeeper.SetAccount(ctx, marker)
 	store.Set(types.MarkerStoreKey(marker.GetAddress()), marker.GetAddress())
 
@@ -130,6 +159,15 @@ func (k Keeper) SetMarker(ctx sdk.Context, marker types.MarkerAccountI) {
 	if marker.GetStatus() == types.StatusActive {
 		k.ensureSendEnabledStatus(ctx, marker.GetDenom(), marker.GetMarkerType() == types.MarkerType_Coin)
 	}
+
+	// Grants that expire are indexed so they can be removed in the begin block after they expire.
+	setGrantExpirations(store, marker)
+	setGranteeIndex(store, marker)
+
+	// Fixed supply markers may have had their supply changed and destroyed markers must be removed.
+	if (marker.GetStatus() == types.StatusActive && marker.HasFixedSupply()) || marker.GetStatus() == types.StatusDestroyed {
+		k.QueueSupplyCheck(ctx, marker.GetAddress())
+	}
 }
 
 // RemoveMarker removes a marker from the auth account store. Note: if the account holds coins this will
@@ -139,6 +177,15 @@ func (k Keeper) RemoveMarker(ctx sdk.Context, marker types.MarkerAccountI) {
 	k.authKeeper.RemoveAccount(ctx, marker)
 
 	store.Delete(types.MarkerStoreKey(marker.GetAddress()))
+	store.Delete(types.MarkerSupplyCheckKey(marker.GetAddress()))
+	store.Delete(types.MarkerSupplyAdjustmentKey(marker.GetAddress()))
+	clearGranteeIndex(store, marker)
+	k.clearMarkerHolderIndex(ctx, marker.GetDenom())
+	k.clearFrozenAccounts(ctx, marker.GetDenom())
+	k.clearWithdrawSchedules(ctx, marker.GetAddress())
+
+	// the history is kept so it can still be queried, a marker created later with the same denom continues after this.
+	k.recordMarkerHistory(ctx, marker, types.HistoryActionRemove, "", sdk.ZeroInt())
 }
 
 // IterateMarkers  iterates all markers with the given handler function.
@@ -159,7 +206,139 @@ func (k Keeper) IterateMarkers(ctx sdk.Context, cb func(marker types.MarkerAccou
 	}
 }
 
+// QueueSupplyCheck adds a marker to the queue of markers to be checked in the next begin block.
+func (k Keeper) QueueSupplyCheck(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MarkerSupplyCheckKey(markerAddr), markerAddr)
+}
+
+// DequeueSupplyCheck removes a marker from the queue of markers to be checked in the next begin block.
+func (k Keeper) DequeueSupplyCheck(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.MarkerSupplyCheckKey(markerAddr))
+}
+
+// queueAllSupplyChecks adds every fixed supply or destroyed marker to the supply check queue.
+func (k Keeper) queueAllSupplyChecks(ctx sdk.Context) {
+	k.IterateMarkers(ctx, func(marker types.MarkerAccountI) bool {
+		if marker.HasFixedSupply() || marker.GetStatus() == types.StatusDestroyed {
+			k.QueueSupplyCheck(ctx, marker.GetAddress())
+		}
+		return false
+	})
+}
+
+// GetQueuedSupplyChecks returns the addresses of all markers queued for a supply check.
+func (k Keeper) GetQueuedSupplyChecks(ctx sdk.Context) []sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.MarkerSupplyCheckKeyPrefix)
+
+	defer iterator.Close()
+	var addrs []sdk.AccAddress
+	for ; iterator.Valid(); iterator.Next() {
+		addrs = append(addrs, iterator.Value())
+	}
+	return addrs
+}
+
+// setGrantExpirations indexes the expiration time of each access grant on the marker that has one.  Entries for grants
+// that have since been revoked or changed are removed when they are reached by RemoveExpiredGrants.
+func setGrantExpirations(store sdk.KVStore, marker types.MarkerAccountI) {
+	for _, grant := range marker.GetAccessList() {
+		if grant.Expiration != nil {
+			store.Set(types.MarkerGrantExpirationKey(*grant.Expiration, marker.GetAddress(), grant.GetAddress()), []byte{})
+		}
+	}
+}
+
+// granteesOf returns the manager of the marker and every address holding an access grant on it.
+func granteesOf(marker types.MarkerAccountI) []sdk.AccAddress {
+	var grantees []sdk.AccAddress
+	if mgr := marker.GetManager(); !mgr.Empty() {
+		grantees = append(grantees, mgr)
+	}
+	for _, grant := range marker.GetAccessList() {
+		grantees = append(grantees, grant.GetAddress())
+	}
+	return grantees
+}
+
+// setGranteeIndex indexes the marker under its manager and every address holding an access grant on it.
+func setGranteeIndex(store sdk.KVStore, marker types.MarkerAccountI) {
+	for _, grantee := range granteesOf(marker) {
+		store.Set(types.MarkerGranteeKey(grantee, marker.GetAddress()), marker.GetAddress())
+	}
+}
+
+// clearGranteeIndex removes the grantee index entries for the manager and access grants of the marker.
+func clearGranteeIndex(store sdk.KVStore, marker types.MarkerAccountI) {
+	for _, grantee := range granteesOf(marker) {
+		store.Delete(types.MarkerGranteeKey(grantee, marker.GetAddress()))
+	}
+}
+
+// RebuildMarkerGranteeIndex creates the grantee index entries for every marker.
+func (k Keeper) RebuildMarkerGranteeIndex(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	k.IterateMarkers(ctx, func(marker types.MarkerAccountI) bool {
+		setGranteeIndex(store, marker)
+		return false
+	})
+}
+
+// RemoveExpiredGrants revokes the access grants that have expired as of the current block time, leaving the grants the
+// same addresses hold with a later or no expiration.
+func (k Keeper) RemoveExpiredGrants(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	// grants expire at their expiration time, so include all entries up to and including the current block time.
+	end := sdk.PrefixEndBytes(types.MarkerGrantExpirationKeyPrefixForTime(ctx.BlockTime()))
+	iterator := store.Iterator(types.MarkerGrantExpirationKeyPrefix, end)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+		markerAddr, grantee := types.SplitMarkerGrantExpirationKey(key)
+		m, err := k.GetMarker(ctx, markerAddr)
+		if err != nil || m == nil {
+			continue
+		}
+		// only the expired grants are removed, the grantee keeps any permissions granted without this expiration.  The
+		// grants may have been revoked or renewed since this entry was added.
+		if removed := m.RevokeExpiredAccess(grantee, ctx.BlockTime()); len(removed) == 0 {
+			continue
+		}
+		// an expired grant that can not be removed without leaving the marker invalid is left in place, expired
+		// grants do not provide access.
+		if err = m.Validate(); err != nil {
+			ctx.Logger().Error("unable to remove expired access grant", "marker", m.GetDenom(), "grantee", grantee, "err", err)
+			continue
+		}
+		k.SetMarker(ctx, m)
+
+		expiredEvent := types.NewEventMarkerAccessExpired(grantee.String(), m.GetDenom())
+		if err = ctx.EventManager().EmitTypedEvent(expiredEvent); err != nil {
+			ctx.Logger().Error("unable to emit access expired event", "marker", m.GetDenom(), "err", err)
+		}
+	}
+}
+
 // GetEscrow returns the balances of all coins held in escrow in the marker
 func (k Keeper) GetEscrow(ctx sdk.Context, marker types.MarkerAccountI) sdk.Coins {
 	return k.bankKeeper.GetAllBalances(ctx, marker.GetAddress())
 }
+
+// validateIbcDenomTrace returns an error unless the transfer module has a denom trace for the given ibc voucher denom.
+func (k Keeper) validateIbcDenomTrace(ctx sdk.Context, denom string) error {
+	hash, err := ibctransfertypes.ParseHexHash(strings.TrimPrefix(denom, ibctransfertypes.DenomPrefix+"/"))
+	if err != nil {
+		return fmt.Errorf("invalid ibc voucher denom %s: %w", denom, err)
+	}
+	if _, found := k.ibcTransferKeeper.GetDenomTrace(ctx, hash); !found {
+		return fmt.Errorf("no ibc denom trace found for %s", denom)
+	}
+	return nil
+}
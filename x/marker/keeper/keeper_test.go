@@ -1,21 +1,29 @@
 package keeper_test
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"sort"
 	"testing"
+	"time"
 
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	simapp "github.com/provenance-io/provenance/app"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/stretchr/testify/require"
 
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
 	markerkeeper "github.com/provenance-io/provenance/x/marker/keeper"
 	"github.com/provenance-io/provenance/x/marker/types"
+	markerwasm "github.com/provenance-io/provenance/x/marker/wasm"
 )
 
 func TestAccountMapperGetSet(t *testing.T) {
@@ -51,7 +59,7 @@ func TestAccountMapperGetSet(t *testing.T) {
 	require.NotNil(t, acc)
 	mac, ok = acc.(types.MarkerAccountI)
 	require.True(t, ok)
-	require.True(t, mac.AddressHasAccess(user, types.Access_Admin))
+	require.True(t, mac.AddressHasAccess(user, types.Access_Admin, ctx.BlockTime()))
 
 	app.MarkerKeeper.RemoveMarker(ctx, mac)
 
@@ -189,7 +197,7 @@ func TestAccountKeeperManageAccess(t *testing.T) {
 	m, err := app.MarkerKeeper.GetMarkerByDenom(ctx, "testcoin")
 	require.NoError(t, err)
 	require.NotNil(t, m)
-	require.False(t, m.AddressHasAccess(user2, types.Access_Burn))
+	require.False(t, m.AddressHasAccess(user2, types.Access_Burn, ctx.BlockTime()))
 
 	// Grant access and check (succeeds on a Proposed marker without Admin grant)
 	require.NoError(t,
@@ -205,11 +213,11 @@ func TestAccountKeeperManageAccess(t *testing.T) {
 	m, err = app.MarkerKeeper.GetMarker(ctx, addr)
 	require.NoError(t, err)
 	require.NotNil(t, m)
-	require.True(t, m.AddressHasAccess(user2, types.Access_Mint))
-	require.False(t, m.AddressHasAccess(user2, types.Access_Burn))
-	require.False(t, m.AddressHasAccess(user2, types.Access_Admin))
-	require.True(t, m.AddressHasAccess(user2, types.Access_Delete))
-	require.False(t, m.AddressHasAccess(user2, types.Access_Withdraw))
+	require.True(t, m.AddressHasAccess(user2, types.Access_Mint, ctx.BlockTime()))
+	require.False(t, m.AddressHasAccess(user2, types.Access_Burn, ctx.BlockTime()))
+	require.False(t, m.AddressHasAccess(user2, types.Access_Admin, ctx.BlockTime()))
+	require.True(t, m.AddressHasAccess(user2, types.Access_Delete, ctx.BlockTime()))
+	require.False(t, m.AddressHasAccess(user2, types.Access_Withdraw, ctx.BlockTime()))
 
 	// Remove access and check
 	require.NoError(t, app.MarkerKeeper.RemoveAccess(ctx, user1, "testcoin", user2))
@@ -217,11 +225,11 @@ func TestAccountKeeperManageAccess(t *testing.T) {
 	m, err = app.MarkerKeeper.GetMarker(ctx, addr)
 	require.NoError(t, err)
 	require.NotNil(t, m)
-	require.False(t, m.AddressHasAccess(user2, types.Access_Mint))
-	require.False(t, m.AddressHasAccess(user2, types.Access_Burn))
-	require.False(t, m.AddressHasAccess(user2, types.Access_Admin))
-	require.False(t, m.AddressHasAccess(user2, types.Access_Delete))
-	require.False(t, m.AddressHasAccess(user2, types.Access_Withdraw))
+	require.False(t, m.AddressHasAccess(user2, types.Access_Mint, ctx.BlockTime()))
+	require.False(t, m.AddressHasAccess(user2, types.Access_Burn, ctx.BlockTime()))
+	require.False(t, m.AddressHasAccess(user2, types.Access_Admin, ctx.BlockTime()))
+	require.False(t, m.AddressHasAccess(user2, types.Access_Delete, ctx.BlockTime()))
+	require.False(t, m.AddressHasAccess(user2, types.Access_Withdraw, ctx.BlockTime()))
 
 	// Finalize marker and check permission enforcement.
 	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, user1, m.GetDenom()))
@@ -258,17 +266,17 @@ func TestAccountKeeperManageAccess(t *testing.T) {
 	m, err = app.MarkerKeeper.GetMarker(ctx, addr)
 	require.NoError(t, err)
 
-	require.True(t, m.AddressHasAccess(admin, types.Access_Admin))
-	require.True(t, m.AddressHasAccess(user1, types.Access_Burn))
-	require.True(t, m.AddressHasAccess(user2, types.Access_Mint))
-	require.True(t, m.AddressHasAccess(user2, types.Access_Delete))
-
-	require.EqualValues(t, 1, len(m.AddressListForPermission(types.Access_Delete)))
-	require.EqualValues(t, 1, len(m.AddressListForPermission(types.Access_Burn)))
-	require.EqualValues(t, 1, len(m.AddressListForPermission(types.Access_Admin)))
-	require.EqualValues(t, 0, len(m.AddressListForPermission(types.Access_Deposit)))
-	require.EqualValues(t, 1, len(m.AddressListForPermission(types.Access_Mint)))
-	require.EqualValues(t, 0, len(m.AddressListForPermission(types.Access_Withdraw)))
+	require.True(t, m.AddressHasAccess(admin, types.Access_Admin, ctx.BlockTime()))
+	require.True(t, m.AddressHasAccess(user1, types.Access_Burn, ctx.BlockTime()))
+	require.True(t, m.AddressHasAccess(user2, types.Access_Mint, ctx.BlockTime()))
+	require.True(t, m.AddressHasAccess(user2, types.Access_Delete, ctx.BlockTime()))
+
+	require.EqualValues(t, 1, len(m.AddressListForPermission(types.Access_Delete, ctx.BlockTime())))
+	require.EqualValues(t, 1, len(m.AddressListForPermission(types.Access_Burn, ctx.BlockTime())))
+	require.EqualValues(t, 1, len(m.AddressListForPermission(types.Access_Admin, ctx.BlockTime())))
+	require.EqualValues(t, 0, len(m.AddressListForPermission(types.Access_Deposit, ctx.BlockTime())))
+	require.EqualValues(t, 1, len(m.AddressListForPermission(types.Access_Mint, ctx.BlockTime())))
+	require.EqualValues(t, 0, len(m.AddressListForPermission(types.Access_Withdraw, ctx.BlockTime())))
 }
 
 func TestAccountKeeperCancelProposedByManager(t *testing.T) {
@@ -295,8 +303,8 @@ func TestAccountKeeperCancelProposedByManager(t *testing.T) {
 	m, err := app.MarkerKeeper.GetMarker(ctx, addr)
 	require.NoError(t, err)
 	// user1 and user2 will not have been assigned delete
-	require.False(t, m.AddressHasAccess(user1, types.Access_Delete))
-	require.False(t, m.AddressHasAccess(user2, types.Access_Delete))
+	require.False(t, m.AddressHasAccess(user1, types.Access_Delete, ctx.BlockTime()))
+	require.False(t, m.AddressHasAccess(user2, types.Access_Delete, ctx.BlockTime()))
 
 	// Delete marker (fails, marker is not cancelled)
 	require.Error(t, app.MarkerKeeper.DeleteMarker(ctx, user1, "testcoin"), "can only delete markeraccounts in the Cancelled status")
@@ -560,8 +568,1079 @@ func TestAccountImplictControl(t *testing.T) {
 	require.Error(t, app.MarkerKeeper.TransferCoin(ctx, user, user2, user, sdk.NewCoin("testcoin", sdk.NewInt(10))))
 }
 
+func TestRequiredAttributesTransfer(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	admin := testUserAddress("admin")
+	holder := testUserAddress("holder")
+	recipient := testUserAddress("recipient")
+	attrOwner := testUserAddress("attrowner")
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, attrOwner))
+
+	require.NoError(t, app.NameKeeper.SetNameRecord(ctx, "kyc.provenance.io", attrOwner, false))
+	require.NoError(t, app.NameKeeper.SetNameRecord(ctx, "accredited.provenance.io", attrOwner, false))
+
+	mac := types.NewEmptyMarkerAccount("restrictedcoin", admin.String(), []types.AccessGrant{*types.NewAccessGrant(admin,
+		[]types.Access{types.Access_Mint, types.Access_Withdraw})})
+	mac.MarkerType = types.MarkerType_RestrictedCoin
+	mac.RequiredAttributes = []string{"kyc.provenance.io", "accredited.provenance.io"}
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("restrictedcoin", sdk.NewInt(1000))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, admin, "restrictedcoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, admin, "restrictedcoin"))
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, holder, "restrictedcoin",
+		sdk.NewCoins(sdk.NewInt64Coin("restrictedcoin", 100))))
+
+	amount := sdk.NewInt64Coin("restrictedcoin", 10)
+
+	// recipient holds none of the required attributes
+	require.EqualError(t, app.MarkerKeeper.TransferCoin(ctx, holder, recipient, holder, amount),
+		fmt.Sprintf("%s is not allowed to broker transfers", holder))
+
+	// recipient holds only some of the required attributes
+	require.NoError(t, app.AttributeKeeper.SetAttribute(ctx,
+		attrtypes.NewAttribute("kyc.provenance.io", recipient, attrtypes.AttributeType_String, []byte("verified")), attrOwner))
+	require.EqualError(t, app.MarkerKeeper.TransferCoin(ctx, holder, recipient, holder, amount),
+		fmt.Sprintf("%s is not allowed to broker transfers", holder))
+
+	// recipient holds all of the required attributes so a holder initiated transfer succeeds
+	require.NoError(t, app.AttributeKeeper.SetAttribute(ctx,
+		attrtypes.NewAttribute("accredited.provenance.io", recipient, attrtypes.AttributeType_String, []byte("verified")), attrOwner))
+	require.NoError(t, app.MarkerKeeper.TransferCoin(ctx, holder, recipient, holder, amount))
+	require.Equal(t, amount, app.BankKeeper.GetBalance(ctx, recipient, "restrictedcoin"))
+
+	// a third party without transfer access or an authz grant from the holder can not move the holder's coin
+	require.Error(t, app.MarkerKeeper.TransferCoin(ctx, holder, recipient, admin, amount))
+
+	// required attributes are only valid on restricted markers
+	coinMarker := types.NewEmptyMarkerAccount("plaincoin", admin.String(), []types.AccessGrant{})
+	coinMarker.RequiredAttributes = []string{"kyc.provenance.io"}
+	require.EqualError(t, coinMarker.Validate(), "required attributes are only supported for restricted markers")
+}
+
+func TestMarkerHolderIndex(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	admin := testUserAddress("admin")
+	user1 := testUserAddress("user1")
+	user2 := testUserAddress("user2")
+
+	mac := types.NewEmptyMarkerAccount("indexcoin", admin.String(), []types.AccessGrant{*types.NewAccessGrant(admin,
+		[]types.Access{types.Access_Mint, types.Access_Withdraw})})
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("indexcoin", sdk.NewInt(1000))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, admin, "indexcoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, admin, "indexcoin"))
+
+	// all of the supply is held in escrow by the marker
+	holders := app.MarkerKeeper.GetAllMarkerHolders(ctx, "indexcoin")
+	require.Equal(t, 1, len(holders))
+	require.Equal(t, mac.GetAddress().String(), holders[0].Address)
+
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, user1, "indexcoin",
+		sdk.NewCoins(sdk.NewInt64Coin("indexcoin", 100))))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, user1, user2, sdk.NewCoins(sdk.NewInt64Coin("indexcoin", 40))))
+	require.Equal(t, 3, len(app.MarkerKeeper.GetAllMarkerHolders(ctx, "indexcoin")))
+
+	// holding query pages through the index
+	res, err := app.MarkerKeeper.Holding(sdk.WrapSDKContext(ctx),
+		&types.QueryHoldingRequest{Id: "indexcoin", Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Balances))
+	require.EqualValues(t, 3, res.Pagination.Total)
+
+	// accounts that no longer hold the denom are removed from the index
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, user1, user2, sdk.NewCoins(sdk.NewInt64Coin("indexcoin", 60))))
+	holders = app.MarkerKeeper.GetAllMarkerHolders(ctx, "indexcoin")
+	require.Equal(t, 2, len(holders))
+	for _, h := range holders {
+		require.NotEqual(t, user1.String(), h.Address)
+	}
+
+	// the index can be recreated from bank balances
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	store.Delete(types.MarkerHolderKey("indexcoin", user2))
+	require.Equal(t, 1, len(app.MarkerKeeper.GetAllMarkerHolders(ctx, "indexcoin")))
+	app.MarkerKeeper.RebuildMarkerHolderIndex(ctx)
+	require.Equal(t, 2, len(app.MarkerKeeper.GetAllMarkerHolders(ctx, "indexcoin")))
+}
+
 // testUserAddress gives a quick way to make a valid test address (no keys though)
 func testUserAddress(name string) sdk.AccAddress {
 	addr := types.MustGetMarkerAddress(name)
 	return addr
 }
+
+func TestFreezeAccount(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	admin := testUserAddress("admin")
+	holder := testUserAddress("holder")
+	recipient := testUserAddress("recipient")
+
+	mac := types.NewEmptyMarkerAccount("freezecoin", admin.String(), []types.AccessGrant{*types.NewAccessGrant(admin,
+		[]types.Access{types.Access_Mint, types.Access_Withdraw, types.Access_Transfer, types.Access_Freeze})})
+	mac.MarkerType = types.MarkerType_RestrictedCoin
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("freezecoin", sdk.NewInt(1000))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, admin, "freezecoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, admin, "freezecoin"))
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, holder, "freezecoin",
+		sdk.NewCoins(sdk.NewInt64Coin("freezecoin", 100))))
+
+	amount := sdk.NewInt64Coin("freezecoin", 10)
+
+	// only accounts with freeze access can freeze
+	require.EqualError(t, app.MarkerKeeper.AddFrozenAccount(ctx, holder, "freezecoin", holder),
+		fmt.Sprintf("%s does not have ACCESS_FREEZE on freezecoin markeraccount", holder))
+	require.NoError(t, app.MarkerKeeper.AddFrozenAccount(ctx, admin, "freezecoin", holder))
+	require.True(t, app.MarkerKeeper.IsAccountFrozen(ctx, "freezecoin", holder))
+	require.EqualError(t, app.MarkerKeeper.AddFrozenAccount(ctx, admin, "freezecoin", holder),
+		fmt.Sprintf("%s is already frozen for freezecoin", holder))
+
+	// a frozen account can not send the coin by any means
+	require.EqualError(t, app.MarkerKeeper.TransferCoin(ctx, holder, recipient, admin, amount),
+		fmt.Sprintf("%s is frozen for freezecoin: account is frozen", holder))
+	require.EqualError(t, app.BankKeeper.SendCoins(ctx, holder, recipient, sdk.NewCoins(amount)),
+		fmt.Sprintf("%s is frozen for freezecoin: account is frozen", holder))
+	require.ErrorIs(t, app.BankKeeper.SendCoinsFromAccountToModule(ctx, holder, types.CoinPoolName, sdk.NewCoins(amount)),
+		types.ErrAccountFrozen)
+
+	// but can still receive it
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, holder, "freezecoin", sdk.NewCoins(amount)))
+
+	// a frozen marker account can not be withdrawn from
+	require.NoError(t, app.MarkerKeeper.AddFrozenAccount(ctx, admin, "freezecoin", mac.GetAddress()))
+	require.EqualError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, recipient, "freezecoin", sdk.NewCoins(amount)),
+		"freezecoin markeraccount is frozen for freezecoin: account is frozen")
+
+	res, err := app.MarkerKeeper.FrozenAccounts(sdk.WrapSDKContext(ctx),
+		&types.QueryFrozenAccountsRequest{Id: "freezecoin", Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{holder.String(), mac.GetAddress().String()}, res.Addresses)
+	require.EqualValues(t, 2, res.Pagination.Total)
+
+	// frozen accounts are exported in genesis
+	genesis := app.MarkerKeeper.ExportGenesis(ctx)
+	require.Equal(t, 1, len(genesis.FrozenAccounts))
+	require.Equal(t, "freezecoin", genesis.FrozenAccounts[0].Denom)
+	require.Equal(t, 2, len(genesis.FrozenAccounts[0].Addresses))
+
+	require.NoError(t, app.MarkerKeeper.RemoveFrozenAccount(ctx, admin, "freezecoin", holder))
+	require.EqualError(t, app.MarkerKeeper.RemoveFrozenAccount(ctx, admin, "freezecoin", holder),
+		fmt.Sprintf("%s is not frozen for freezecoin", holder))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, holder, recipient, sdk.NewCoins(amount)))
+	require.Equal(t, amount, app.BankKeeper.GetBalance(ctx, recipient, "freezecoin"))
+
+	// freeze is only supported for restricted markers
+	coinMarker := types.NewEmptyMarkerAccount("plaincoin", admin.String(), []types.AccessGrant{*types.NewAccessGrant(admin,
+		[]types.Access{types.Access_Freeze})})
+	require.Error(t, coinMarker.Validate())
+}
+
+func TestForceTransfer(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	admin := testUserAddress("admin")
+	holder := testUserAddress("holder")
+	recipient := testUserAddress("recipient")
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, holder))
+
+	mac := types.NewEmptyMarkerAccount("clawcoin", admin.String(), []types.AccessGrant{*types.NewAccessGrant(admin,
+		[]types.Access{types.Access_Mint, types.Access_Withdraw, types.Access_Freeze, types.Access_ForceTransfer})})
+	mac.MarkerType = types.MarkerType_RestrictedCoin
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("clawcoin", sdk.NewInt(1000))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, admin, "clawcoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, admin, "clawcoin"))
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, holder, "clawcoin",
+		sdk.NewCoins(sdk.NewInt64Coin("clawcoin", 100))))
+
+	amount := sdk.NewInt64Coin("clawcoin", 10)
+
+	// only accounts with force transfer access can force a transfer
+	require.EqualError(t, app.MarkerKeeper.ForceTransferCoin(ctx, holder, recipient, recipient, amount),
+		fmt.Sprintf("%s does not have ACCESS_FORCE_TRANSFER on clawcoin markeraccount", recipient))
+
+	// coin can be moved out of a frozen account without an authz grant from the holder
+	require.NoError(t, app.MarkerKeeper.AddFrozenAccount(ctx, admin, "clawcoin", holder))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, app.MarkerKeeper.ForceTransferCoin(ctx, holder, recipient, admin, amount))
+	require.Equal(t, amount, app.BankKeeper.GetBalance(ctx, recipient, "clawcoin"))
+	require.Equal(t, int64(90), app.BankKeeper.GetBalance(ctx, holder, "clawcoin").Amount.Int64())
+	require.Equal(t, "provenance.marker.v1.EventMarkerForceTransfer", ctx.EventManager().Events()[len(ctx.EventManager().Events())-1].Type)
+
+	// the holder is still frozen for normal transfers
+	require.Error(t, app.BankKeeper.SendCoins(ctx, holder, recipient, sdk.NewCoins(amount)))
+
+	// module and marker accounts can not be force transferred from
+	require.EqualError(t, app.MarkerKeeper.ForceTransferCoin(ctx, mac.GetAddress(), recipient, admin, amount),
+		fmt.Sprintf("funds can not be force transferred from module or marker account %s", mac.GetAddress()))
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.EqualError(t, app.MarkerKeeper.ForceTransferCoin(ctx, feeCollector, recipient, admin, amount),
+		fmt.Sprintf("funds can not be force transferred from module or marker account %s", feeCollector))
+
+	// forced transfers can be disabled chain wide
+	params := app.MarkerKeeper.GetParams(ctx)
+	params.EnableForceTransfer = false
+	app.MarkerKeeper.SetParams(ctx, params)
+	require.EqualError(t, app.MarkerKeeper.ForceTransferCoin(ctx, holder, recipient, admin, amount),
+		"forced transfers are not enabled")
+}
+
+func TestMaxSupply(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	admin := testUserAddress("admin")
+
+	mac := types.NewEmptyMarkerAccount("cappedcoin", admin.String(), []types.AccessGrant{*types.NewAccessGrant(admin,
+		[]types.Access{types.Access_Mint, types.Access_Burn})})
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("cappedcoin", sdk.NewInt(1000))))
+	require.NoError(t, mac.SetMaxSupply(sdk.NewInt(1500)))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+
+	// proposed markers can not be configured past their max supply
+	require.EqualError(t, app.MarkerKeeper.MintCoin(ctx, admin, sdk.NewInt64Coin("cappedcoin", 501)),
+		"requested supply 1501 exceeds max supply 1500")
+	require.NoError(t, app.MarkerKeeper.MintCoin(ctx, admin, sdk.NewInt64Coin("cappedcoin", 200)))
+
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, admin, "cappedcoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, admin, "cappedcoin"))
+
+	m, err := app.MarkerKeeper.GetMarkerByDenom(ctx, "cappedcoin")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(1500), m.GetMaxSupply())
+	require.EqualError(t, m.SetMaxSupply(sdk.NewInt(2000)), "max supply can only be set on proposed markers")
+
+	// active markers can not mint past their max supply
+	require.EqualError(t, app.MarkerKeeper.MintCoin(ctx, admin, sdk.NewInt64Coin("cappedcoin", 301)),
+		"requested supply 1501 exceeds max supply 1500")
+	require.NoError(t, app.MarkerKeeper.MintCoin(ctx, admin, sdk.NewInt64Coin("cappedcoin", 300)))
+	require.Equal(t, sdk.NewInt(1500), app.BankKeeper.GetSupply(ctx, "cappedcoin").Amount)
+
+	// supply increase proposals are held to the same limit
+	require.EqualError(t, markerkeeper.HandleSupplyIncreaseProposal(ctx, app.MarkerKeeper,
+		types.NewSupplyIncreaseProposal("title", "description", sdk.NewInt64Coin("cappedcoin", 1), "")),
+		"requested supply 1501 exceeds max supply 1500")
+
+	// circulation can always be reduced
+	require.NoError(t, app.MarkerKeeper.BurnCoin(ctx, admin, sdk.NewInt64Coin("cappedcoin", 100)))
+	require.Equal(t, sdk.NewInt(1400), app.BankKeeper.GetSupply(ctx, "cappedcoin").Amount)
+}
+
+func TestDistribute(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	admin := testUserAddress("admin")
+	holders := []sdk.AccAddress{testUserAddress("holder1"), testUserAddress("holder2"), testUserAddress("holder3")}
+
+	mac := types.NewEmptyMarkerAccount("fundcoin", admin.String(), []types.AccessGrant{*types.NewAccessGrant(admin,
+		[]types.Access{types.Access_Mint, types.Access_Withdraw})})
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("fundcoin", sdk.NewInt(1000))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, admin, "fundcoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, admin, "fundcoin"))
+	for i, amount := range []int64{500, 300, 199} {
+		require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, holders[i], "fundcoin",
+			sdk.NewCoins(sdk.NewInt64Coin("fundcoin", amount))))
+	}
+	simapp.FundAccount(app, ctx, mac.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("payout", 1001)))
+	payout := sdk.NewCoins(sdk.NewInt64Coin("payout", 1000))
+
+	// only accounts with withdraw access can distribute
+	require.EqualError(t, app.MarkerKeeper.DistributeCoins(ctx, holders[0], "fundcoin", payout),
+		fmt.Sprintf("%s does not have ACCESS_WITHDRAW on fundcoin markeraccount", holders[0]))
+	require.EqualError(t, app.MarkerKeeper.DistributeCoins(ctx, admin, "fundcoin", sdk.NewCoins(sdk.NewInt64Coin("fundcoin", 1))),
+		"cannot distribute fundcoin to its own holders")
+	require.EqualError(t, app.MarkerKeeper.DistributeCoins(ctx, admin, "fundcoin", sdk.NewCoins(sdk.NewInt64Coin("payout", 2000))),
+		"insufficient escrow 1fundcoin,1001payout for distribution of 2000payout")
+
+	require.NoError(t, app.MarkerKeeper.DistributeCoins(ctx, admin, "fundcoin", payout))
+	require.Equal(t, int64(1), app.BankKeeper.GetBalance(ctx, mac.GetAddress(), "payout").Amount.Int64())
+	require.EqualError(t, app.MarkerKeeper.DistributeCoins(ctx, admin, "fundcoin", sdk.NewCoins(sdk.NewInt64Coin("payout", 1))),
+		"a distribution to fundcoin holders is already in progress")
+
+	// the three holders are paid over two batches, no balances are recorded until a holder's balance changes
+	require.Empty(t, app.MarkerKeeper.GetAllDistributionBalances(ctx))
+	app.MarkerKeeper.ProcessDistributions(ctx, 2)
+	d, found := app.MarkerKeeper.GetDistribution(ctx, "fundcoin")
+	require.True(t, found)
+	require.NotEmpty(t, d.LastHolder)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	app.MarkerKeeper.ProcessDistributions(ctx, 2)
+	_, found = app.MarkerKeeper.GetDistribution(ctx, "fundcoin")
+	require.False(t, found)
+	require.Equal(t, "provenance.marker.v1.EventMarkerDistributionComplete", ctx.EventManager().Events()[len(ctx.EventManager().Events())-1].Type)
+
+	for i, amount := range []int64{500, 300, 199} {
+		require.Equal(t, amount, app.BankKeeper.GetBalance(ctx, holders[i], "payout").Amount.Int64())
+	}
+	// the rounding dust is returned to the marker escrow
+	require.Equal(t, int64(2), app.BankKeeper.GetBalance(ctx, mac.GetAddress(), "payout").Amount.Int64())
+	require.Empty(t, app.MarkerKeeper.GetAllDistributionBalances(ctx))
+}
+
+func TestDistributeWithTransfersBetweenHolders(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	admin := testUserAddress("admin")
+	holders := []sdk.AccAddress{testUserAddress("holder1"), testUserAddress("holder2"), testUserAddress("holder3")}
+
+	mac := types.NewEmptyMarkerAccount("movecoin", admin.String(), []types.AccessGrant{*types.NewAccessGrant(admin,
+		[]types.Access{types.Access_Mint, types.Access_Withdraw})})
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("movecoin", sdk.NewInt(900))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, admin, "movecoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, admin, "movecoin"))
+	for _, holder := range holders {
+		require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, holder, "movecoin",
+			sdk.NewCoins(sdk.NewInt64Coin("movecoin", 300))))
+	}
+	simapp.FundAccount(app, ctx, mac.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("payout", 900)))
+	require.NoError(t, app.MarkerKeeper.DistributeCoins(ctx, admin, "movecoin", sdk.NewCoins(sdk.NewInt64Coin("payout", 900))))
+
+	// holders are paid in the order of their addresses
+	sorted := append([]sdk.AccAddress{}, holders...)
+	sort.Slice(sorted, func(i, j int) bool { return bytes.Compare(sorted[i], sorted[j]) < 0 })
+	paid, leaving, unpaid := sorted[0], sorted[1], sorted[2]
+
+	// pay the first holder then move its coin to a holder that has not been paid yet
+	require.Empty(t, app.MarkerKeeper.GetAllDistributionBalances(ctx))
+	app.MarkerKeeper.ProcessDistributions(ctx, 1)
+	require.Equal(t, int64(300), app.BankKeeper.GetBalance(ctx, paid, "payout").Amount.Int64())
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, paid, unpaid, sdk.NewCoins(sdk.NewInt64Coin("movecoin", 300))))
+	balances := app.MarkerKeeper.GetAllDistributionBalances(ctx)
+	require.Len(t, balances, 1)
+	require.Equal(t, types.NewMarkerDistributionBalance("movecoin", unpaid, sdk.NewInt(300)), balances[0])
+
+	// a holder that has not been paid yet can give up all of its coin and is still paid
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, leaving, paid, sdk.NewCoins(sdk.NewInt64Coin("movecoin", 300))))
+	require.Len(t, app.MarkerKeeper.GetAllDistributionBalances(ctx), 2)
+
+	// a new holder receiving coin during the distribution is not paid either
+	newHolder := testUserAddress("holder4")
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, unpaid, newHolder, sdk.NewCoins(sdk.NewInt64Coin("movecoin", 100))))
+
+	app.MarkerKeeper.ProcessDistributions(ctx, 10)
+	_, found := app.MarkerKeeper.GetDistribution(ctx, "movecoin")
+	require.False(t, found)
+
+	// every holder is paid from the balance held when the distribution was requested
+	for _, holder := range holders {
+		require.Equal(t, int64(300), app.BankKeeper.GetBalance(ctx, holder, "payout").Amount.Int64())
+	}
+	require.True(t, app.BankKeeper.GetBalance(ctx, newHolder, "payout").IsZero())
+	require.True(t, app.BankKeeper.GetBalance(ctx, mac.GetAddress(), "payout").IsZero())
+}
+
+func TestDistributeToMoreHoldersThanBlockLimit(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	admin := testUserAddress("admin")
+	holderCount := types.MaxDistributionHoldersPerBlock + 50
+
+	mac := types.NewEmptyMarkerAccount("manycoin", admin.String(), []types.AccessGrant{*types.NewAccessGrant(admin,
+		[]types.Access{types.Access_Mint, types.Access_Withdraw})})
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("manycoin", sdk.NewInt(int64(holderCount*2)))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, admin, "manycoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, admin, "manycoin"))
+	holders := make([]sdk.AccAddress, holderCount)
+	for i := range holders {
+		holders[i] = testUserAddress(fmt.Sprintf("holder%d", i))
+		require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, holders[i], "manycoin",
+			sdk.NewCoins(sdk.NewInt64Coin("manycoin", 2))))
+	}
+	simapp.FundAccount(app, ctx, mac.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("payout", int64(holderCount*4))))
+
+	// requesting the distribution does not visit the holders
+	require.NoError(t, app.MarkerKeeper.DistributeCoins(ctx, admin, "manycoin",
+		sdk.NewCoins(sdk.NewInt64Coin("payout", int64(holderCount*4)))))
+	require.Empty(t, app.MarkerKeeper.GetAllDistributionBalances(ctx))
+
+	app.MarkerKeeper.ProcessDistributions(ctx, types.MaxDistributionHoldersPerBlock)
+	_, found := app.MarkerKeeper.GetDistribution(ctx, "manycoin")
+	require.True(t, found)
+
+	// move coin from a holder that has not been paid to one that has between the two blocks
+	var paid, unpaid sdk.AccAddress
+	for _, holder := range holders {
+		if app.BankKeeper.GetBalance(ctx, holder, "payout").IsZero() {
+			unpaid = holder
+		} else {
+			paid = holder
+		}
+	}
+	require.NotNil(t, paid)
+	require.NotNil(t, unpaid)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, unpaid, paid, sdk.NewCoins(sdk.NewInt64Coin("manycoin", 2))))
+
+	app.MarkerKeeper.ProcessDistributions(ctx, types.MaxDistributionHoldersPerBlock)
+	_, found = app.MarkerKeeper.GetDistribution(ctx, "manycoin")
+	require.False(t, found)
+	for _, holder := range holders {
+		require.Equal(t, int64(4), app.BankKeeper.GetBalance(ctx, holder, "payout").Amount.Int64(), holder.String())
+	}
+	require.True(t, app.BankKeeper.GetBalance(ctx, mac.GetAddress(), "payout").IsZero())
+	require.Empty(t, app.MarkerKeeper.GetAllDistributionBalances(ctx))
+}
+
+func TestAccessGrantExpiration(t *testing.T) {
+	app := simapp.Setup(false)
+	blockTime := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: blockTime})
+	admin := testUserAddress("admin")
+	minter := testUserAddress("minter")
+
+	mac := types.NewEmptyMarkerAccount("expirecoin", admin.String(), []types.AccessGrant{*types.NewAccessGrant(admin,
+		[]types.Access{types.Access_Mint, types.Access_Admin})})
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("expirecoin", sdk.NewInt(1000))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, admin, "expirecoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, admin, "expirecoin"))
+
+	// a grant that has already expired is rejected
+	grant := types.NewAccessGrant(minter, []types.Access{types.Access_Mint})
+	grant.Expiration = &blockTime
+	require.EqualError(t, app.MarkerKeeper.AddAccess(ctx, admin, "expirecoin", grant),
+		fmt.Sprintf("access grant expiration %s must be after the current block time", blockTime))
+
+	expiration := blockTime.Add(time.Hour)
+	grant.Expiration = &expiration
+	require.NoError(t, app.MarkerKeeper.AddAccess(ctx, admin, "expirecoin", grant))
+	require.NoError(t, app.MarkerKeeper.MintCoin(ctx, minter, sdk.NewInt64Coin("expirecoin", 10)))
+
+	// the grant provides no access once the block time reaches the expiration
+	ctx = ctx.WithBlockTime(expiration)
+	require.EqualError(t, app.MarkerKeeper.MintCoin(ctx, minter, sdk.NewInt64Coin("expirecoin", 10)),
+		fmt.Sprintf("%s does not have ACCESS_MINT on expirecoin markeraccount", minter))
+
+	// and is removed from the marker in begin block
+	app.MarkerKeeper.RemoveExpiredGrants(ctx)
+	m, err := app.MarkerKeeper.GetMarkerByDenom(ctx, "expirecoin")
+	require.NoError(t, err)
+	require.Equal(t, []sdk.AccAddress{admin}, m.AddressListForPermission(types.Access_Mint, ctx.BlockTime()))
+	require.Equal(t, 1, len(m.GetAccessList()))
+
+	var found bool
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == "provenance.marker.v1.EventMarkerAccessExpired" {
+			found = true
+		}
+	}
+	require.True(t, found, "expected an access expired event")
+
+	// grants without an expiration are not affected
+	ctx = ctx.WithBlockTime(expiration.AddDate(10, 0, 0))
+	app.MarkerKeeper.RemoveExpiredGrants(ctx)
+	require.NoError(t, app.MarkerKeeper.MintCoin(ctx, admin, sdk.NewInt64Coin("expirecoin", 10)))
+}
+
+func TestAccessGrantExpirationKeepsPermanentGrants(t *testing.T) {
+	app := simapp.Setup(false)
+	blockTime := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: blockTime})
+	admin := testUserAddress("admin")
+	operator := testUserAddress("operator")
+
+	mac := types.NewEmptyMarkerAccount("tempcoin", admin.String(), []types.AccessGrant{*types.NewAccessGrant(admin,
+		[]types.Access{types.Access_Mint, types.Access_Admin})})
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("tempcoin", sdk.NewInt(1000))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, admin, "tempcoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, admin, "tempcoin"))
+
+	// a permanent admin is given a temporary mint grant
+	require.NoError(t, app.MarkerKeeper.AddAccess(ctx, admin, "tempcoin",
+		types.NewAccessGrant(operator, []types.Access{types.Access_Admin, types.Access_Withdraw})))
+	expiration := blockTime.Add(24 * time.Hour)
+	grant := types.NewAccessGrant(operator, []types.Access{types.Access_Mint})
+	grant.Expiration = &expiration
+	require.NoError(t, app.MarkerKeeper.AddAccess(ctx, admin, "tempcoin", grant))
+	// a later permanent grant does not clear the expiration of the temporary permissions
+	require.NoError(t, app.MarkerKeeper.AddAccess(ctx, admin, "tempcoin",
+		types.NewAccessGrant(operator, []types.Access{types.Access_Burn})))
+
+	m, err := app.MarkerKeeper.GetMarkerByDenom(ctx, "tempcoin")
+	require.NoError(t, err)
+	for _, access := range []types.Access{types.Access_Admin, types.Access_Withdraw, types.Access_Mint, types.Access_Burn} {
+		require.True(t, m.AddressHasAccess(operator, access, ctx.BlockTime()), "operator has %s", access)
+	}
+	require.ElementsMatch(t, []sdk.AccAddress{admin, operator}, m.AddressListForPermission(types.Access_Mint, ctx.BlockTime()))
+
+	// once the temporary grant expires only its permissions are removed
+	ctx = ctx.WithBlockTime(expiration)
+	app.MarkerKeeper.RemoveExpiredGrants(ctx)
+	m, err = app.MarkerKeeper.GetMarkerByDenom(ctx, "tempcoin")
+	require.NoError(t, err)
+	require.False(t, m.AddressHasAccess(operator, types.Access_Mint, ctx.BlockTime()))
+	for _, access := range []types.Access{types.Access_Admin, types.Access_Withdraw, types.Access_Burn} {
+		require.True(t, m.AddressHasAccess(operator, access, ctx.BlockTime()), "operator keeps %s", access)
+	}
+	require.Equal(t, []sdk.AccAddress{admin}, m.AddressListForPermission(types.Access_Mint, ctx.BlockTime()))
+	for _, grant := range m.GetAccessList() {
+		require.Nil(t, grant.Expiration, "expired grant is removed")
+	}
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, operator, operator, "tempcoin", sdk.NewCoins(sdk.NewInt64Coin("tempcoin", 10))))
+}
+
+func TestMarkersByGrantee(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	manager := testUserAddress("manager")
+	minter := testUserAddress("minter")
+
+	for _, denom := range []string{"granteecoin1", "granteecoin2"} {
+		mac := types.NewEmptyMarkerAccount(denom, manager.String(), []types.AccessGrant{*types.NewAccessGrant(manager,
+			[]types.Access{types.Access_Admin})})
+		require.NoError(t, mac.SetSupply(sdk.NewCoin(denom, sdk.NewInt(1000))))
+		require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+		require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, manager, denom))
+		require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, manager, denom))
+	}
+
+	queryDenoms := func(addr sdk.AccAddress, permission types.Access) []string {
+		res, err := app.MarkerKeeper.MarkersByGrantee(sdk.WrapSDKContext(ctx),
+			&types.QueryMarkersByGranteeRequest{Address: addr.String(), Permission: permission})
+		require.NoError(t, err)
+		var denoms []string
+		for _, any := range res.Markers {
+			denoms = append(denoms, any.GetCachedValue().(types.MarkerAccountI).GetDenom())
+		}
+		return denoms
+	}
+
+	require.ElementsMatch(t, []string{"granteecoin1", "granteecoin2"}, queryDenoms(manager, types.Access_Unknown))
+	require.ElementsMatch(t, []string{"granteecoin1", "granteecoin2"}, queryDenoms(manager, types.Access_Admin))
+	require.Empty(t, queryDenoms(manager, types.Access_Mint))
+	require.Empty(t, queryDenoms(minter, types.Access_Unknown))
+
+	// the index follows grants added and removed from the marker
+	require.NoError(t, app.MarkerKeeper.AddAccess(ctx, manager, "granteecoin2",
+		types.NewAccessGrant(minter, []types.Access{types.Access_Mint})))
+	require.Equal(t, []string{"granteecoin2"}, queryDenoms(minter, types.Access_Unknown))
+	require.Equal(t, []string{"granteecoin2"}, queryDenoms(minter, types.Access_Mint))
+	require.Empty(t, queryDenoms(minter, types.Access_Burn))
+
+	require.NoError(t, app.MarkerKeeper.RemoveAccess(ctx, manager, "granteecoin2", minter))
+	require.Empty(t, queryDenoms(minter, types.Access_Unknown))
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	require.False(t, store.Has(types.MarkerGranteeKey(minter, types.MustGetMarkerAddress("granteecoin2"))))
+
+	// the index can be rebuilt for existing markers
+	store.Delete(types.MarkerGranteeKey(manager, types.MustGetMarkerAddress("granteecoin1")))
+	require.Equal(t, []string{"granteecoin2"}, queryDenoms(manager, types.Access_Unknown))
+	app.MarkerKeeper.RebuildMarkerGranteeIndex(ctx)
+	require.ElementsMatch(t, []string{"granteecoin1", "granteecoin2"}, queryDenoms(manager, types.Access_Unknown))
+
+	_, err := app.MarkerKeeper.MarkersByGrantee(sdk.WrapSDKContext(ctx), &types.QueryMarkersByGranteeRequest{Address: "invalid"})
+	require.Error(t, err)
+}
+
+func TestMultiTransfer(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	admin := testUserAddress("admin")
+	holder := testUserAddress("holder")
+	recipient1 := testUserAddress("recipient1")
+	recipient2 := testUserAddress("recipient2")
+
+	for _, denom := range []string{"multicoin1", "multicoin2"} {
+		mac := types.NewEmptyMarkerAccount(denom, admin.String(), []types.AccessGrant{*types.NewAccessGrant(admin,
+			[]types.Access{types.Access_Mint, types.Access_Withdraw, types.Access_Transfer})})
+		mac.MarkerType = types.MarkerType_RestrictedCoin
+		require.NoError(t, mac.SetSupply(sdk.NewCoin(denom, sdk.NewInt(1000))))
+		require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+		require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, admin, denom))
+		require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, admin, denom))
+		require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, holder, denom,
+			sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	}
+	require.NoError(t, app.AuthzKeeper.SaveGrant(ctx, admin, holder, types.NewMarkerTransferAuthorization(
+		sdk.NewCoins(sdk.NewInt64Coin("multicoin1", 20), sdk.NewInt64Coin("multicoin2", 5)), nil), ctx.BlockTime().Add(time.Hour)))
+
+	// the authorization limit applies to the total transferred out of the holder's account
+	err := app.MarkerKeeper.MultiTransferCoins(ctx, admin, []types.TransferLeg{
+		types.NewTransferLeg(holder, recipient1, sdk.NewInt64Coin("multicoin1", 15)),
+		types.NewTransferLeg(holder, recipient2, sdk.NewInt64Coin("multicoin1", 15)),
+	})
+	require.EqualError(t, err, "requested amount is more than spend limit: insufficient funds")
+
+	// a transfer by an address without transfer access is rejected before any coin is sent
+	err = app.MarkerKeeper.MultiTransferCoins(ctx, recipient1, []types.TransferLeg{
+		types.NewTransferLeg(recipient1, recipient2, sdk.NewInt64Coin("multicoin1", 1)),
+	})
+	require.EqualError(t, err, fmt.Sprintf("%s is not allowed to broker transfers", recipient1))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, app.MarkerKeeper.MultiTransferCoins(ctx, admin, []types.TransferLeg{
+		types.NewTransferLeg(holder, recipient1, sdk.NewInt64Coin("multicoin1", 10)),
+		types.NewTransferLeg(holder, recipient2, sdk.NewInt64Coin("multicoin1", 5)),
+		types.NewTransferLeg(holder, recipient2, sdk.NewInt64Coin("multicoin2", 5)),
+		types.NewTransferLeg(admin, recipient1, sdk.NewInt64Coin("multicoin2", 0)),
+	}))
+	require.Equal(t, int64(10), app.BankKeeper.GetBalance(ctx, recipient1, "multicoin1").Amount.Int64())
+	require.Equal(t, int64(5), app.BankKeeper.GetBalance(ctx, recipient2, "multicoin1").Amount.Int64())
+	require.Equal(t, int64(5), app.BankKeeper.GetBalance(ctx, recipient2, "multicoin2").Amount.Int64())
+	require.Equal(t, int64(85), app.BankKeeper.GetBalance(ctx, holder, "multicoin1").Amount.Int64())
+
+	// the holder's authorization is reduced by the total amount transferred
+	auth, _ := app.AuthzKeeper.GetCleanAuthorization(ctx, admin, holder, types.MarkerTransferAuthorization{}.MsgTypeURL())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("multicoin1", 5)), auth.(*types.MarkerTransferAuthorization).TransferLimit)
+
+	var transferEvents, multiTransferEvents int
+	for _, e := range ctx.EventManager().Events() {
+		switch e.Type {
+		case "provenance.marker.v1.EventMarkerTransfer":
+			transferEvents++
+		case "provenance.marker.v1.EventMarkerMultiTransfer":
+			multiTransferEvents++
+		}
+	}
+	require.Equal(t, 4, transferEvents, "one transfer event per leg")
+	require.Equal(t, 1, multiTransferEvents, "one aggregate multi transfer event")
+}
+
+// testTransferHook rejects transfers of more than a limit and records the transfers it is asked about.
+type testTransferHook struct {
+	limit int64
+	calls []string
+}
+
+func (h *testTransferHook) BeforeRestrictedTransfer(
+	_ sdk.Context, _ types.MarkerAccountI, from, to, _ sdk.AccAddress, amount sdk.Coin,
+) error {
+	h.calls = append(h.calls, fmt.Sprintf("%s:%s:%s", from, to, amount))
+	if amount.Amount.Int64() > h.limit {
+		return fmt.Errorf("amount over limit")
+	}
+	return nil
+}
+
+// testContractQuerier answers transfer restriction queries, allowing transfers to a single address.
+type testContractQuerier struct {
+	allowed sdk.AccAddress
+}
+
+func (q testContractQuerier) QuerySmart(_ sdk.Context, _ sdk.AccAddress, req []byte) ([]byte, error) {
+	var query markerwasm.TransferRestrictionQuery
+	if err := json.Unmarshal(req, &query); err != nil {
+		return nil, err
+	}
+	if query.Params.To != q.allowed.String() {
+		return json.Marshal(markerwasm.TransferRestrictionResponse{Allowed: false, Reason: "recipient not approved"})
+	}
+	return json.Marshal(markerwasm.TransferRestrictionResponse{Allowed: true})
+}
+
+func TestTransferRestrictionHooks(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	admin := testUserAddress("admin")
+	holder := testUserAddress("holder")
+	recipient := testUserAddress("recipient")
+	other := testUserAddress("other")
+	contract := testUserAddress("contract")
+
+	hook := &testTransferHook{limit: 50}
+	k := markerkeeper.NewKeeper(app.AppCodec(), app.GetKey(types.ModuleName), app.GetSubspace(types.ModuleName),
+		app.AccountKeeper, app.BankKeeper, app.AuthzKeeper, app.AttributeKeeper, app.GetKey(banktypes.StoreKey), app.TransferKeeper)
+	k.SetTransferRestrictionHooks(hook, markerwasm.NewTransferRestrictionHook(testContractQuerier{allowed: recipient}))
+	require.Panics(t, func() { k.SetTransferRestrictionHooks(hook) }, "hooks can only be set once")
+
+	for _, denom := range []string{"hookcoin", "contractcoin"} {
+		mac := types.NewEmptyMarkerAccount(denom, admin.String(), []types.AccessGrant{*types.NewAccessGrant(admin,
+			[]types.Access{types.Access_Mint, types.Access_Withdraw, types.Access_Transfer})})
+		mac.MarkerType = types.MarkerType_RestrictedCoin
+		if denom == "contractcoin" {
+			mac.TransferRestrictionContract = contract.String()
+		}
+		require.NoError(t, mac.SetSupply(sdk.NewCoin(denom, sdk.NewInt(1000))))
+		require.NoError(t, k.AddMarkerAccount(ctx, mac))
+		require.NoError(t, k.FinalizeMarker(ctx, admin, denom))
+		require.NoError(t, k.ActivateMarker(ctx, admin, denom))
+		require.NoError(t, k.WithdrawCoins(ctx, admin, holder, denom, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	}
+	require.NoError(t, app.AuthzKeeper.SaveGrant(ctx, admin, holder, types.NewMarkerTransferAuthorization(
+		sdk.NewCoins(sdk.NewInt64Coin("hookcoin", 100), sdk.NewInt64Coin("contractcoin", 100)), nil), ctx.BlockTime().Add(time.Hour)))
+
+	// a hook can reject a transfer
+	err := k.TransferCoin(ctx, holder, recipient, admin, sdk.NewInt64Coin("hookcoin", 60))
+	require.EqualError(t, err, fmt.Sprintf("transfer of 60hookcoin from %s to %s rejected: amount over limit", holder, recipient))
+	require.NoError(t, k.TransferCoin(ctx, holder, recipient, admin, sdk.NewInt64Coin("hookcoin", 10)))
+	require.Equal(t, int64(10), app.BankKeeper.GetBalance(ctx, recipient, "hookcoin").Amount.Int64())
+
+	// the marker's transfer restriction contract must allow the transfer
+	err = k.TransferCoin(ctx, holder, other, admin, sdk.NewInt64Coin("contractcoin", 10))
+	require.EqualError(t, err, fmt.Sprintf("transfer of 10contractcoin from %s to %s rejected: transfer not allowed by %s: recipient not approved",
+		holder, other, contract))
+	require.NoError(t, k.TransferCoin(ctx, holder, recipient, admin, sdk.NewInt64Coin("contractcoin", 10)))
+	require.Equal(t, int64(10), app.BankKeeper.GetBalance(ctx, recipient, "contractcoin").Amount.Int64())
+
+	// every leg of a multi transfer is checked before any coin is sent
+	hook.calls = nil
+	err = k.MultiTransferCoins(ctx, admin, []types.TransferLeg{
+		types.NewTransferLeg(holder, recipient, sdk.NewInt64Coin("hookcoin", 5)),
+		types.NewTransferLeg(holder, other, sdk.NewInt64Coin("contractcoin", 5)),
+	})
+	require.Error(t, err)
+	require.Len(t, hook.calls, 2)
+	require.Equal(t, int64(10), app.BankKeeper.GetBalance(ctx, recipient, "hookcoin").Amount.Int64())
+}
+
+func TestChangeMarkerManager(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	manager := testUserAddress("manager")
+	admin := testUserAddress("admin")
+	newManager := testUserAddress("newmanager")
+	other := testUserAddress("other")
+
+	mac := types.NewEmptyMarkerAccount("managedcoin", manager.String(), []types.AccessGrant{*types.NewAccessGrant(admin,
+		[]types.Access{types.Access_Mint, types.Access_Admin})})
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("managedcoin", sdk.NewInt(1000))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+
+	err := app.MarkerKeeper.ChangeMarkerManager(ctx, other, "managedcoin", other)
+	require.EqualError(t, err, fmt.Sprintf("%s is not allowed to change the manager of managedcoin", other))
+
+	// the current manager can hand off the marker
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, app.MarkerKeeper.ChangeMarkerManager(ctx, manager, "managedcoin", newManager))
+	m, err := app.MarkerKeeper.GetMarkerByDenom(ctx, "managedcoin")
+	require.NoError(t, err)
+	require.Equal(t, newManager, m.GetManager())
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, "provenance.marker.v1.EventMarkerChangeManager", ctx.EventManager().Events()[0].Type)
+
+	// the previous manager no longer can
+	err = app.MarkerKeeper.ChangeMarkerManager(ctx, manager, "managedcoin", manager)
+	require.EqualError(t, err, fmt.Sprintf("%s is not allowed to change the manager of managedcoin", manager))
+
+	// an admin can change the manager of a finalized marker
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, newManager, "managedcoin"))
+	require.NoError(t, app.MarkerKeeper.ChangeMarkerManager(ctx, admin, "managedcoin", manager))
+	m, err = app.MarkerKeeper.GetMarkerByDenom(ctx, "managedcoin")
+	require.NoError(t, err)
+	require.Equal(t, manager, m.GetManager())
+
+	err = app.MarkerKeeper.ChangeMarkerManager(ctx, admin, "managedcoin", m.GetAddress())
+	require.EqualError(t, err, "marker can not be self managed")
+
+	// activation clears the manager but an admin can assign one to the active marker
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, manager, "managedcoin"))
+	err = app.MarkerKeeper.ChangeMarkerManager(ctx, manager, "managedcoin", manager)
+	require.EqualError(t, err, fmt.Sprintf("%s is not allowed to change the manager of managedcoin", manager))
+	require.NoError(t, app.MarkerKeeper.ChangeMarkerManager(ctx, admin, "managedcoin", newManager))
+	m, err = app.MarkerKeeper.GetMarkerByDenom(ctx, "managedcoin")
+	require.NoError(t, err)
+	require.Equal(t, types.StatusActive, m.GetStatus())
+	require.Equal(t, newManager, m.GetManager())
+
+	// and the new manager of the active marker can rotate to another key
+	require.NoError(t, app.MarkerKeeper.ChangeMarkerManager(ctx, newManager, "managedcoin", other))
+	m, err = app.MarkerKeeper.GetMarkerByDenom(ctx, "managedcoin")
+	require.NoError(t, err)
+	require.Equal(t, other, m.GetManager())
+}
+
+func TestSetMarkerTransferRestrictionContract(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	admin := testUserAddress("admin")
+	other := testUserAddress("other")
+	contract := testUserAddress("contract")
+
+	mac := types.NewEmptyMarkerAccount("restrictedcoin", admin.String(), []types.AccessGrant{*types.NewAccessGrant(admin,
+		[]types.Access{types.Access_Mint, types.Access_Admin})})
+	mac.MarkerType = types.MarkerType_RestrictedCoin
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("restrictedcoin", sdk.NewInt(1000))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+
+	coinMac := types.NewEmptyMarkerAccount("plaincoin", admin.String(), []types.AccessGrant{*types.NewAccessGrant(admin,
+		[]types.Access{types.Access_Admin})})
+	require.NoError(t, coinMac.SetSupply(sdk.NewCoin("plaincoin", sdk.NewInt(1000))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, coinMac))
+
+	err := app.MarkerKeeper.SetMarkerTransferRestrictionContract(ctx, other, "restrictedcoin", contract.String())
+	require.EqualError(t, err, fmt.Sprintf("%s does not have %s on restrictedcoin markeraccount", other, types.Access_Admin))
+
+	err = app.MarkerKeeper.SetMarkerTransferRestrictionContract(ctx, admin, "plaincoin", contract.String())
+	require.EqualError(t, err, "transfer restriction contract is only supported for restricted markers")
+
+	err = app.MarkerKeeper.SetMarkerTransferRestrictionContract(ctx, admin, "restrictedcoin", "notanaddress")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid transfer restriction contract address")
+
+	// an admin can set the contract of a marker created without one
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, app.MarkerKeeper.SetMarkerTransferRestrictionContract(ctx, admin, "restrictedcoin", contract.String()))
+	m, err := app.MarkerKeeper.GetMarkerByDenom(ctx, "restrictedcoin")
+	require.NoError(t, err)
+	require.Equal(t, contract.String(), m.GetTransferRestrictionContract())
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, "provenance.marker.v1.EventMarkerSetTransferRestrictionContract", ctx.EventManager().Events()[0].Type)
+
+	// and clear it again, including on an active marker
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, admin, "restrictedcoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, admin, "restrictedcoin"))
+	require.NoError(t, app.MarkerKeeper.SetMarkerTransferRestrictionContract(ctx, admin, "restrictedcoin", ""))
+	m, err = app.MarkerKeeper.GetMarkerByDenom(ctx, "restrictedcoin")
+	require.NoError(t, err)
+	require.Empty(t, m.GetTransferRestrictionContract())
+}
+
+func TestMarkerHistory(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: time.Now(), Height: 10})
+	manager := testUserAddress("manager")
+
+	mac := types.NewEmptyMarkerAccount("historycoin", manager.String(), []types.AccessGrant{*types.NewAccessGrant(manager,
+		[]types.Access{types.Access_Mint, types.Access_Burn})})
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("historycoin", sdk.NewInt(1000))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.Empty(t, app.MarkerKeeper.GetMarkerHistory(ctx, "historycoin"))
+
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, manager, "historycoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, manager, "historycoin"))
+	require.NoError(t, app.MarkerKeeper.MintCoin(ctx, manager, sdk.NewCoin("historycoin", sdk.NewInt(50))))
+	require.NoError(t, app.MarkerKeeper.BurnCoin(ctx, manager, sdk.NewCoin("historycoin", sdk.NewInt(20))))
+
+	history := app.MarkerKeeper.GetMarkerHistory(ctx, "historycoin")
+	require.Len(t, history, 4)
+	require.Equal(t, uint64(1), history[0].Sequence)
+	require.Equal(t, types.HistoryActionFinalize, history[0].Action)
+	require.Equal(t, types.StatusFinalized, history[0].Status)
+	require.Equal(t, manager.String(), history[0].Actor)
+	require.Equal(t, int64(10), history[0].Height)
+	require.Equal(t, types.HistoryActionActivate, history[1].Action)
+	require.Equal(t, types.StatusActive, history[1].Status)
+	require.Equal(t, types.HistoryActionMint, history[2].Action)
+	require.Equal(t, sdk.NewInt(50), history[2].Amount)
+	require.Equal(t, types.HistoryActionBurn, history[3].Action)
+	require.Equal(t, sdk.NewInt(20), history[3].Amount)
+
+	// only the most recent entries are kept
+	for i := 0; i < types.MaxMarkerHistoryEntries; i++ {
+		require.NoError(t, app.MarkerKeeper.MintCoin(ctx, manager, sdk.NewCoin("historycoin", sdk.OneInt())))
+	}
+	history = app.MarkerKeeper.GetMarkerHistory(ctx, "historycoin")
+	require.Len(t, history, types.MaxMarkerHistoryEntries)
+	require.Equal(t, uint64(5), history[0].Sequence)
+	require.Equal(t, uint64(types.MaxMarkerHistoryEntries+4), history[len(history)-1].Sequence)
+
+	res, err := app.MarkerKeeper.MarkerHistory(sdk.WrapSDKContext(ctx), &types.QueryMarkerHistoryRequest{
+		Id:         "historycoin",
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 2)
+	require.Equal(t, uint64(5), res.Entries[0].Sequence)
+	require.Equal(t, uint64(types.MaxMarkerHistoryEntries), res.Pagination.Total)
+
+	genesis := app.MarkerKeeper.ExportGenesis(ctx)
+	require.Len(t, genesis.History, types.MaxMarkerHistoryEntries)
+	require.NoError(t, genesis.Validate())
+
+	// the history of a removed marker can still be queried by denom or address and ends with the removal
+	marker, err := app.MarkerKeeper.GetMarkerByDenom(ctx, "historycoin")
+	require.NoError(t, err)
+	app.MarkerKeeper.RemoveMarker(ctx, marker)
+	for _, id := range []string{"historycoin", mac.GetAddress().String()} {
+		res, err = app.MarkerKeeper.MarkerHistory(sdk.WrapSDKContext(ctx), &types.QueryMarkerHistoryRequest{
+			Id:         id,
+			Pagination: &query.PageRequest{Offset: types.MaxMarkerHistoryEntries - 1},
+		})
+		require.NoError(t, err, id)
+		require.Len(t, res.Entries, 1, id)
+		require.Equal(t, types.HistoryActionRemove, res.Entries[0].Action)
+		require.Equal(t, uint64(types.MaxMarkerHistoryEntries+5), res.Entries[0].Sequence)
+		require.Empty(t, res.Entries[0].Actor)
+	}
+	_, err = app.MarkerKeeper.MarkerHistory(sdk.WrapSDKContext(ctx), &types.QueryMarkerHistoryRequest{Id: "nohistorycoin"})
+	require.Error(t, err)
+
+	// a marker created again with the same denom continues after the removal entry
+	mac = types.NewEmptyMarkerAccount("historycoin", manager.String(), []types.AccessGrant{*types.NewAccessGrant(manager,
+		[]types.Access{types.Access_Mint})})
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("historycoin", sdk.NewInt(2000))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, manager, "historycoin"))
+	history = app.MarkerKeeper.GetMarkerHistory(ctx, "historycoin")
+	require.Equal(t, types.HistoryActionRemove, history[len(history)-2].Action)
+	require.Equal(t, types.HistoryActionFinalize, history[len(history)-1].Action)
+	require.Equal(t, uint64(types.MaxMarkerHistoryEntries+6), history[len(history)-1].Sequence)
+}
+
+func TestScheduledWithdraw(t *testing.T) {
+	app := simapp.Setup(false)
+	blockTime := time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Time: blockTime})
+	admin := testUserAddress("admin")
+	investor := testUserAddress("investor")
+
+	mac := types.NewEmptyMarkerAccount("vestcoin", admin.String(), []types.AccessGrant{*types.NewAccessGrant(admin,
+		[]types.Access{types.Access_Mint, types.Access_Withdraw, types.Access_Admin})})
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("vestcoin", sdk.NewInt(1000))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, admin, "vestcoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, admin, "vestcoin"))
+	vest := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("vestcoin", amount)) }
+
+	// only accounts with withdraw access can schedule withdrawals and only for the future
+	_, err := app.MarkerKeeper.ScheduleWithdrawCoins(ctx, investor, investor, "vestcoin", vest(100), blockTime.Add(time.Hour))
+	require.EqualError(t, err, fmt.Sprintf("%s does not have ACCESS_WITHDRAW on vestcoin markeraccount", investor))
+	_, err = app.MarkerKeeper.ScheduleWithdrawCoins(ctx, admin, investor, "vestcoin", vest(100), blockTime)
+	require.EqualError(t, err, "release time 2021-11-01T00:00:00Z must be after the current block time")
+
+	id, err := app.MarkerKeeper.ScheduleWithdrawCoins(ctx, admin, investor, "vestcoin", vest(100), blockTime.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), id)
+	id, err = app.MarkerKeeper.ScheduleWithdrawCoins(ctx, admin, investor, "vestcoin", vest(200), blockTime.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), id)
+	// the escrow is only checked when the withdrawal is released
+	id, err = app.MarkerKeeper.ScheduleWithdrawCoins(ctx, admin, investor, "vestcoin", vest(5000), blockTime.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), id)
+	require.Len(t, app.MarkerKeeper.GetWithdrawSchedules(ctx, mac.GetAddress()), 3)
+
+	// scheduled withdrawals can be cancelled by an account with withdraw access
+	require.EqualError(t, app.MarkerKeeper.CancelWithdrawSchedule(ctx, investor, "vestcoin", 2),
+		fmt.Sprintf("%s does not have ACCESS_WITHDRAW on vestcoin markeraccount", investor))
+	require.NoError(t, app.MarkerKeeper.CancelWithdrawSchedule(ctx, admin, "vestcoin", 2))
+	require.EqualError(t, app.MarkerKeeper.CancelWithdrawSchedule(ctx, admin, "vestcoin", 2),
+		"scheduled withdrawal 2 not found for vestcoin")
+
+	// nothing is released before the release time
+	app.MarkerKeeper.ReleaseWithdrawSchedules(ctx, types.MaxWithdrawSchedulesPerBlock)
+	require.Len(t, app.MarkerKeeper.GetWithdrawSchedules(ctx, mac.GetAddress()), 2)
+	require.True(t, app.BankKeeper.GetBalance(ctx, investor, "vestcoin").IsZero())
+
+	ctx = ctx.WithBlockTime(blockTime.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	app.MarkerKeeper.ReleaseWithdrawSchedules(ctx, types.MaxWithdrawSchedulesPerBlock)
+	require.Empty(t, app.MarkerKeeper.GetAllWithdrawSchedules(ctx))
+	require.Equal(t, int64(100), app.BankKeeper.GetBalance(ctx, investor, "vestcoin").Amount.Int64())
+	events := ctx.EventManager().Events()
+	require.Equal(t, "provenance.marker.v1.EventMarkerWithdrawScheduleFailed", events[len(events)-1].Type)
+
+	// the withdraw access must still be held when the withdrawal is released
+	_, err = app.MarkerKeeper.ScheduleWithdrawCoins(ctx, admin, investor, "vestcoin", vest(100), blockTime.Add(2*time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, app.MarkerKeeper.RemoveAccess(ctx, admin, "vestcoin", admin))
+	ctx = ctx.WithBlockTime(blockTime.Add(3 * time.Hour))
+	app.MarkerKeeper.ReleaseWithdrawSchedules(ctx, types.MaxWithdrawSchedulesPerBlock)
+	require.Empty(t, app.MarkerKeeper.GetAllWithdrawSchedules(ctx))
+	require.Equal(t, int64(100), app.BankKeeper.GetBalance(ctx, investor, "vestcoin").Amount.Int64())
+}
+
+func TestAddFinalizeActivateMarker(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	server := markerkeeper.NewMsgServerImpl(app.MarkerKeeper)
+	issuer := testUserAddress("issuer")
+	metadata := banktypes.Metadata{
+		Description: "an issued coin",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "nissue", Exponent: 0},
+			{Denom: "uissue", Exponent: 3},
+			{Denom: "issue", Exponent: 9},
+		},
+		Base:    "nissue",
+		Display: "issue",
+		Name:    "Issue",
+		Symbol:  "ISS",
+	}
+
+	// the marker must pass the checks made on activation, nothing is created when it does not
+	msg := types.NewMsgAddFinalizeActivateMarkerRequest("nissue", sdk.NewInt(1000), issuer, types.MarkerType_Coin, true, false,
+		[]types.AccessGrant{*types.NewAccessGrant(issuer, []types.Access{types.Access_Withdraw})})
+	cacheCtx, _ := ctx.CacheContext()
+	require.NoError(t, app.BankKeeper.MintCoins(cacheCtx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("nissue", 2000))))
+	_, err := server.AddFinalizeActivateMarker(sdk.WrapSDKContext(cacheCtx), msg)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "has been defined as less than pre-existing supply")
+
+	// metadata for another denom is rejected
+	badMetadata := metadata
+	badMetadata.Base = "nother"
+	msg.Metadata = &badMetadata
+	_, err = server.AddFinalizeActivateMarker(sdk.WrapSDKContext(ctx), msg)
+	require.EqualError(t, err, "denom metadata base nother does not match marker denom nissue: invalid request")
+
+	msg.Metadata = &metadata
+	_, err = server.AddFinalizeActivateMarker(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+
+	m, err := app.MarkerKeeper.GetMarkerByDenom(ctx, "nissue")
+	require.NoError(t, err)
+	require.Equal(t, types.StatusActive, m.GetStatus())
+	require.Empty(t, m.GetManager(), "the manager is cleared when the marker is activated")
+	require.True(t, m.HasFixedSupply())
+	require.Equal(t, int64(1000), app.BankKeeper.GetBalance(ctx, m.GetAddress(), "nissue").Amount.Int64())
+	stored, found := app.BankKeeper.GetDenomMetaData(ctx, "nissue")
+	require.True(t, found)
+	require.Equal(t, metadata, stored)
+
+	var actions []types.MarkerHistoryAction
+	for _, entry := range app.MarkerKeeper.GetMarkerHistory(ctx, "nissue") {
+		actions = append(actions, entry.Action)
+	}
+	require.Equal(t, []types.MarkerHistoryAction{
+		types.HistoryActionAdd, types.HistoryActionFinalize, types.HistoryActionActivate,
+	}, actions)
+
+	// the marker already exists
+	_, err = server.AddFinalizeActivateMarker(sdk.WrapSDKContext(ctx), msg)
+	require.Error(t, err)
+}
+
+func TestBurnCoinFrom(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	admin := testUserAddress("admin")
+	holder := testUserAddress("holder")
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, holder))
+
+	mac := types.NewEmptyMarkerAccount("redeemcoin", admin.String(), []types.AccessGrant{*types.NewAccessGrant(admin,
+		[]types.Access{types.Access_Withdraw, types.Access_Freeze, types.Access_BurnFrom})})
+	mac.MarkerType = types.MarkerType_RestrictedCoin
+	mac.SupplyFixed = true
+	require.NoError(t, mac.SetSupply(sdk.NewCoin("redeemcoin", sdk.NewInt(1000))))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, mac))
+	require.NoError(t, app.MarkerKeeper.FinalizeMarker(ctx, admin, "redeemcoin"))
+	require.NoError(t, app.MarkerKeeper.ActivateMarker(ctx, admin, "redeemcoin"))
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, holder, "redeemcoin",
+		sdk.NewCoins(sdk.NewInt64Coin("redeemcoin", 100))))
+
+	amount := sdk.NewInt64Coin("redeemcoin", 40)
+
+	// only accounts with burn from access can burn coin from a holder
+	require.EqualError(t, app.MarkerKeeper.BurnCoinFrom(ctx, holder, holder, amount),
+		fmt.Sprintf("%s does not have ACCESS_BURN_FROM on redeemcoin markeraccount", holder))
+
+	// coin can be burned from a frozen account and the fixed supply is reduced
+	require.NoError(t, app.MarkerKeeper.AddFrozenAccount(ctx, admin, "redeemcoin", holder))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, app.MarkerKeeper.BurnCoinFrom(ctx, admin, holder, amount))
+	require.Equal(t, int64(60), app.BankKeeper.GetBalance(ctx, holder, "redeemcoin").Amount.Int64())
+	require.Equal(t, int64(900), app.BankKeeper.GetBalance(ctx, mac.GetAddress(), "redeemcoin").Amount.Int64())
+	require.Equal(t, int64(960), app.BankKeeper.GetSupply(ctx, "redeemcoin").Amount.Int64())
+	m, err := app.MarkerKeeper.GetMarkerByDenom(ctx, "redeemcoin")
+	require.NoError(t, err)
+	require.Equal(t, int64(960), m.GetSupply().Amount.Int64())
+	events := ctx.EventManager().Events()
+	require.Equal(t, "provenance.marker.v1.EventMarkerBurnFrom", events[len(events)-1].Type)
+
+	// the holder must have the coin to burn
+	require.Error(t, app.MarkerKeeper.BurnCoinFrom(ctx, admin, holder, sdk.NewInt64Coin("redeemcoin", 100)))
+
+	// module and marker accounts can not be burned from
+	require.EqualError(t, app.MarkerKeeper.BurnCoinFrom(ctx, admin, mac.GetAddress(), amount),
+		fmt.Sprintf("funds can not be burned from module or marker account %s", mac.GetAddress()))
+
+	// burn from access is only supported on restricted markers
+	coinGrant := types.NewAccessGrant(admin, []types.Access{types.Access_BurnFrom})
+	require.Error(t, types.ValidateGrantsForMarkerType(types.MarkerType_Coin, *coinGrant))
+}
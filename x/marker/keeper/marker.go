@@ -2,10 +2,13 @@ package keeper
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/provenance-io/provenance/x/marker/types"
@@ -16,8 +19,9 @@ func (k Keeper) GetAllMarkerHolders(ctx sdk.Context, denom string) []types.Balan
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "get_all_marker_holders")
 
 	var results []types.Balance
-	k.bankKeeper.IterateAllBalances(ctx, func(addr sdk.AccAddress, coin sdk.Coin) (stop bool) {
-		if coin.Denom == denom && !coin.Amount.IsZero() {
+	k.IterateMarkerHolders(ctx, denom, func(addr sdk.AccAddress) (stop bool) {
+		coin := k.bankKeeper.GetBalance(ctx, addr, denom)
+		if !coin.Amount.IsZero() {
 			results = append(results,
 				types.Balance{
 					Address: addr.String(),
@@ -29,6 +33,66 @@ func (k Keeper) GetAllMarkerHolders(ctx sdk.Context, denom string) []types.Balan
 	return results
 }
 
+// IterateMarkerHolders iterates the addresses in the holder index of the given marker denom.
+func (k Keeper) IterateMarkerHolders(ctx sdk.Context, denom string, cb func(addr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.MarkerHolderKeyPrefixForDenom(denom))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.AccAddress(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// RebuildMarkerHolderIndex recreates the holder index of every marker from the balances held by the bank module.
+// This requires a scan of all balances and should only be used for genesis and migrations.
+func (k Keeper) RebuildMarkerHolderIndex(ctx sdk.Context) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "rebuild_marker_holder_index")
+
+	store := ctx.KVStore(k.storeKey)
+	denoms := make(map[string]bool)
+	k.IterateMarkers(ctx, func(marker types.MarkerAccountI) bool {
+		k.clearMarkerHolderIndex(ctx, marker.GetDenom())
+		denoms[marker.GetDenom()] = true
+		return false
+	})
+	k.bankKeeper.IterateAllBalances(ctx, func(addr sdk.AccAddress, coin sdk.Coin) (stop bool) {
+		if denoms[coin.Denom] {
+			setHolderIndex(store, coin.Denom, addr, coin)
+		}
+		return false
+	})
+}
+
+// indexMarkerHolders adds every current holder of the denom to the holder index.  Only required when a marker is
+// added for a denom that already has a supply.
+func (k Keeper) indexMarkerHolders(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	k.bankKeeper.IterateAllBalances(ctx, func(addr sdk.AccAddress, coin sdk.Coin) (stop bool) {
+		if coin.Denom == denom {
+			setHolderIndex(store, coin.Denom, addr, coin)
+		}
+		return false
+	})
+}
+
+// clearMarkerHolderIndex removes all holder index entries for the denom.
+func (k Keeper) clearMarkerHolderIndex(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.MarkerHolderKeyPrefixForDenom(denom))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
 // GetMarkerByDenom looks up marker with the given denom
 func (k Keeper) GetMarkerByDenom(ctx sdk.Context, denom string) (types.MarkerAccountI, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "get_marker_by_denom")
@@ -80,6 +144,11 @@ func (k Keeper) AddMarkerAccount(ctx sdk.Context, marker types.MarkerAccountI) e
 	}
 	k.SetMarker(ctx, marker)
 
+	// coin for this denom may already be in circulation, ensure any existing holders are in the index.
+	if k.bankKeeper.GetSupply(ctx, marker.GetDenom()).IsPositive() {
+		k.indexMarkerHolders(ctx, marker.GetDenom())
+	}
+
 	markerAddEvent := types.NewEventMarkerAdd(
 		marker.GetSupply().Denom,
 		marker.GetSupply().Amount.String(),
@@ -105,11 +174,14 @@ func (k Keeper) AddAccess(
 	if err != nil {
 		return fmt.Errorf("marker not found for %s: %s", denom, err)
 	}
+	if grant.IsExpired(ctx.BlockTime()) {
+		return fmt.Errorf("access grant expiration %s must be after the current block time", grant.GetExpiration())
+	}
 	switch m.GetStatus() {
 	// marker is fixed/active, assert permission to make changes by checking for Grant Permission
 	case types.StatusFinalized, types.StatusActive:
 		if !(caller.Equals(m.GetManager()) && m.GetStatus() == types.StatusFinalized) &&
-			!m.AddressHasAccess(caller, types.Access_Admin) &&
+			!m.AddressHasAccess(caller, types.Access_Admin, ctx.BlockTime()) &&
 			!k.accountControlsAllSupply(ctx, caller, m) {
 			return fmt.Errorf("%s is not authorized to make access list changes against finalized/active %s marker",
 				caller, m.GetDenom())
@@ -154,7 +226,7 @@ func (k Keeper) RemoveAccess(ctx sdk.Context, caller sdk.AccAddress, denom strin
 	// marker is fixed/active, assert permission to make changes by checking for Grant Permission
 	case types.StatusFinalized, types.StatusActive:
 		if !(caller.Equals(m.GetManager()) && m.GetStatus() == types.StatusFinalized) &&
-			!m.AddressHasAccess(caller, types.Access_Admin) &&
+			!m.AddressHasAccess(caller, types.Access_Admin, ctx.BlockTime()) &&
 			!k.accountControlsAllSupply(ctx, caller, m) {
 			return fmt.Errorf("%s is not authorized to make access list changes against finalized/active %s marker",
 				caller, m.GetDenom())
@@ -198,7 +270,7 @@ func (k Keeper) WithdrawCoins(
 	if err != nil {
 		return fmt.Errorf("marker not found for %s: %s", denom, err)
 	}
-	if !m.AddressHasAccess(caller, types.Access_Withdraw) {
+	if !m.AddressHasAccess(caller, types.Access_Withdraw, ctx.BlockTime()) {
 		return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Withdraw, m.GetDenom())
 	}
 	// check to see if marker is active (the coins created by a marker can only be withdrawn when it is active)
@@ -207,6 +279,12 @@ func (k Keeper) WithdrawCoins(
 		return fmt.Errorf("cannot withdraw marker created coins from a marker that is not in Active status")
 	}
 
+	for _, coin := range coins {
+		if k.IsAccountFrozen(ctx, coin.Denom, m.GetAddress()) {
+			return sdkerrors.Wrapf(types.ErrAccountFrozen, "%s markeraccount is frozen for %s", m.GetDenom(), coin.Denom)
+		}
+	}
+
 	if recipient.Empty() {
 		recipient = caller
 	}
@@ -215,6 +293,9 @@ func (k Keeper) WithdrawCoins(
 		[]banktypes.Output{banktypes.NewOutput(recipient, coins)}); err != nil {
 		return err
 	}
+	if m.HasFixedSupply() {
+		k.QueueSupplyCheck(ctx, m.GetAddress())
+	}
 
 	markerWithdrawEvent := types.NewEventMarkerWithdraw(coins.String(), denom, caller.String(), recipient.String())
 	if err := ctx.EventManager().EmitTypedEvent(markerWithdrawEvent); err != nil {
@@ -235,15 +316,21 @@ func (k Keeper) MintCoin(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin)
 	if err != nil {
 		return fmt.Errorf("marker not found for %s: %s", coin.Denom, err)
 	}
-	if !m.AddressHasAccess(caller, types.Access_Mint) {
+	if !m.AddressHasAccess(caller, types.Access_Mint, ctx.BlockTime()) {
 		return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Mint, m.GetDenom())
 	}
+	if m.HasIbcEnabled() {
+		return fmt.Errorf("supply of ibc enabled marker %s is managed by ibc transfers", m.GetDenom())
+	}
 
 	switch {
 	// For proposed, finalized accounts we allow adjusting the total_supply of the marker but we do not
 	// mint actual coin.
 	case m.GetStatus() == types.StatusProposed || m.GetStatus() == types.StatusFinalized:
 		total := m.GetSupply().Add(coin)
+		if m.ExceedsMaxSupply(total.Amount) {
+			return fmt.Errorf("requested supply %s exceeds max supply %s", total.Amount, m.GetMaxSupply())
+		}
 		if err = m.SetSupply(total); err != nil {
 			return err
 		}
@@ -261,6 +348,8 @@ func (k Keeper) MintCoin(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin)
 		}
 	}
 
+	k.recordMarkerHistory(ctx, m, types.HistoryActionMint, caller.String(), coin.Amount)
+
 	markerMintEvent := types.NewEventMarkerMint(coin.Amount.String(), coin.Denom, caller.String())
 	if err := ctx.EventManager().EmitTypedEvent(markerMintEvent); err != nil {
 		return err
@@ -278,9 +367,12 @@ func (k Keeper) BurnCoin(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin)
 	if err != nil {
 		return fmt.Errorf("marker not found for %s: %s", coin.Denom, err)
 	}
-	if !m.AddressHasAccess(caller, types.Access_Burn) {
+	if !m.AddressHasAccess(caller, types.Access_Burn, ctx.BlockTime()) {
 		return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Burn, m.GetDenom())
 	}
+	if m.HasIbcEnabled() {
+		return fmt.Errorf("supply of ibc enabled marker %s is managed by ibc transfers", m.GetDenom())
+	}
 
 	switch {
 	// For proposed, finalized accounts we allow adjusting the total_supply of the marker but we do not
@@ -303,6 +395,8 @@ func (k Keeper) BurnCoin(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin)
 		}
 	}
 
+	k.recordMarkerHistory(ctx, m, types.HistoryActionBurn, caller.String(), coin.Amount)
+
 	markerBurnEvent := types.NewEventMarkerBurn(coin.Amount.String(), coin.Denom, caller.String())
 	if err := ctx.EventManager().EmitTypedEvent(markerBurnEvent); err != nil {
 		return err
@@ -331,6 +425,9 @@ func (k Keeper) AdjustCirculation(ctx sdk.Context, marker types.MarkerAccountI,
 	}
 
 	if desiredSupply.Amount.GT(currentSupply) { // not enough coin in circulation, mint more.
+		if marker.ExceedsMaxSupply(desiredSupply.Amount) {
+			return fmt.Errorf("requested supply %s exceeds max supply %s", desiredSupply.Amount, marker.GetMaxSupply())
+		}
 		offset := sdk.NewCoin(marker.GetDenom(), desiredSupply.Amount.Sub(currentSupply))
 		ctx.Logger().Info(
 			fmt.Sprintf("Adjusting %s circulation: increasing supply by %s",
@@ -365,6 +462,10 @@ func (k Keeper) AdjustCirculation(ctx sdk.Context, marker types.MarkerAccountI,
 func (k Keeper) IncreaseSupply(ctx sdk.Context, marker types.MarkerAccountI, coin sdk.Coin) error {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "increase_supply")
 
+	if marker.HasIbcEnabled() {
+		return fmt.Errorf("supply of ibc enabled marker %s is managed by ibc transfers", marker.GetDenom())
+	}
+
 	inCirculation := sdk.NewCoin(marker.GetDenom(), k.bankKeeper.GetSupply(ctx, marker.GetDenom()).Amount)
 	total := inCirculation.Add(coin)
 	maxAllowed := sdk.NewCoin(marker.GetDenom(), sdk.NewIntFromUint64(k.GetParams(ctx).MaxTotalSupply))
@@ -372,6 +473,9 @@ func (k Keeper) IncreaseSupply(ctx sdk.Context, marker types.MarkerAccountI, coi
 		return fmt.Errorf(
 			"requested supply %d exceeds maximum allowed value %d", total.Amount, maxAllowed.Amount)
 	}
+	if marker.ExceedsMaxSupply(total.Amount) {
+		return fmt.Errorf("requested supply %s exceeds max supply %s", total.Amount, marker.GetMaxSupply())
+	}
 
 	// If the marker has a fixed supply then adjust the supply to match the new total
 	if marker.HasFixedSupply() {
@@ -391,6 +495,10 @@ func (k Keeper) IncreaseSupply(ctx sdk.Context, marker types.MarkerAccountI, coi
 func (k Keeper) DecreaseSupply(ctx sdk.Context, marker types.MarkerAccountI, coin sdk.Coin) error {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "decrease_supply")
 
+	if marker.HasIbcEnabled() {
+		return fmt.Errorf("supply of ibc enabled marker %s is managed by ibc transfers", marker.GetDenom())
+	}
+
 	inCirculation := sdk.NewCoin(marker.GetDenom(), k.bankKeeper.GetSupply(ctx, marker.GetDenom()).Amount)
 
 	// Ensure the request will not send the total supply below zero
@@ -454,6 +562,14 @@ func (k Keeper) FinalizeMarker(ctx sdk.Context, caller sdk.Address, denom string
 	// Any pre-existing coin amounts for our denom need to be removed from our amount to mint
 	preexistingCoin := sdk.NewCoin(m.GetDenom(), k.bankKeeper.GetSupply(ctx, m.GetDenom()).Amount)
 
+	// The supply of an ibc voucher is whatever has been received over ibc, there is nothing to mint.
+	if m.HasIbcEnabled() {
+		supplyRequest = preexistingCoin
+		if err = m.SetSupply(supplyRequest); err != nil {
+			return err
+		}
+	}
+
 	// If the requested total is less than the existing total, the supply invariant would halt the chain if activated
 	if supplyRequest.IsLT(preexistingCoin) {
 		return fmt.Errorf("marker supply %v has been defined as less than pre-existing"+
@@ -468,6 +584,7 @@ func (k Keeper) FinalizeMarker(ctx sdk.Context, caller sdk.Address, denom string
 		return err
 	}
 	k.SetMarker(ctx, m)
+	k.recordMarkerHistory(ctx, m, types.HistoryActionFinalize, caller.String(), sdk.ZeroInt())
 
 	// record status as finalized.
 	markerFinalizeEvent := types.NewEventMarkerFinalize(denom, caller.String())
@@ -513,15 +630,22 @@ func (k Keeper) ActivateMarker(ctx sdk.Context, caller sdk.Address, denom string
 	// Any pre-existing coin amounts for our denom need to be removed from our amount to mint
 	preexistingCoin := sdk.NewCoin(m.GetDenom(), k.bankKeeper.GetSupply(ctx, m.GetDenom()).Amount)
 
-	// If the requested total is less than the existing total, the supply invariant would halt the chain if activated
-	if supplyRequest.IsLT(preexistingCoin) {
-		return fmt.Errorf("marker supply %v has been defined as less than pre-existing"+
-			" supply %v, can not finalize marker", supplyRequest, preexistingCoin)
-	}
+	if m.HasIbcEnabled() {
+		// The supply of an ibc voucher is whatever has been received over ibc, there is nothing to mint.
+		if err = m.SetSupply(preexistingCoin); err != nil {
+			return err
+		}
+	} else {
+		// If the requested total is less than the existing total, the supply invariant would halt the chain if activated
+		if supplyRequest.IsLT(preexistingCoin) {
+			return fmt.Errorf("marker supply %v has been defined as less than pre-existing"+
+				" supply %v, can not finalize marker", supplyRequest, preexistingCoin)
+		}
 
-	// Ensure the supply amount requested is minted and placed in the marker's account
-	if err = k.AdjustCirculation(ctx, m, supplyRequest); err != nil {
-		return err
+		// Ensure the supply amount requested is minted and placed in the marker's account
+		if err = k.AdjustCirculation(ctx, m, supplyRequest); err != nil {
+			return err
+		}
 	}
 
 	// With the coin supply minted and assigned to the marker we can transition to the Active state.
@@ -534,6 +658,7 @@ func (k Keeper) ActivateMarker(ctx sdk.Context, caller sdk.Address, denom string
 	}
 	// record status as active
 	k.SetMarker(ctx, m)
+	k.recordMarkerHistory(ctx, m, types.HistoryActionActivate, caller.String(), sdk.ZeroInt())
 
 	markerActivateEvent := types.NewEventMarkerActivate(denom, caller.String())
 	if err := ctx.EventManager().EmitTypedEvent(markerActivateEvent); err != nil {
@@ -555,7 +680,7 @@ func (k Keeper) CancelMarker(ctx sdk.Context, caller sdk.AccAddress, denom strin
 	switch m.GetStatus() {
 	case types.StatusFinalized, types.StatusActive:
 		// for active or finalized markers the caller must be assigned permission to perform this action.
-		if !m.AddressHasAccess(caller, types.Access_Delete) {
+		if !m.AddressHasAccess(caller, types.Access_Delete, ctx.BlockTime()) {
 			return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Delete, m.GetDenom())
 		}
 		// for finalized/active we need to ensure the full coin supply has been recalled as it will all be burned.
@@ -568,7 +693,7 @@ func (k Keeper) CancelMarker(ctx sdk.Context, caller sdk.AccAddress, denom strin
 		}
 	case types.StatusProposed:
 		// for a proposed marker either the manager or someone assigned `delete` can perform this action
-		if !(m.GetManager().Equals(caller) || m.AddressHasAccess(caller, types.Access_Delete)) {
+		if !(m.GetManager().Equals(caller) || m.AddressHasAccess(caller, types.Access_Delete, ctx.BlockTime())) {
 			return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Delete, m.GetDenom())
 		}
 	case types.StatusCancelled:
@@ -583,6 +708,7 @@ func (k Keeper) CancelMarker(ctx sdk.Context, caller sdk.AccAddress, denom strin
 		return err
 	}
 	k.SetMarker(ctx, m)
+	k.recordMarkerHistory(ctx, m, types.HistoryActionCancel, caller.String(), sdk.ZeroInt())
 
 	markerCancelEvent := types.NewEventMarkerCancel(denom, caller.String())
 	if err := ctx.EventManager().EmitTypedEvent(markerCancelEvent); err != nil {
@@ -603,7 +729,7 @@ func (k Keeper) DeleteMarker(ctx sdk.Context, caller sdk.AccAddress, denom strin
 	}
 
 	// either the manager [set if a proposed marker was cancelled] or someone assigned `delete` can perform this action
-	if !(m.GetManager().Equals(caller) || m.AddressHasAccess(caller, types.Access_Delete)) {
+	if !(m.GetManager().Equals(caller) || m.AddressHasAccess(caller, types.Access_Delete, ctx.BlockTime())) {
 		return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Delete, m.GetDenom())
 	}
 
@@ -643,6 +769,7 @@ func (k Keeper) DeleteMarker(ctx sdk.Context, caller sdk.AccAddress, denom strin
 		return err
 	}
 	k.SetMarker(ctx, m)
+	k.recordMarkerHistory(ctx, m, types.HistoryActionDestroy, caller.String(), sdk.ZeroInt())
 
 	markerDeleteEvent := types.NewEventMarkerDelete(denom, caller.String())
 	if err := ctx.EventManager().EmitTypedEvent(markerDeleteEvent); err != nil {
@@ -653,7 +780,8 @@ func (k Keeper) DeleteMarker(ctx sdk.Context, caller sdk.AccAddress, denom strin
 }
 
 // TransferCoin transfers restricted coins between to accounts when the administrator account holds the transfer
-// access right and the marker type is restricted_coin
+// access right and the marker type is restricted_coin.  If the marker has required attributes then a transfer
+// is also allowed without the transfer access right when the recipient holds all of the required attributes.
 func (k Keeper) TransferCoin(ctx sdk.Context, from, to, admin sdk.AccAddress, amount sdk.Coin) error {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "transfer_coin")
 
@@ -664,11 +792,20 @@ func (k Keeper) TransferCoin(ctx sdk.Context, from, to, admin sdk.AccAddress, am
 	if m.GetMarkerType() != types.MarkerType_RestrictedCoin {
 		return fmt.Errorf("marker type is not restricted_coin, brokered transfer not supported")
 	}
-	if !m.AddressHasAccess(admin, types.Access_Transfer) {
-		return fmt.Errorf("%s is not allowed to broker transfers", admin.String())
+	if k.IsAccountFrozen(ctx, amount.Denom, from) {
+		return sdkerrors.Wrapf(types.ErrAccountFrozen, "%s is frozen for %s", from, amount.Denom)
+	}
+	if !m.AddressHasAccess(admin, types.Access_Transfer, ctx.BlockTime()) {
+		hasAttrs, attrErr := k.hasRequiredAttributes(ctx, m, to)
+		if attrErr != nil {
+			return attrErr
+		}
+		if !hasAttrs {
+			return fmt.Errorf("%s is not allowed to broker transfers", admin.String())
+		}
 	}
 	if !admin.Equals(from) {
-		err = k.authzHandler(ctx, admin, from, amount)
+		err = k.authzHandler(ctx, admin, from, []sdk.AccAddress{to}, sdk.NewCoins(amount))
 		if err != nil {
 			return err
 		}
@@ -676,7 +813,9 @@ func (k Keeper) TransferCoin(ctx sdk.Context, from, to, admin sdk.AccAddress, am
 	if k.bankKeeper.BlockedAddr(to) {
 		return fmt.Errorf("%s is not allowed to receive funds", to)
 	}
-
+	if err = k.beforeRestrictedTransfer(ctx, m, from, to, admin, amount); err != nil {
+		return err
+	}
 	// send the coins between accounts (does not check send_enabled on coin denom)
 	if err = k.bankKeeper.SendCoins(ctx, from, to, sdk.NewCoins(amount)); err != nil {
 		return err
@@ -696,24 +835,362 @@ func (k Keeper) TransferCoin(ctx sdk.Context, from, to, admin sdk.AccAddress, am
 	return nil
 }
 
-func (k Keeper) authzHandler(ctx sdk.Context, admin sdk.AccAddress, from sdk.AccAddress, amount sdk.Coin) error {
+// MultiTransferCoins transfers restricted coins for each of the transfer legs.  The administrator's transfer access
+// right is checked once for each marker and the transfer limit granted to the administrator by each holder is reduced
+// once by the total amount transferred out of the holder's account.  Every leg is checked before any coin is sent and
+// the failure of any leg fails all of them.
+func (k Keeper) MultiTransferCoins(ctx sdk.Context, admin sdk.AccAddress, legs []types.TransferLeg) error {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "multi_transfer_coins")
+
+	markers := make(map[string]types.MarkerAccountI)
+	canTransfer := make(map[string]bool)
+	// holders are kept in order of their first leg so the authorizations are updated deterministically.
+	var holders []sdk.AccAddress
+	holderAmounts := make(map[string]sdk.Coins)
+	holderRecipients := make(map[string][]sdk.AccAddress)
+	total := sdk.NewCoins()
+	fromAddrs := make([]sdk.AccAddress, len(legs))
+	toAddrs := make([]sdk.AccAddress, len(legs))
+
+	for i, leg := range legs {
+		from, err := sdk.AccAddressFromBech32(leg.FromAddress)
+		if err != nil {
+			return err
+		}
+		to, err := sdk.AccAddressFromBech32(leg.ToAddress)
+		if err != nil {
+			return err
+		}
+		fromAddrs[i], toAddrs[i] = from, to
+		denom := leg.Amount.Denom
+		m, found := markers[denom]
+		if !found {
+			m, err = k.GetMarkerByDenom(ctx, denom)
+			if err != nil {
+				return fmt.Errorf("marker not found for %s: %s", denom, err)
+			}
+			if m.GetMarkerType() != types.MarkerType_RestrictedCoin {
+				return fmt.Errorf("marker type is not restricted_coin, brokered transfer not supported")
+			}
+			markers[denom] = m
+			canTransfer[denom] = m.AddressHasAccess(admin, types.Access_Transfer, ctx.BlockTime())
+		}
+		if k.IsAccountFrozen(ctx, denom, from) {
+			return sdkerrors.Wrapf(types.ErrAccountFrozen, "%s is frozen for %s", from, denom)
+		}
+		if !canTransfer[denom] {
+			hasAttrs, attrErr := k.hasRequiredAttributes(ctx, m, to)
+			if attrErr != nil {
+				return attrErr
+			}
+			if !hasAttrs {
+				return fmt.Errorf("%s is not allowed to broker transfers", admin.String())
+			}
+		}
+		if k.bankKeeper.BlockedAddr(to) {
+			return fmt.Errorf("%s is not allowed to receive funds", to)
+		}
+		if err = k.beforeRestrictedTransfer(ctx, m, from, to, admin, leg.Amount); err != nil {
+			return err
+		}
+		if !admin.Equals(from) {
+			if _, seen := holderAmounts[from.String()]; !seen {
+				holders = append(holders, from)
+			}
+			holderAmounts[from.String()] = holderAmounts[from.String()].Add(leg.Amount)
+			holderRecipients[from.String()] = append(holderRecipients[from.String()], to)
+		}
+		total = total.Add(leg.Amount)
+	}
+
+	for _, holder := range holders {
+		if err := k.authzHandler(ctx, admin, holder, holderRecipients[holder.String()], holderAmounts[holder.String()]); err != nil {
+			return err
+		}
+	}
+
+	for i, leg := range legs {
+		// send the coins between accounts (does not check send_enabled on coin denom)
+		if err := k.bankKeeper.SendCoins(ctx, fromAddrs[i], toAddrs[i], sdk.NewCoins(leg.Amount)); err != nil {
+			return err
+		}
+		markerTransferEvent := types.NewEventMarkerTransfer(
+			leg.Amount.Amount.String(),
+			leg.Amount.Denom,
+			admin.String(),
+			leg.ToAddress,
+			leg.FromAddress,
+		)
+		if err := ctx.EventManager().EmitTypedEvent(markerTransferEvent); err != nil {
+			return err
+		}
+	}
+
+	multiTransferEvent := types.NewEventMarkerMultiTransfer(total.String(), admin.String(), len(legs))
+	return ctx.EventManager().EmitTypedEvent(multiTransferEvent)
+}
+
+// beforeRestrictedTransfer calls the transfer restriction hooks, if any, returning an error if the transfer is rejected.
+func (k Keeper) beforeRestrictedTransfer(
+	ctx sdk.Context, m types.MarkerAccountI, from, to, admin sdk.AccAddress, amount sdk.Coin,
+) error {
+	if k.transferHooks == nil {
+		return nil
+	}
+	if err := k.transferHooks.BeforeRestrictedTransfer(ctx, m, from, to, admin, amount); err != nil {
+		return fmt.Errorf("transfer of %s from %s to %s rejected: %w", amount, from, to, err)
+	}
+	return nil
+}
+
+// ForceTransferCoin transfers restricted coins out of any non-module account without the approval of the holder when
+// the administrator account holds the force transfer access right and forced transfers are enabled.  Accounts frozen
+// for the marker can be transferred from.
+func (k Keeper) ForceTransferCoin(ctx sdk.Context, from, to, admin sdk.AccAddress, amount sdk.Coin) error {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "force_transfer_coin")
+
+	if !k.GetEnableForceTransfer(ctx) {
+		return fmt.Errorf("forced transfers are not enabled")
+	}
+	m, err := k.GetMarkerByDenom(ctx, amount.Denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %s", amount.Denom, err)
+	}
+	if m.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return fmt.Errorf("marker type is not restricted_coin, forced transfer not supported")
+	}
+	if !m.AddressHasAccess(admin, types.Access_ForceTransfer, ctx.BlockTime()) {
+		return fmt.Errorf("%s does not have %s on %s markeraccount", admin, types.Access_ForceTransfer, m.GetDenom())
+	}
+	switch k.authKeeper.GetAccount(ctx, from).(type) {
+	case authtypes.ModuleAccountI, types.MarkerAccountI:
+		return fmt.Errorf("funds can not be force transferred from module or marker account %s", from)
+	}
+	if k.bankKeeper.BlockedAddr(to) {
+		return fmt.Errorf("%s is not allowed to receive funds", to)
+	}
+
+	// send the coins between accounts (does not check send_enabled on coin denom or if the account is frozen)
+	if err = k.bankKeeper.SendCoins(withFrozenBypass(ctx), from, to, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	markerForceTransferEvent := types.NewEventMarkerForceTransfer(
+		amount.Amount.String(),
+		amount.Denom,
+		admin.String(),
+		to.String(),
+		from.String(),
+	)
+	if err := ctx.EventManager().EmitTypedEvent(markerForceTransferEvent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// BurnCoinFrom burns restricted coin directly from the balance of any non-module account holding it when the
+// administrator account holds the burn from access right.  The coin is moved into the marker's escrow and burned
+// from there, reducing the supply of a marker with a fixed supply.  Accounts frozen for the marker can be burned from.
+func (k Keeper) BurnCoinFrom(ctx sdk.Context, admin, from sdk.AccAddress, coin sdk.Coin) error {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "burn_coin_from")
+
+	m, err := k.GetMarkerByDenom(ctx, coin.Denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %s", coin.Denom, err)
+	}
+	if m.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return fmt.Errorf("marker type is not restricted_coin, burn from not supported")
+	}
+	if !m.AddressHasAccess(admin, types.Access_BurnFrom, ctx.BlockTime()) {
+		return fmt.Errorf("%s does not have %s on %s markeraccount", admin, types.Access_BurnFrom, m.GetDenom())
+	}
+	if m.GetStatus() != types.StatusActive {
+		return fmt.Errorf("cannot burn coin from an account for a marker that is not in Active status")
+	}
+	switch k.authKeeper.GetAccount(ctx, from).(type) {
+	case authtypes.ModuleAccountI, types.MarkerAccountI:
+		return fmt.Errorf("funds can not be burned from module or marker account %s", from)
+	}
+
+	// move the coin into escrow so it can be burned along with the supply reduction (does not check send_enabled on
+	// coin denom or if the account is frozen)
+	if err = k.bankKeeper.SendCoins(withFrozenBypass(ctx), from, m.GetAddress(), sdk.NewCoins(coin)); err != nil {
+		return err
+	}
+	if err = k.DecreaseSupply(ctx, m, coin); err != nil {
+		return err
+	}
+
+	k.recordMarkerHistory(ctx, m, types.HistoryActionBurn, admin.String(), coin.Amount)
+
+	markerBurnFromEvent := types.NewEventMarkerBurnFrom(coin.Amount.String(), coin.Denom, admin.String(), from.String())
+	if err := ctx.EventManager().EmitTypedEvent(markerBurnFromEvent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// hasRequiredAttributes returns true if the marker has required attributes and the account holds all of them.
+func (k Keeper) hasRequiredAttributes(ctx sdk.Context, m types.MarkerAccountI, acc sdk.AccAddress) (bool, error) {
+	required := m.GetRequiredAttributes()
+	if len(required) == 0 {
+		return false, nil
+	}
+	attributes, err := k.attrKeeper.GetAllAttributes(ctx, acc)
+	if err != nil {
+		return false, fmt.Errorf("could not get attributes for %s: %w", acc, err)
+	}
+	held := make(map[string]bool, len(attributes))
+	for _, attr := range attributes {
+		held[strings.ToLower(strings.TrimSpace(attr.Name))] = true
+	}
+	for _, name := range required {
+		if !held[strings.ToLower(strings.TrimSpace(name))] {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// authzHandler checks the MarkerTransferAuthorization granted to the admin by the from account allows the amount to
+// be transferred to each of the recipients and reduces the transfer limit of the authorization by the amount.
+func (k Keeper) authzHandler(ctx sdk.Context, admin, from sdk.AccAddress, recipients []sdk.AccAddress, amount sdk.Coins) error {
 	markerAuth := types.MarkerTransferAuthorization{}
 	authorization, expireTime := k.authzKeeper.GetCleanAuthorization(ctx, admin, from, markerAuth.MsgTypeURL())
 	if authorization == nil {
 		return fmt.Errorf("%s account has not been granted authority to withdraw from %s account", admin, from)
 	}
-	accept, err := authorization.Accept(ctx, &types.MsgTransferRequest{Amount: amount})
-	if err != nil {
+	transferAuth, ok := authorization.(*types.MarkerTransferAuthorization)
+	if !ok {
+		return fmt.Errorf("authorization was not accepted for %s", admin)
+	}
+	for _, to := range recipients {
+		if !transferAuth.IsAllowed(to.String()) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot transfer to %s address", to)
+		}
+	}
+	limitLeft, isNegative := transferAuth.TransferLimit.SafeSub(amount)
+	if isNegative {
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "requested amount is more than spend limit")
+	}
+	if limitLeft.IsZero() {
+		return k.authzKeeper.DeleteGrant(ctx, admin, from, markerAuth.MsgTypeURL())
+	}
+	return k.authzKeeper.SaveGrant(ctx, admin, from,
+		&types.MarkerTransferAuthorization{TransferLimit: limitLeft, AllowList: transferAuth.AllowList}, expireTime)
+}
+
+// AddFrozenAccount prevents the given account from transferring the coin of a restricted marker.  The caller must hold
+// the freeze access right on the marker.
+func (k Keeper) AddFrozenAccount(ctx sdk.Context, caller sdk.AccAddress, denom string, addr sdk.AccAddress) error {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "freeze_account")
+
+	if _, err := k.getFreezableMarker(ctx, caller, denom); err != nil {
 		return err
 	}
-	if accept.Accept {
-		limitLeft, _ := authorization.(*types.MarkerTransferAuthorization).DecreaseTransferLimit(amount)
-		if limitLeft.IsZero() {
-			return k.authzKeeper.DeleteGrant(ctx, admin, from, markerAuth.MsgTypeURL())
+	if k.IsAccountFrozen(ctx, denom, addr) {
+		return fmt.Errorf("%s is already frozen for %s", addr, denom)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MarkerFrozenAccountKey(denom, addr), addr)
+
+	markerFreezeEvent := types.NewEventMarkerFreezeAccount(denom, caller.String(), addr.String())
+	if err := ctx.EventManager().EmitTypedEvent(markerFreezeEvent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RemoveFrozenAccount allows a frozen account to transfer the coin of a restricted marker again.  The caller must hold
+// the freeze access right on the marker.
+func (k Keeper) RemoveFrozenAccount(ctx sdk.Context, caller sdk.AccAddress, denom string, addr sdk.AccAddress) error {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "unfreeze_account")
+
+	if _, err := k.getFreezableMarker(ctx, caller, denom); err != nil {
+		return err
+	}
+	if !k.IsAccountFrozen(ctx, denom, addr) {
+		return fmt.Errorf("%s is not frozen for %s", addr, denom)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.MarkerFrozenAccountKey(denom, addr))
+
+	markerUnfreezeEvent := types.NewEventMarkerUnfreezeAccount(denom, caller.String(), addr.String())
+	if err := ctx.EventManager().EmitTypedEvent(markerUnfreezeEvent); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// getFreezableMarker returns the restricted marker for the denom if the caller is allowed to freeze its accounts.
+func (k Keeper) getFreezableMarker(ctx sdk.Context, caller sdk.AccAddress, denom string) (types.MarkerAccountI, error) {
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return nil, fmt.Errorf("marker not found for %s: %s", denom, err)
+	}
+	if m.GetMarkerType() != types.MarkerType_RestrictedCoin {
+		return nil, fmt.Errorf("marker type is not restricted_coin, account freeze not supported")
+	}
+	if !m.AddressHasAccess(caller, types.Access_Freeze, ctx.BlockTime()) {
+		return nil, fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Freeze, m.GetDenom())
+	}
+	return m, nil
+}
+
+// IsMarkerAdmin returns true if the address holds the admin access on the marker with the given denom.
+func (k Keeper) IsMarkerAdmin(ctx sdk.Context, denom string, addr sdk.AccAddress) bool {
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil || m == nil {
+		return false
+	}
+	return m.AddressHasAccess(addr, types.Access_Admin, ctx.BlockTime())
+}
+
+// IsAccountFrozen returns true if the account has been frozen for the given marker denom.
+func (k Keeper) IsAccountFrozen(ctx sdk.Context, denom string, addr sdk.AccAddress) bool {
+	return isAccountFrozen(ctx.KVStore(k.storeKey), denom, addr)
+}
+
+// IterateFrozenAccounts iterates the addresses of all accounts frozen for the given marker denom.
+func (k Keeper) IterateFrozenAccounts(ctx sdk.Context, denom string, cb func(addr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.MarkerFrozenAccountKeyPrefixForDenom(denom))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.AccAddress(iterator.Value())) {
+			break
 		}
-		return k.authzKeeper.SaveGrant(ctx, admin, from, &types.MarkerTransferAuthorization{TransferLimit: limitLeft}, expireTime)
 	}
-	return fmt.Errorf("authorization was not accepted for %s", admin)
+}
+
+// GetFrozenAccounts returns the addresses of all accounts frozen for the given marker denom.
+func (k Keeper) GetFrozenAccounts(ctx sdk.Context, denom string) []sdk.AccAddress {
+	var addrs []sdk.AccAddress
+	k.IterateFrozenAccounts(ctx, denom, func(addr sdk.AccAddress) bool {
+		addrs = append(addrs, addr)
+		return false
+	})
+	return addrs
+}
+
+// clearFrozenAccounts removes all frozen account entries for the denom.
+func (k Keeper) clearFrozenAccounts(ctx sdk.Context, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	for _, addr := range k.GetFrozenAccounts(ctx, denom) {
+		store.Delete(types.MarkerFrozenAccountKey(denom, addr))
+	}
+}
+
+// isAccountFrozen returns true if the store holds a frozen account entry for the denom and address.
+func isAccountFrozen(store sdk.KVStore, denom string, addr sdk.AccAddress) bool {
+	return store.Has(types.MarkerFrozenAccountKey(denom, addr))
 }
 
 // SetMarkerDenomMetadata updates the denom metadata records for the current marker.
@@ -727,9 +1204,13 @@ func (k Keeper) SetMarkerDenomMetadata(ctx sdk.Context, metadata banktypes.Metad
 	if markerErr != nil {
 		return fmt.Errorf("marker not found for %s: %w", metadata.Base, markerErr)
 	}
-	if !marker.GetManager().Equals(caller) && !marker.AddressHasAccess(caller, types.Access_Admin) {
+	if !marker.GetManager().Equals(caller) && !marker.AddressHasAccess(caller, types.Access_Admin, ctx.BlockTime()) {
 		return fmt.Errorf("%s is not allowed to manage marker metadata", caller.String())
 	}
+	// the metadata of an ibc voucher describes coin issued on another chain so only governance may set it.
+	if marker.HasIbcEnabled() {
+		return fmt.Errorf("metadata of ibc enabled marker %s can only be set by governance proposal", metadata.Base)
+	}
 
 	var existing *banktypes.Metadata
 	if e, _ := k.bankKeeper.GetDenomMetaData(ctx, metadata.Base); len(e.Base) > 0 {
@@ -754,6 +1235,60 @@ func (k Keeper) SetMarkerDenomMetadata(ctx sdk.Context, metadata banktypes.Metad
 	return nil
 }
 
+// ChangeMarkerManager assigns a new manager to a marker, including active markers whose manager was cleared on
+// activation.  The caller must be the current manager or hold the admin access right on the marker.
+func (k Keeper) ChangeMarkerManager(ctx sdk.Context, caller sdk.AccAddress, denom string, newManager sdk.AccAddress) error {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "change_marker_manager")
+
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %w", denom, err)
+	}
+	if !m.GetManager().Equals(caller) && !m.AddressHasAccess(caller, types.Access_Admin, ctx.BlockTime()) {
+		return fmt.Errorf("%s is not allowed to change the manager of %s", caller.String(), denom)
+	}
+	return k.setMarkerManager(ctx, m, caller.String(), newManager)
+}
+
+// SetMarkerTransferRestrictionContract sets or clears the smart contract that approves transfers of a restricted
+// marker.  The caller must hold the admin access right on the marker.
+func (k Keeper) SetMarkerTransferRestrictionContract(ctx sdk.Context, caller sdk.AccAddress, denom string, contract string) error {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "set_transfer_restriction_contract")
+
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %w", denom, err)
+	}
+	if !m.AddressHasAccess(caller, types.Access_Admin, ctx.BlockTime()) {
+		return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Admin, denom)
+	}
+	if err = m.SetTransferRestrictionContract(contract); err != nil {
+		return err
+	}
+	k.SetMarker(ctx, m)
+
+	setContractEvent := types.NewEventMarkerSetTransferRestrictionContract(denom, caller.String(), contract)
+	return ctx.EventManager().EmitTypedEvent(setContractEvent)
+}
+
+// setMarkerManager assigns a new manager to the marker, records it, and emits the change event.
+func (k Keeper) setMarkerManager(ctx sdk.Context, m types.MarkerAccountI, administrator string, newManager sdk.AccAddress) error {
+	if newManager.Equals(m.GetAddress()) {
+		return fmt.Errorf("marker can not be self managed")
+	}
+	oldManager := m.GetManager()
+	if err := m.SetManager(newManager); err != nil {
+		return err
+	}
+	if err := m.Validate(); err != nil {
+		return err
+	}
+	k.SetMarker(ctx, m)
+
+	changeManagerEvent := types.NewEventMarkerChangeManager(m.GetDenom(), administrator, oldManager.String(), newManager.String())
+	return ctx.EventManager().EmitTypedEvent(changeManagerEvent)
+}
+
 // accountControlsAllSupply return true if the caller account address possess 100% of the total supply of a marker.
 // This check is used to determine if an account should be allowed to perform defacto admin operations on a marker.
 func (k Keeper) accountControlsAllSupply(ctx sdk.Context, caller sdk.AccAddress, m types.MarkerAccountI) bool {
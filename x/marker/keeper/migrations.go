@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	v042 "github.com/provenance-io/provenance/x/marker/legacy/v042"
+	"github.com/provenance-io/provenance/x/marker/types"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -27,3 +28,37 @@ func (m *Migrator) Migrate1to2(ctx sdk.Context) error {
 	ctx.Logger().Info("Finished Migrating Marker Module from Version 1 to 2")
 	return err
 }
+
+// Migrate2to3 migrates from version 2 to 3 by building the marker holder index.
+func (m *Migrator) Migrate2to3(ctx sdk.Context) error {
+	ctx.Logger().Info("Migrating Marker Module from Version 2 to 3")
+	m.keeper.RebuildMarkerHolderIndex(ctx)
+	ctx.Logger().Info("Finished Migrating Marker Module from Version 2 to 3")
+	return nil
+}
+
+// Migrate3to4 migrates from version 3 to 4 by queueing a supply check of all markers.
+func (m *Migrator) Migrate3to4(ctx sdk.Context) error {
+	ctx.Logger().Info("Migrating Marker Module from Version 3 to 4")
+	m.keeper.queueAllSupplyChecks(ctx)
+	ctx.Logger().Info("Finished Migrating Marker Module from Version 3 to 4")
+	return nil
+}
+
+// Migrate4to5 migrates from version 4 to 5 by setting the default value of the enable force transfer param.
+func (m *Migrator) Migrate4to5(ctx sdk.Context) error {
+	ctx.Logger().Info("Migrating Marker Module from Version 4 to 5")
+	if !m.keeper.paramSpace.Has(ctx, types.ParamStoreKeyEnableForceTransfer) {
+		m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyEnableForceTransfer, types.DefaultEnableForceTransfer)
+	}
+	ctx.Logger().Info("Finished Migrating Marker Module from Version 4 to 5")
+	return nil
+}
+
+// Migrate5to6 migrates from version 5 to 6 by building the marker grantee index.
+func (m *Migrator) Migrate5to6(ctx sdk.Context) error {
+	ctx.Logger().Info("Migrating Marker Module from Version 5 to 6")
+	m.keeper.RebuildMarkerGranteeIndex(ctx)
+	ctx.Logger().Info("Finished Migrating Marker Module from Version 5 to 6")
+	return nil
+}
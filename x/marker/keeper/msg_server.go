@@ -38,6 +38,12 @@ func (k msgServer) AddMarker(goCtx context.Context, msg *types.MsgAddMarkerReque
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "a marker can not be created in an ACTIVE status")
 	}
 
+	// Markers wrapping an ibc voucher denom are only added by governance, see HandleAddMarkerProposal, as anyone could
+	// otherwise claim the denom of an existing voucher.
+	if msg.IbcEnabled {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "ibc enabled markers can only be added by governance proposal")
+	}
+
 	// Add marker requests must pass extra validation for denom (in addition to regular coin validation expression)
 	if err = k.ValidateUnrestictedDenom(ctx, msg.Amount.Denom); err != nil {
 		return nil, err
@@ -62,6 +68,13 @@ func (k msgServer) AddMarker(goCtx context.Context, msg *types.MsgAddMarkerReque
 		msg.Status,
 		msg.MarkerType)
 	ma.SupplyFixed = msg.SupplyFixed
+	ma.RequiredAttributes = msg.RequiredAttributes
+	ma.TransferRestrictionContract = msg.TransferRestrictionContract
+	if !msg.MaxSupply.IsNil() {
+		if err = ma.SetMaxSupply(msg.MaxSupply); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
 
 	if k.GetEnableGovernance(ctx) {
 		ma.AllowGovernanceControl = true
@@ -73,6 +86,7 @@ func (k msgServer) AddMarker(goCtx context.Context, msg *types.MsgAddMarkerReque
 		ctx.Logger().Error("unable to add marker", "err", err)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
+	k.recordMarkerHistory(ctx, ma, types.HistoryActionAdd, msg.FromAddress, sdk.ZeroInt())
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -446,3 +460,401 @@ func (k msgServer) SetDenomMetadata(
 
 	return &types.MsgSetDenomMetadataResponse{}, nil
 }
+
+// FreezeAccount handles a message to freeze an account holding the coin of a restricted marker
+func (k msgServer) FreezeAccount(
+	goCtx context.Context,
+	msg *types.MsgFreezeAccountRequest,
+) (*types.MsgFreezeAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := k.Keeper.AddFrozenAccount(ctx, msg.GetSigners()[0], msg.Denom, addr); err != nil {
+		ctx.Logger().Error("unable to freeze account", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgFreezeAccountResponse{}, nil
+}
+
+// UnfreezeAccount handles a message to unfreeze an account holding the coin of a restricted marker
+func (k msgServer) UnfreezeAccount(
+	goCtx context.Context,
+	msg *types.MsgUnfreezeAccountRequest,
+) (*types.MsgUnfreezeAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := k.Keeper.RemoveFrozenAccount(ctx, msg.GetSigners()[0], msg.Denom, addr); err != nil {
+		ctx.Logger().Error("unable to unfreeze account", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgUnfreezeAccountResponse{}, nil
+}
+
+// ForceTransfer handles a message to move restricted marker coin out of an account without the holder's approval
+func (k msgServer) ForceTransfer(
+	goCtx context.Context,
+	msg *types.MsgForceTransferRequest,
+) (*types.MsgForceTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+	admin, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.ForceTransferCoin(ctx, from, to, admin, msg.Amount); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, types.EventTelemetryKeyForceTransfer},
+			1,
+			[]metrics.Label{
+				telemetry.NewLabel(types.EventTelemetryLabelToAddress, msg.ToAddress),
+				telemetry.NewLabel(types.EventTelemetryLabelFromAddress, msg.FromAddress),
+				telemetry.NewLabel(types.EventTelemetryLabelDenom, msg.Amount.Denom),
+				telemetry.NewLabel(types.EventTelemetryLabelAdministrator, msg.Administrator),
+			},
+		)
+	}()
+
+	return &types.MsgForceTransferResponse{}, nil
+}
+
+// Distribute handles a message to pay out coin held in a marker's escrow to the holders of the marker.
+func (k msgServer) Distribute(goCtx context.Context, msg *types.MsgDistributeRequest) (*types.MsgDistributeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	admin, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.DistributeCoins(ctx, admin, msg.Denom, msg.Amount); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, types.EventTelemetryKeyDistribute},
+			1,
+			[]metrics.Label{
+				telemetry.NewLabel(types.EventTelemetryLabelDenom, msg.Denom),
+				telemetry.NewLabel(types.EventTelemetryLabelAdministrator, msg.Administrator),
+			},
+		)
+	}()
+
+	return &types.MsgDistributeResponse{}, nil
+}
+
+// MultiTransfer handles a message to atomically transfer restricted coins between many accounts
+func (k msgServer) MultiTransfer(goCtx context.Context, msg *types.MsgMultiTransferRequest) (*types.MsgMultiTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	admin, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.MultiTransferCoins(ctx, admin, msg.Legs); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, types.EventTelemetryKeyMultiTransfer},
+			float32(len(msg.Legs)),
+			[]metrics.Label{
+				telemetry.NewLabel(types.EventTelemetryLabelAdministrator, msg.Administrator),
+			},
+		)
+	}()
+
+	return &types.MsgMultiTransferResponse{}, nil
+}
+
+// ChangeManager handles a message to assign a new manager to a marker
+func (k msgServer) ChangeManager(goCtx context.Context, msg *types.MsgChangeManagerRequest) (*types.MsgChangeManagerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	newManager, err := sdk.AccAddressFromBech32(msg.NewManager)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if err := k.Keeper.ChangeMarkerManager(ctx, msg.GetSigners()[0], msg.Denom, newManager); err != nil {
+		ctx.Logger().Error("unable to change marker manager", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgChangeManagerResponse{}, nil
+}
+
+// ScheduleWithdraw handles a message to withdraw coins from a marker account at a future release time
+func (k msgServer) ScheduleWithdraw(goCtx context.Context, msg *types.MsgScheduleWithdrawRequest) (*types.MsgScheduleWithdrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := k.Keeper.ScheduleWithdrawCoins(ctx, msg.GetSigners()[0], to, msg.Denom, msg.Amount, msg.ReleaseTime)
+	if err != nil {
+		ctx.Logger().Error("unable to schedule withdrawal from marker", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, types.EventTelemetryKeyScheduleWithdraw},
+			1,
+			[]metrics.Label{
+				telemetry.NewLabel(types.EventTelemetryLabelToAddress, msg.ToAddress),
+				telemetry.NewLabel(types.EventTelemetryLabelDenom, msg.Denom),
+				telemetry.NewLabel(types.EventTelemetryLabelAdministrator, msg.Administrator),
+			},
+		)
+	}()
+
+	return &types.MsgScheduleWithdrawResponse{Id: id}, nil
+}
+
+// CancelScheduledWithdraw handles a message to remove a scheduled withdrawal before it is released
+func (k msgServer) CancelScheduledWithdraw(
+	goCtx context.Context, msg *types.MsgCancelScheduledWithdrawRequest,
+) (*types.MsgCancelScheduledWithdrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := k.Keeper.CancelWithdrawSchedule(ctx, msg.GetSigners()[0], msg.Denom, msg.Id); err != nil {
+		ctx.Logger().Error("unable to cancel scheduled withdrawal", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgCancelScheduledWithdrawResponse{}, nil
+}
+
+// AddFinalizeActivateMarker handles a message to add a marker and take it through the finalized status to active in
+// a single request.  The marker is subject to the same checks as a marker finalized and activated with separate
+// requests, any failure leaves no trace of the marker.
+func (k msgServer) AddFinalizeActivateMarker(
+	goCtx context.Context, msg *types.MsgAddFinalizeActivateMarkerRequest,
+) (*types.MsgAddFinalizeActivateMarkerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// The marker is added in the proposed status with the sender as manager so that it can finalize and activate it.
+	if _, err := k.AddMarker(goCtx, msg.AddMarkerRequest()); err != nil {
+		return nil, err
+	}
+
+	caller := msg.GetSigners()[0]
+	if err := k.Keeper.FinalizeMarker(ctx, caller, msg.Amount.Denom); err != nil {
+		ctx.Logger().Error("unable to finalize marker", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if msg.Metadata != nil {
+		if err := k.Keeper.SetMarkerDenomMetadata(ctx, *msg.Metadata, caller); err != nil {
+			ctx.Logger().Error("unable to set denom metadata", "err", err)
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+	if err := k.Keeper.ActivateMarker(ctx, caller, msg.Amount.Denom); err != nil {
+		ctx.Logger().Error("unable to activate marker", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return &types.MsgAddFinalizeActivateMarkerResponse{}, nil
+}
+
+// BurnFrom handles a message to burn restricted marker coin from the balance of an account holding it.
+func (k msgServer) BurnFrom(goCtx context.Context, msg *types.MsgBurnFromRequest) (*types.MsgBurnFromResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	admin, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		return nil, err
+	}
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.BurnCoinFrom(ctx, admin, from, msg.Amount); err != nil {
+		ctx.Logger().Error("unable to burn coin from account", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, types.EventTelemetryKeyBurnFrom},
+			1,
+			[]metrics.Label{
+				telemetry.NewLabel(types.EventTelemetryLabelFromAddress, msg.FromAddress),
+				telemetry.NewLabel(types.EventTelemetryLabelDenom, msg.Amount.Denom),
+				telemetry.NewLabel(types.EventTelemetryLabelAdministrator, msg.Administrator),
+			},
+		)
+	}()
+
+	return &types.MsgBurnFromResponse{}, nil
+}
+
+// SetTransferRestrictionContract handles a message to set or clear the transfer restriction contract of a marker
+func (k msgServer) SetTransferRestrictionContract(
+	goCtx context.Context, msg *types.MsgSetTransferRestrictionContractRequest,
+) (*types.MsgSetTransferRestrictionContractResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Validate transaction message.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := k.Keeper.SetMarkerTransferRestrictionContract(ctx, msg.GetSigners()[0], msg.Denom, msg.Contract); err != nil {
+		ctx.Logger().Error("unable to set transfer restriction contract", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgSetTransferRestrictionContractResponse{}, nil
+}
@@ -15,6 +15,7 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 		MaxTotalSupply:         k.GetMaxTotalSupply(ctx),
 		EnableGovernance:       k.GetEnableGovernance(ctx),
 		UnrestrictedDenomRegex: k.GetUnrestrictedDenomRegex(ctx),
+		EnableForceTransfer:    k.GetEnableForceTransfer(ctx),
 	}
 }
 
@@ -54,6 +55,15 @@ func (k Keeper) GetUnrestrictedDenomRegex(ctx sdk.Context) (regex string) {
 	return
 }
 
+// GetEnableForceTransfer returns the current parameter value for enabling forced transfers (or default if unset)
+func (k Keeper) GetEnableForceTransfer(ctx sdk.Context) (enabled bool) {
+	enabled = types.DefaultEnableForceTransfer
+	if k.paramSpace.Has(ctx, types.ParamStoreKeyEnableForceTransfer) {
+		k.paramSpace.Get(ctx, types.ParamStoreKeyEnableForceTransfer, &enabled)
+	}
+	return
+}
+
 // ValidateUnrestictedDenom checks if the supplied denom is valid based on the module params
 func (k Keeper) ValidateUnrestictedDenom(ctx sdk.Context, denom string) error {
 	// Anchors are enforced on the denom validation expression.  Similar to how the SDK does hits.
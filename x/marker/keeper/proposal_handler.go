@@ -4,7 +4,9 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/provenance-io/provenance/x/marker/types"
 )
@@ -27,6 +29,7 @@ func HandleAddMarkerProposal(ctx sdk.Context, k Keeper, c *types.AddMarkerPropos
 	newMarker.AllowGovernanceControl = c.AllowGovernanceControl
 	newMarker.SupplyFixed = c.SupplyFixed
 	newMarker.MarkerType = c.MarkerType
+	newMarker.IbcEnabled = c.IbcEnabled
 
 	if err := newMarker.SetSupply(c.Amount); err != nil {
 		return err
@@ -39,6 +42,11 @@ func HandleAddMarkerProposal(ctx sdk.Context, k Keeper, c *types.AddMarkerPropos
 	if err := newMarker.Validate(); err != nil {
 		return err
 	}
+	if newMarker.IbcEnabled {
+		if err := k.validateIbcDenomTrace(ctx, newMarker.Denom); err != nil {
+			return err
+		}
+	}
 
 	if err := k.AddMarkerAccount(ctx, newMarker); err != nil {
 		return err
@@ -50,6 +58,7 @@ func HandleAddMarkerProposal(ctx sdk.Context, k Keeper, c *types.AddMarkerPropos
 			return err
 		}
 	}
+	k.recordMarkerHistory(ctx, newMarker, types.HistoryActionAdd, govActor(), sdk.ZeroInt())
 
 	logger := k.Logger(ctx)
 	logger.Info("a new marker was added", "marker", c.Amount.Denom, "supply", c.Amount.String())
@@ -77,6 +86,9 @@ func HandleSupplyIncreaseProposal(ctx sdk.Context, k Keeper, c *types.SupplyIncr
 
 	if m.GetStatus() == types.StatusProposed || m.GetStatus() == types.StatusFinalized {
 		total := m.GetSupply().Add(c.Amount)
+		if m.ExceedsMaxSupply(total.Amount) {
+			return fmt.Errorf("requested supply %s exceeds max supply %s", total.Amount, m.GetMaxSupply())
+		}
 		if err = m.SetSupply(total); err != nil {
 			return err
 		}
@@ -84,6 +96,7 @@ func HandleSupplyIncreaseProposal(ctx sdk.Context, k Keeper, c *types.SupplyIncr
 			return err
 		}
 		k.SetMarker(ctx, m)
+		k.recordMarkerHistory(ctx, m, types.HistoryActionMint, govActor(), c.Amount.Amount)
 		logger.Info("marker configured supply increased", "marker", c.Amount.Denom, "amount", c.Amount.Amount.String())
 		return nil
 	} else if m.GetStatus() != types.StatusActive {
@@ -93,6 +106,7 @@ func HandleSupplyIncreaseProposal(ctx sdk.Context, k Keeper, c *types.SupplyIncr
 	if err := k.IncreaseSupply(ctx, m, c.Amount); err != nil {
 		return err
 	}
+	k.recordMarkerHistory(ctx, m, types.HistoryActionMint, govActor(), c.Amount.Amount)
 
 	logger.Info("marker total supply increased", "marker", c.Amount.Denom, "amount", c.Amount.Amount.String())
 
@@ -135,6 +149,7 @@ func HandleSupplyDecreaseProposal(ctx sdk.Context, k Keeper, c *types.SupplyDecr
 	if err := k.DecreaseSupply(ctx, m, c.Amount); err != nil {
 		return err
 	}
+	k.recordMarkerHistory(ctx, m, types.HistoryActionBurn, govActor(), c.Amount.Amount)
 
 	logger := k.Logger(ctx)
 	logger.Info("marker total supply reduced", "marker", c.Amount.Denom, "amount", c.Amount.Amount.String())
@@ -159,7 +174,9 @@ func HandleSetAdministratorProposal(ctx sdk.Context, k Keeper, c *types.SetAdmin
 		return fmt.Errorf("%s marker does not allow governance control", c.Denom)
 	}
 	for _, a := range c.Access {
-		if err := m.GrantAccess(types.NewAccessGrant(a.GetAddress(), a.Permissions)); err != nil {
+		grant := types.NewAccessGrant(a.GetAddress(), a.Permissions)
+		grant.Expiration = a.Expiration
+		if err := m.GrantAccess(grant); err != nil {
 			return err
 		}
 		logger := k.Logger(ctx)
@@ -174,6 +191,36 @@ func HandleSetAdministratorProposal(ctx sdk.Context, k Keeper, c *types.SetAdmin
 	return nil
 }
 
+// HandleChangeManagerProposal handles a ChangeManager governance proposal request
+func HandleChangeManagerProposal(ctx sdk.Context, k Keeper, c *types.ChangeManagerProposal) error {
+	addr, err := types.MarkerAddress(c.Denom)
+	if err != nil {
+		return err
+	}
+	m, err := k.GetMarker(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if m == nil {
+		return fmt.Errorf("%s marker does not exist", c.Denom)
+	}
+	if !m.HasGovernanceEnabled() {
+		return fmt.Errorf("%s marker does not allow governance control", c.Denom)
+	}
+	newManager, err := sdk.AccAddressFromBech32(c.NewManager)
+	if err != nil {
+		return err
+	}
+	if err := k.setMarkerManager(ctx, m, govActor(), newManager); err != nil {
+		return err
+	}
+
+	logger := k.Logger(ctx)
+	logger.Info("marker manager changed", "marker", c.Denom, "manager", c.NewManager)
+
+	return nil
+}
+
 // HandleRemoveAdministratorProposal handles a RemoveAdministrator governance proposal request
 func HandleRemoveAdministratorProposal(ctx sdk.Context, k Keeper, c *types.RemoveAdministratorProposal) error {
 	addr, err := types.MarkerAddress(c.Denom)
@@ -252,6 +299,7 @@ func HandleChangeStatusProposal(ctx sdk.Context, k Keeper, c *types.ChangeStatus
 		}
 	}
 
+	previous := m.GetStatus()
 	if err := m.SetStatus(c.NewStatus); err != nil {
 		return err
 	}
@@ -261,6 +309,9 @@ func HandleChangeStatusProposal(ctx sdk.Context, k Keeper, c *types.ChangeStatus
 	}
 
 	k.SetMarker(ctx, m)
+	if action := historyActionForStatus(c.NewStatus); previous != c.NewStatus && action != types.HistoryActionUnspecified {
+		k.recordMarkerHistory(ctx, m, action, govActor(), sdk.ZeroInt())
+	}
 
 	logger := k.Logger(ctx)
 	logger.Info("changed marker status", "marker", c.Denom, "stats", c.NewStatus.String())
@@ -323,3 +374,24 @@ func HandleSetDenomMetadataProposal(ctx sdk.Context, k Keeper, c *types.SetDenom
 	k.Logger(ctx).Info("denom metadata set for marker", "marker", c.Metadata.Base, "denom metadata", c.Metadata.String())
 	return nil
 }
+
+// govActor returns the address of the governance module account that changes made by proposals are attributed to.
+func govActor() string {
+	return authtypes.NewModuleAddress(govtypes.ModuleName).String()
+}
+
+// historyActionForStatus returns the history action recorded when a marker transitions to the given status.
+func historyActionForStatus(status types.MarkerStatus) types.MarkerHistoryAction {
+	switch status {
+	case types.StatusFinalized:
+		return types.HistoryActionFinalize
+	case types.StatusActive:
+		return types.HistoryActionActivate
+	case types.StatusCancelled:
+		return types.HistoryActionCancel
+	case types.StatusDestroyed:
+		return types.HistoryActionDestroy
+	default:
+		return types.HistoryActionUnspecified
+	}
+}
@@ -34,7 +34,7 @@ type IntegrationTestSuite struct {
 func (s *IntegrationTestSuite) SetupSuite() {
 	s.app = provenance.Setup(false)
 	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{})
-	s.k = markerkeeper.NewKeeper(s.app.AppCodec(), s.app.GetKey(markertypes.ModuleName), s.app.GetSubspace(markertypes.ModuleName), s.app.AccountKeeper, s.app.BankKeeper, s.app.AuthzKeeper, s.app.GetKey(banktypes.StoreKey))
+	s.k = markerkeeper.NewKeeper(s.app.AppCodec(), s.app.GetKey(markertypes.ModuleName), s.app.GetSubspace(markertypes.ModuleName), s.app.AccountKeeper, s.app.BankKeeper, s.app.AuthzKeeper, s.app.AttributeKeeper, s.app.GetKey(banktypes.StoreKey), s.app.TransferKeeper)
 	s.accountAddr = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 }
 
@@ -238,6 +238,33 @@ func (s *IntegrationTestSuite) TestMarkerProposals() {
 			nil,
 		},
 
+		// CHANGE MANAGER
+		{
+			"change manager - no governance",
+			markertypes.NewChangeManagerProposal("title", "description", "testnogov", s.accountAddr),
+			fmt.Errorf("testnogov marker does not allow governance control"),
+		},
+		{
+			"change manager - marker doesnot exist",
+			markertypes.NewChangeManagerProposal("title", "description", "test", s.accountAddr),
+			fmt.Errorf("test marker does not exist"),
+		},
+		{
+			"change manager - active marker",
+			markertypes.NewChangeManagerProposal("title", "description", "test1", s.accountAddr),
+			nil,
+		},
+		{
+			"change manager - add proposed marker",
+			markertypes.NewAddMarkerProposal("title", "description", "newmanager", sdk.NewInt(100), s.accountAddr, markertypes.StatusProposed, markertypes.MarkerType_Coin, []markertypes.AccessGrant{}, true, true),
+			nil,
+		},
+		{
+			"change manager - valid",
+			markertypes.NewChangeManagerProposal("title", "description", "newmanager", sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())),
+			nil,
+		},
+
 		// SET DENOM METADATA PROPOSALS
 		{
 			"set denom metadata - bad denom",
@@ -344,6 +371,8 @@ func (s *IntegrationTestSuite) TestMarkerProposals() {
 				err = markerkeeper.HandleWithdrawEscrowProposal(s.ctx, s.k, c)
 			case *markertypes.SetDenomMetadataProposal:
 				err = markerkeeper.HandleSetDenomMetadataProposal(s.ctx, s.k, c)
+			case *markertypes.ChangeManagerProposal:
+				err = markerkeeper.HandleChangeManagerProposal(s.ctx, s.k, c)
 			default:
 				panic("invalid proposal type")
 			}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -9,8 +10,8 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/provenance-io/provenance/x/marker/types"
 )
@@ -81,22 +82,15 @@ func (k Keeper) Holding(c context.Context, req *types.QueryHoldingRequest) (*typ
 	}
 
 	denom := marker.GetDenom()
-	balancesStore := prefix.NewStore(ctx.KVStore(k.bankKeeperStoreKey), banktypes.BalancesPrefix)
+	holderStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MarkerHolderKeyPrefixForDenom(denom))
 	var balances []types.Balance
-	pageRes, perr := query.FilteredPaginate(balancesStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
-		var coin sdk.Coin
-		if cerr := k.cdc.Unmarshal(value, &coin); cerr != nil {
-			return false, cerr
-		}
-		if coin.Denom != denom || coin.Amount.IsZero() {
+	pageRes, perr := query.FilteredPaginate(holderStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		address := sdk.AccAddress(value)
+		coin := k.bankKeeper.GetBalance(ctx, address, denom)
+		if coin.IsZero() {
 			return false, nil
 		}
 		if accumulate {
-			address, aerr := banktypes.AddressFromBalancesStore(key)
-			if aerr != nil {
-				k.Logger(ctx).With("key", key, "err", aerr).Error("failed to get address from balances store")
-				return true, aerr
-			}
 			balances = append(balances,
 				types.Balance{
 					Address: address.String(),
@@ -172,3 +166,186 @@ func (k Keeper) DenomMetadata(c context.Context, req *types.QueryDenomMetadataRe
 
 	return &types.QueryDenomMetadataResponse{Metadata: metadata}, nil
 }
+
+// FrozenAccounts query for all accounts frozen for the given marker coins
+func (k Keeper) FrozenAccounts(c context.Context, req *types.QueryFrozenAccountsRequest) (*types.QueryFrozenAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	frozenStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MarkerFrozenAccountKeyPrefixForDenom(marker.GetDenom()))
+	var addresses []string
+	pageRes, perr := query.Paginate(frozenStore, req.Pagination, func(key []byte, value []byte) error {
+		addresses = append(addresses, sdk.AccAddress(value).String())
+		return nil
+	})
+	if perr != nil {
+		return nil, perr
+	}
+
+	return &types.QueryFrozenAccountsResponse{
+		Addresses:  addresses,
+		Pagination: pageRes,
+	}, nil
+}
+
+// MarkersByGrantee query for all markers managed by or with access granted to an address
+func (k Keeper) MarkersByGrantee(c context.Context, req *types.QueryMarkersByGranteeRequest) (*types.QueryMarkersByGranteeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	grantee, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if _, ok := types.Access_name[int32(req.Permission)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid permission %d", req.Permission)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	granteeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MarkerGranteeKeyPrefixForAddress(grantee))
+	markers := make([]*codectypes.Any, 0)
+	pageRes, err := query.FilteredPaginate(granteeStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		marker, err := k.GetMarker(ctx, sdk.AccAddress(value))
+		if err != nil || marker == nil {
+			return false, err
+		}
+		if req.Permission == types.Access_Unknown {
+			// without a permission any marker the address manages or holds an unexpired grant on is included.
+			if !grantee.Equals(marker.GetManager()) && !hasUnexpiredGrant(marker, grantee, ctx.BlockTime()) {
+				return false, nil
+			}
+		} else if !marker.AddressHasAccess(grantee, req.Permission, ctx.BlockTime()) {
+			return false, nil
+		}
+		if accumulate {
+			any, anyErr := codectypes.NewAnyWithValue(marker)
+			if anyErr != nil {
+				return false, status.Errorf(codes.Internal, anyErr.Error())
+			}
+			markers = append(markers, any)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryMarkersByGranteeResponse{Markers: markers, Pagination: pageRes}, nil
+}
+
+// MarkerHistory query for the recorded status transitions and supply changes of a marker, oldest first
+func (k Keeper) MarkerHistory(c context.Context, req *types.QueryMarkerHistoryRequest) (*types.QueryMarkerHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	// the history outlives the marker account so it is found by the address derived from the denom once removed.
+	var markerAddr sdk.AccAddress
+	if marker, err := accountForDenomOrAddress(ctx, k, req.Id); err == nil && marker != nil {
+		markerAddr = marker.GetAddress()
+	} else {
+		if markerAddr, err = sdk.AccAddressFromBech32(req.Id); err != nil {
+			markerAddr, err = types.MarkerAddress(req.Id)
+		}
+		if err != nil || !k.hasMarkerHistory(ctx, types.MarkerHistoryKeyPrefixForAddress(markerAddr)) {
+			return nil, sdkerrors.Wrap(types.ErrMarkerNotFound, "invalid denom or address")
+		}
+	}
+
+	historyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MarkerHistoryKeyPrefixForAddress(markerAddr))
+	entries := make([]types.MarkerHistoryEntry, 0)
+	pageRes, perr := query.Paginate(historyStore, req.Pagination, func(key []byte, value []byte) error {
+		var entry types.MarkerHistoryEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+		return nil
+	})
+	if perr != nil {
+		return nil, perr
+	}
+
+	return &types.QueryMarkerHistoryResponse{
+		Entries:    entries,
+		Pagination: pageRes,
+	}, nil
+}
+
+// SupplyReport query for a reconciliation of the required supply of one or all markers against the bank module supply
+func (k Keeper) SupplyReport(c context.Context, req *types.QuerySupplyReportRequest) (*types.QuerySupplyReportResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if len(req.Id) > 0 {
+		marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+		if err != nil {
+			return nil, err
+		}
+		return &types.QuerySupplyReportResponse{Reports: []types.MarkerSupplyReport{k.GetSupplyReport(ctx, marker)}}, nil
+	}
+
+	markerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.MarkerStoreKeyPrefix)
+	reports := make([]types.MarkerSupplyReport, 0)
+	pageRes, err := query.Paginate(markerStore, req.Pagination, func(key []byte, value []byte) error {
+		marker, err := k.GetMarker(ctx, sdk.AccAddress(value))
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		if marker != nil {
+			reports = append(reports, k.GetSupplyReport(ctx, marker))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySupplyReportResponse{Reports: reports, Pagination: pageRes}, nil
+}
+
+// WithdrawSchedules query for the withdrawals scheduled against one or all markers that have not been released yet
+func (k Keeper) WithdrawSchedules(c context.Context, req *types.QueryWithdrawSchedulesRequest) (*types.QueryWithdrawSchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	storePrefix := types.MarkerWithdrawScheduleKeyPrefix
+	if len(req.Id) > 0 {
+		marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+		if err != nil {
+			return nil, err
+		}
+		storePrefix = types.MarkerWithdrawScheduleKeyPrefixForAddress(marker.GetAddress())
+	}
+
+	scheduleStore := prefix.NewStore(ctx.KVStore(k.storeKey), storePrefix)
+	schedules := make([]types.MarkerWithdrawSchedule, 0)
+	pageRes, err := query.Paginate(scheduleStore, req.Pagination, func(key []byte, value []byte) error {
+		var schedule types.MarkerWithdrawSchedule
+		if err := k.cdc.Unmarshal(value, &schedule); err != nil {
+			return err
+		}
+		schedules = append(schedules, schedule)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryWithdrawSchedulesResponse{Schedules: schedules, Pagination: pageRes}, nil
+}
+
+// hasUnexpiredGrant returns true if the address holds a grant with permissions on the marker that has not expired.
+func hasUnexpiredGrant(marker types.MarkerAccountI, addr sdk.AccAddress, blockTime time.Time) bool {
+	for _, grant := range marker.GetAccessList() {
+		if grant.Address == addr.String() && len(grant.Permissions) > 0 && !grant.IsExpired(blockTime) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// GetSupplyReport compares the required supply of a marker with the supply tracked by the bank module and the amount
+// held in the marker's escrow.
+func (k Keeper) GetSupplyReport(ctx sdk.Context, marker types.MarkerAccountI) types.MarkerSupplyReport {
+	totalSupply := k.CurrentCirculation(ctx, marker)
+	escrow := k.bankKeeper.GetBalance(ctx, marker.GetAddress(), marker.GetDenom()).Amount
+	report := types.MarkerSupplyReport{
+		Denom:          marker.GetDenom(),
+		Status:         marker.GetStatus(),
+		SupplyFixed:    marker.HasFixedSupply(),
+		RequiredSupply: marker.GetSupply().Amount,
+		TotalSupply:    totalSupply,
+		Escrow:         escrow,
+		Circulation:    totalSupply.Sub(escrow),
+	}
+	if adjustment, found := k.GetSupplyAdjustment(ctx, marker.GetAddress()); found {
+		report.LastAdjustment = &adjustment
+	}
+	return report
+}
+
+// GetSupplyAdjustment returns the last automatic supply adjustment made to the marker with the given address.
+func (k Keeper) GetSupplyAdjustment(ctx sdk.Context, addr sdk.AccAddress) (a types.MarkerSupplyAdjustment, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MarkerSupplyAdjustmentKey(addr))
+	if bz == nil {
+		return a, false
+	}
+	k.cdc.MustUnmarshal(bz, &a)
+	return a, true
+}
+
+// SetSupplyAdjustment stores the last automatic supply adjustment made to a marker, replacing any previous one.
+func (k Keeper) SetSupplyAdjustment(ctx sdk.Context, a types.MarkerSupplyAdjustment) {
+	ctx.KVStore(k.storeKey).Set(types.MarkerSupplyAdjustmentKey(types.MustGetMarkerAddress(a.Denom)), k.cdc.MustMarshal(&a))
+}
+
+// GetAllSupplyAdjustments returns the last automatic supply adjustment made to each marker.
+func (k Keeper) GetAllSupplyAdjustments(ctx sdk.Context) []types.MarkerSupplyAdjustment {
+	var adjustments []types.MarkerSupplyAdjustment
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.MarkerSupplyAdjustmentKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var a types.MarkerSupplyAdjustment
+		k.cdc.MustUnmarshal(iterator.Value(), &a)
+		adjustments = append(adjustments, a)
+	}
+	return adjustments
+}
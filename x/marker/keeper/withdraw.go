@@ -0,0 +1,211 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// ScheduleWithdrawCoins records a withdrawal of coin from the escrow of a marker that is executed in end block once
+// the release time is reached, see ReleaseWithdrawSchedules.  The coin is not set aside when the withdrawal is
+// scheduled, the escrow must hold the amount when it is released.  Returns the id assigned to the scheduled withdrawal.
+func (k Keeper) ScheduleWithdrawCoins(
+	ctx sdk.Context, caller sdk.AccAddress, recipient sdk.AccAddress, denom string, coins sdk.Coins, releaseTime time.Time,
+) (uint64, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "schedule_withdraw_coins")
+
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return 0, fmt.Errorf("marker not found for %s: %s", denom, err)
+	}
+	if !m.AddressHasAccess(caller, types.Access_Withdraw, ctx.BlockTime()) {
+		return 0, fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Withdraw, m.GetDenom())
+	}
+	if m.GetStatus() != types.StatusActive {
+		return 0, fmt.Errorf("cannot schedule a withdrawal from a marker that is not in Active status")
+	}
+	if !releaseTime.After(ctx.BlockTime()) {
+		return 0, fmt.Errorf("release time %s must be after the current block time", releaseTime.UTC().Format(time.RFC3339))
+	}
+	if recipient.Empty() {
+		recipient = caller
+	}
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return 0, fmt.Errorf("%s is not allowed to receive funds", recipient)
+	}
+
+	schedule := types.NewMarkerWithdrawSchedule(
+		k.nextWithdrawScheduleID(ctx), denom, caller, recipient, coins, releaseTime,
+	)
+	k.SetWithdrawSchedule(ctx, schedule)
+
+	scheduledEvent := types.NewEventMarkerWithdrawScheduled(schedule)
+	if err = ctx.EventManager().EmitTypedEvent(scheduledEvent); err != nil {
+		return 0, err
+	}
+	return schedule.Id, nil
+}
+
+// CancelWithdrawSchedule removes a scheduled withdrawal that has not been released.  The caller must be the account
+// that scheduled the withdrawal or hold the withdraw access right on the marker.
+func (k Keeper) CancelWithdrawSchedule(ctx sdk.Context, caller sdk.AccAddress, denom string, id uint64) error {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "cancel_withdraw_schedule")
+
+	m, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return fmt.Errorf("marker not found for %s: %s", denom, err)
+	}
+	schedule, found := k.GetWithdrawSchedule(ctx, m.GetAddress(), id)
+	if !found {
+		return fmt.Errorf("scheduled withdrawal %d not found for %s", id, denom)
+	}
+	if schedule.Administrator != caller.String() && !m.AddressHasAccess(caller, types.Access_Withdraw, ctx.BlockTime()) {
+		return fmt.Errorf("%s does not have %s on %s markeraccount", caller, types.Access_Withdraw, m.GetDenom())
+	}
+	k.removeWithdrawSchedule(ctx, schedule)
+
+	cancelledEvent := types.NewEventMarkerWithdrawScheduleCancelled(id, denom, caller.String())
+	return ctx.EventManager().EmitTypedEvent(cancelledEvent)
+}
+
+// ReleaseWithdrawSchedules executes at most limit of the scheduled withdrawals whose release time has been reached,
+// oldest first.  Each withdrawal is removed once attempted, a withdrawal that can not be completed (the administrator
+// no longer holds the withdraw access right, the escrow is insufficient, etc) moves no coin and emits a failure event.
+func (k Keeper) ReleaseWithdrawSchedules(ctx sdk.Context, limit int) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "release_withdraw_schedules")
+
+	// collect the keys that are due before making changes as releasing a withdrawal removes it from the queue.
+	store := ctx.KVStore(k.storeKey)
+	end := sdk.PrefixEndBytes(types.MarkerWithdrawReleaseKeyPrefixForTime(ctx.BlockTime()))
+	iterator := store.Iterator(types.MarkerWithdrawReleaseKeyPrefix, end)
+	var due [][]byte
+	for ; iterator.Valid() && len(due) < limit; iterator.Next() {
+		due = append(due, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range due {
+		markerAddr, id := types.SplitMarkerWithdrawReleaseKey(key)
+		schedule, found := k.GetWithdrawSchedule(ctx, markerAddr, id)
+		if !found {
+			// the release entry no longer references a scheduled withdrawal so there is nothing to do.
+			store.Delete(key)
+			continue
+		}
+		k.removeWithdrawSchedule(ctx, schedule)
+		k.releaseWithdrawSchedule(ctx, schedule)
+	}
+}
+
+// releaseWithdrawSchedule attempts the withdrawal, changes are only kept if the entire withdrawal succeeds.
+func (k Keeper) releaseWithdrawSchedule(ctx sdk.Context, schedule types.MarkerWithdrawSchedule) {
+	admin, err := sdk.AccAddressFromBech32(schedule.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	recipient, err := sdk.AccAddressFromBech32(schedule.ToAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	err = k.WithdrawCoins(cacheCtx, admin, recipient, schedule.Denom, schedule.Amount)
+	if err != nil {
+		ctx.Logger().Error("unable to release scheduled withdrawal", "denom", schedule.Denom, "id", schedule.Id, "err", err)
+		failedEvent := types.NewEventMarkerWithdrawScheduleFailed(schedule.Id, schedule.Denom, err.Error())
+		if err = ctx.EventManager().EmitTypedEvent(failedEvent); err != nil {
+			ctx.Logger().Error("unable to emit scheduled withdrawal failed event", "denom", schedule.Denom, "err", err)
+		}
+		return
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	releasedEvent := types.NewEventMarkerWithdrawScheduleReleased(schedule.Id, schedule.Denom)
+	if err = ctx.EventManager().EmitTypedEvent(releasedEvent); err != nil {
+		ctx.Logger().Error("unable to emit scheduled withdrawal released event", "denom", schedule.Denom, "err", err)
+	}
+}
+
+// GetWithdrawSchedule returns the withdrawal with the given id scheduled against the marker with the given address.
+func (k Keeper) GetWithdrawSchedule(
+	ctx sdk.Context, markerAddr sdk.AccAddress, id uint64,
+) (s types.MarkerWithdrawSchedule, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MarkerWithdrawScheduleKey(markerAddr, id))
+	if bz == nil {
+		return s, false
+	}
+	k.cdc.MustUnmarshal(bz, &s)
+	return s, true
+}
+
+// SetWithdrawSchedule stores a scheduled withdrawal and queues it for release at its release time.
+func (k Keeper) SetWithdrawSchedule(ctx sdk.Context, s types.MarkerWithdrawSchedule) {
+	store := ctx.KVStore(k.storeKey)
+	markerAddr := types.MustGetMarkerAddress(s.Denom)
+	store.Set(types.MarkerWithdrawScheduleKey(markerAddr, s.Id), k.cdc.MustMarshal(&s))
+	store.Set(types.MarkerWithdrawReleaseKey(s.ReleaseTime, markerAddr, s.Id), []byte{})
+}
+
+// removeWithdrawSchedule deletes a scheduled withdrawal and its entry in the release queue.
+func (k Keeper) removeWithdrawSchedule(ctx sdk.Context, s types.MarkerWithdrawSchedule) {
+	store := ctx.KVStore(k.storeKey)
+	markerAddr := types.MustGetMarkerAddress(s.Denom)
+	store.Delete(types.MarkerWithdrawScheduleKey(markerAddr, s.Id))
+	store.Delete(types.MarkerWithdrawReleaseKey(s.ReleaseTime, markerAddr, s.Id))
+}
+
+// GetWithdrawSchedules returns the withdrawals scheduled against a marker, ordered by id.
+func (k Keeper) GetWithdrawSchedules(ctx sdk.Context, markerAddr sdk.AccAddress) []types.MarkerWithdrawSchedule {
+	return k.getWithdrawSchedules(ctx, types.MarkerWithdrawScheduleKeyPrefixForAddress(markerAddr))
+}
+
+// GetAllWithdrawSchedules returns the withdrawals scheduled against all markers.
+func (k Keeper) GetAllWithdrawSchedules(ctx sdk.Context) []types.MarkerWithdrawSchedule {
+	return k.getWithdrawSchedules(ctx, types.MarkerWithdrawScheduleKeyPrefix)
+}
+
+func (k Keeper) getWithdrawSchedules(ctx sdk.Context, prefix []byte) []types.MarkerWithdrawSchedule {
+	var schedules []types.MarkerWithdrawSchedule
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var s types.MarkerWithdrawSchedule
+		k.cdc.MustUnmarshal(iterator.Value(), &s)
+		schedules = append(schedules, s)
+	}
+	return schedules
+}
+
+// clearWithdrawSchedules removes all of the withdrawals scheduled against a marker.
+func (k Keeper) clearWithdrawSchedules(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	for _, s := range k.GetWithdrawSchedules(ctx, markerAddr) {
+		k.removeWithdrawSchedule(ctx, s)
+	}
+}
+
+// GetLastWithdrawScheduleID returns the last id assigned to a scheduled withdrawal, zero if none have been assigned.
+func (k Keeper) GetLastWithdrawScheduleID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.MarkerWithdrawScheduleSequenceKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastWithdrawScheduleID stores the last id assigned to a scheduled withdrawal.
+func (k Keeper) SetLastWithdrawScheduleID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.MarkerWithdrawScheduleSequenceKey, sdk.Uint64ToBigEndian(id))
+}
+
+// nextWithdrawScheduleID assigns the next id for a scheduled withdrawal.
+func (k Keeper) nextWithdrawScheduleID(ctx sdk.Context) uint64 {
+	id := k.GetLastWithdrawScheduleID(ctx) + 1
+	k.SetLastWithdrawScheduleID(ctx, id)
+	return id
+}
@@ -33,7 +33,7 @@ func MigrateMarkerPermissions(ctx sdk.Context, k MarkerKeeperI) error {
 	var err error
 	k.IterateMarkers(ctx, func(marker types.MarkerAccountI) (stop bool) {
 		if marker.GetMarkerType() == types.MarkerType_Coin {
-			invalid := marker.AddressListForPermission(types.Access_Transfer)
+			invalid := marker.AddressListForPermission(types.Access_Transfer, ctx.BlockTime())
 			// invalid permission grants exist, remediation required
 			if len(invalid) > 0 {
 				m, ok := marker.(*types.MarkerAccount)
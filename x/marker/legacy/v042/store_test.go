@@ -104,9 +104,9 @@ func (s *MigrateTestSuite) TestMigrateMarkerPermissions() {
 		m := &marker
 		switch marker.MarkerType {
 		case types.MarkerType_Coin:
-			s.Assert().Len(m.AddressListForPermission(types.Access_Transfer), 0, "expect no addresses with transfer permission on coins")
+			s.Assert().Len(m.AddressListForPermission(types.Access_Transfer, s.ctx.BlockTime()), 0, "expect no addresses with transfer permission on coins")
 		case types.MarkerType_RestrictedCoin:
-			s.Assert().Len(m.AddressListForPermission(types.Access_Transfer), 1, "expect one address with transfer permission on coins")
+			s.Assert().Len(m.AddressListForPermission(types.Access_Transfer, s.ctx.BlockTime()), 1, "expect one address with transfer permission on coins")
 		default:
 			s.Require().Fail("unknown type")
 		}
@@ -19,6 +19,7 @@ const (
 	MaxTotalSupply         = "max_total_supply"
 	EnableGovernance       = "enable_governance"
 	UnrestrictedDenomRegex = "unresticted_denom_regex"
+	EnableForceTransfer    = "enable_force_transfer"
 )
 
 // GenMaxTotalSupply randomized Maximum amount of supply to allow for markers
@@ -31,6 +32,11 @@ func GenEnableGovernance(r *rand.Rand) bool {
 	return r.Int63n(101) <= 50 // 50% chance of unrestricted names being enabled
 }
 
+// GenEnableForceTransfer returns a randomized EnableForceTransfer parameter.
+func GenEnableForceTransfer(r *rand.Rand) bool {
+	return r.Int63n(101) <= 50 // 50% chance of forced transfers being enabled
+}
+
 // GenUnrestrictedDenomRegex returns a randomized length focused string for the unrestricted denom validation expression
 func GenUnrestrictedDenomRegex(r *rand.Rand) string {
 	min := r.Int31n(16) + 3
@@ -58,11 +64,18 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { unrestrictedDenomRegex = GenUnrestrictedDenomRegex(r) },
 	)
 
+	var enableForceTransfer bool
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, EnableForceTransfer, &enableForceTransfer, simState.Rand,
+		func(r *rand.Rand) { enableForceTransfer = GenEnableForceTransfer(r) },
+	)
+
 	markerGenesis := types.GenesisState{
 		Params: types.Params{
 			MaxTotalSupply:         maxTotalSupply,
 			EnableGovernance:       enableGovernance,
 			UnrestrictedDenomRegex: unrestrictedDenomRegex,
+			EnableForceTransfer:    enableForceTransfer,
 		},
 		Markers: []types.MarkerAccount{
 			{
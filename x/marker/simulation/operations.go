@@ -139,14 +139,14 @@ func SimulateMsgChangeStatus(k keeper.Keeper, ak authkeeper.AccountKeeperI, bk b
 				return simtypes.NoOpMsg(types.ModuleName, fmt.Sprintf("%T", msg), "manager account does not exist"), nil, nil
 			}
 		case types.StatusActive:
-			accounts := m.AddressListForPermission(types.Access_Delete)
+			accounts := m.AddressListForPermission(types.Access_Delete, ctx.BlockTime())
 			if len(accounts) < 1 {
 				return simtypes.NoOpMsg(types.ModuleName, types.TypeCancelRequest, "no account has cancel access"), nil, nil
 			}
 			simAccount, _ = simtypes.FindAccount(accs, accounts[0])
 			msg = types.NewMsgCancelRequest(m.GetDenom(), simAccount.Address)
 		case types.StatusCancelled:
-			accounts := m.AddressListForPermission(types.Access_Delete)
+			accounts := m.AddressListForPermission(types.Access_Delete, ctx.BlockTime())
 			if len(accounts) < 1 {
 				return simtypes.NoOpMsg(types.ModuleName, types.TypeDeleteRequest, "no account has delete access"), nil, nil
 			}
@@ -17,6 +17,7 @@ const (
 	keyMaxTotalSupply         = "MaxTotalSupply"
 	keyEnableGovernance       = "EnableGovernance"
 	keyUnrestrictedDenomRegex = "UnrestrictedDenomRegex"
+	keyEnableForceTransfer    = "EnableForceTransfer"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
@@ -39,5 +40,10 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("\"%s\"", GenUnrestrictedDenomRegex(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, keyEnableForceTransfer,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("%v", GenEnableForceTransfer(r))
+			},
+		),
 	}
 }
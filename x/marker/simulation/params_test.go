@@ -34,11 +34,16 @@ func TestParamChanges(t *testing.T) {
 			key:         "UnrestrictedDenomRegex",
 			subspace:    markertypes.ModuleName,
 		},
+		{
+			composedKey: "marker/EnableForceTransfer",
+			key:         "EnableForceTransfer",
+			subspace:    markertypes.ModuleName,
+		},
 	}
 
 	paramChanges := simulation.ParamChanges(r)
 
-	require.Len(t, paramChanges, 3)
+	require.Len(t, paramChanges, 4)
 
 	for i, p := range paramChanges {
 		require.Equal(t, expected[i].composedKey, p.ComposedKey())
@@ -7,16 +7,20 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "github.com/regen-network/cosmos-proto"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -45,30 +49,45 @@ const (
 	// ACCESS_ADMIN is the ability to add access grants for accounts to the list of marker permissions.
 	Access_Admin Access = 6
 	// ACCESS_TRANSFER is the ability to invoke a send operation using the marker module to facilitate exchange.
-	// This capability is useful when the marker denomination has "send enabled = false" preventing normal bank transfer
+	// This access right is only supported on RESTRICTED markers.
 	Access_Transfer Access = 7
+	// ACCESS_FREEZE is the ability to freeze and unfreeze accounts holding the marker's coin, preventing transfers out
+	// of a frozen account.  This access right is only supported on RESTRICTED markers.
+	Access_Freeze Access = 8
+	// ACCESS_FORCE_TRANSFER is the ability to transfer the marker's coin out of any non-module account without the
+	// approval of the holder.  This access right is only supported on RESTRICTED markers.
+	Access_ForceTransfer Access = 9
+	// ACCESS_BURN_FROM is the ability to burn the marker's coin directly from the balance of any non-module account
+	// holding it, reducing the supply of the marker.  This access right is only supported on RESTRICTED markers.
+	Access_BurnFrom Access = 10
 )
 
 var Access_name = map[int32]string{
-	0: "ACCESS_UNSPECIFIED",
-	1: "ACCESS_MINT",
-	2: "ACCESS_BURN",
-	3: "ACCESS_DEPOSIT",
-	4: "ACCESS_WITHDRAW",
-	5: "ACCESS_DELETE",
-	6: "ACCESS_ADMIN",
-	7: "ACCESS_TRANSFER",
+	0:  "ACCESS_UNSPECIFIED",
+	1:  "ACCESS_MINT",
+	2:  "ACCESS_BURN",
+	3:  "ACCESS_DEPOSIT",
+	4:  "ACCESS_WITHDRAW",
+	5:  "ACCESS_DELETE",
+	6:  "ACCESS_ADMIN",
+	7:  "ACCESS_TRANSFER",
+	8:  "ACCESS_FREEZE",
+	9:  "ACCESS_FORCE_TRANSFER",
+	10: "ACCESS_BURN_FROM",
 }
 
 var Access_value = map[string]int32{
-	"ACCESS_UNSPECIFIED": 0,
-	"ACCESS_MINT":        1,
-	"ACCESS_BURN":        2,
-	"ACCESS_DEPOSIT":     3,
-	"ACCESS_WITHDRAW":    4,
-	"ACCESS_DELETE":      5,
-	"ACCESS_ADMIN":       6,
-	"ACCESS_TRANSFER":    7,
+	"ACCESS_UNSPECIFIED":    0,
+	"ACCESS_MINT":           1,
+	"ACCESS_BURN":           2,
+	"ACCESS_DEPOSIT":        3,
+	"ACCESS_WITHDRAW":       4,
+	"ACCESS_DELETE":         5,
+	"ACCESS_ADMIN":          6,
+	"ACCESS_TRANSFER":       7,
+	"ACCESS_FREEZE":         8,
+	"ACCESS_FORCE_TRANSFER": 9,
+	"ACCESS_BURN_FROM":      10,
 }
 
 func (x Access) String() string {
@@ -83,6 +102,8 @@ func (Access) EnumDescriptor() ([]byte, []int) {
 type AccessGrant struct {
 	Address     string     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Permissions AccessList `protobuf:"varint,2,rep,packed,name=permissions,proto3,enum=provenance.marker.v1.Access,castrepeated=AccessList" json:"permissions,omitempty"`
+	// optional time after which the grant no longer provides access, the grant is removed in the next begin block.
+	Expiration *time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
 }
 
 func (m *AccessGrant) Reset()      { *m = AccessGrant{} }
@@ -127,37 +148,44 @@ func init() {
 }
 
 var fileDescriptor_7242c30a84644575 = []byte{
-	// 475 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0xed, 0xb4, 0x4d, 0xda, 0x4b, 0x08, 0xd6, 0xa9, 0x12, 0xa9, 0x29, 0x8e, 0x01, 0x09,
-	0x55, 0x48, 0xb5, 0xd5, 0xb2, 0xb1, 0x39, 0xb1, 0x0b, 0x96, 0x1a, 0x13, 0xd9, 0x8e, 0x22, 0xb1,
-	0x54, 0xae, 0x73, 0xa4, 0xa7, 0xe2, 0x3b, 0xeb, 0xce, 0x4d, 0xe9, 0x17, 0x40, 0xc8, 0x13, 0x0b,
-	0x12, 0x8b, 0xa5, 0xcc, 0xcc, 0x7c, 0x08, 0xc6, 0x8a, 0x89, 0x0d, 0x94, 0x2c, 0x7c, 0x0c, 0x94,
-	0x5c, 0x4a, 0x3d, 0x74, 0x7b, 0xcf, 0xff, 0x9f, 0x7f, 0x7a, 0x7a, 0xf7, 0xc0, 0xb3, 0x94, 0xd1,
-	0x09, 0x22, 0x11, 0x89, 0x91, 0x99, 0x44, 0xec, 0x1c, 0x31, 0x73, 0x72, 0x60, 0x46, 0x71, 0x8c,
-	0x38, 0x1f, 0xb3, 0x88, 0x64, 0x46, 0xca, 0x68, 0x46, 0xe1, 0xf6, 0x2d, 0x67, 0x08, 0xce, 0x98,
-	0x1c, 0xa8, 0xdb, 0x63, 0x3a, 0xa6, 0x4b, 0xc0, 0x5c, 0x54, 0x82, 0x55, 0x77, 0x62, 0xca, 0x13,
-	0xca, 0x4f, 0x44, 0x20, 0x1a, 0x11, 0x3d, 0xf9, 0x22, 0x83, 0xba, 0xb5, 0x94, 0xbf, 0x5a, 0xc8,
-	0x61, 0x0b, 0xd4, 0xa2, 0xd1, 0x88, 0x21, 0xce, 0x5b, 0xb2, 0x2e, 0xef, 0x6d, 0xf9, 0x37, 0x2d,
-	0xf4, 0x40, 0x3d, 0x45, 0x2c, 0xc1, 0x9c, 0x63, 0x4a, 0x78, 0xab, 0xa2, 0xaf, 0xed, 0x35, 0x0f,
-	0x77, 0x8d, 0xbb, 0xc6, 0x30, 0x84, 0xb1, 0xd3, 0xfc, 0xf6, 0xbb, 0x0d, 0x44, 0x7d, 0x8c, 0x79,
-	0xe6, 0x97, 0x05, 0x2f, 0x77, 0x3f, 0x4d, 0xdb, 0xd2, 0xd7, 0x69, 0x5b, 0xfa, 0x3b, 0x6d, 0xcb,
-	0x3f, 0xbf, 0xef, 0x37, 0x4a, 0x63, 0xb8, 0xcf, 0x3f, 0x56, 0x40, 0x55, 0x7c, 0x80, 0x4f, 0x01,
-	0xb4, 0xba, 0x5d, 0x27, 0x08, 0x4e, 0x06, 0x5e, 0xd0, 0x77, 0xba, 0xee, 0x91, 0xeb, 0xd8, 0x8a,
-	0xa4, 0xd6, 0xf3, 0x42, 0xaf, 0x0d, 0xc8, 0x39, 0xa1, 0x97, 0x04, 0xee, 0x80, 0xfa, 0x0a, 0xea,
-	0xb9, 0x5e, 0xa8, 0xc8, 0xea, 0x66, 0x5e, 0xe8, 0xeb, 0x3d, 0x4c, 0xb2, 0x52, 0xd4, 0x19, 0xf8,
-	0x9e, 0x52, 0x11, 0x51, 0xe7, 0x82, 0x11, 0xd8, 0x06, 0xcd, 0x55, 0x64, 0x3b, 0xfd, 0x37, 0x81,
-	0x1b, 0x2a, 0x6b, 0x42, 0x6b, 0xa3, 0x94, 0x72, 0x9c, 0xc1, 0xc7, 0xe0, 0xfe, 0x0a, 0x18, 0xba,
-	0xe1, 0x6b, 0xdb, 0xb7, 0x86, 0xca, 0xba, 0xda, 0xc8, 0x0b, 0x7d, 0x73, 0x88, 0xb3, 0xb3, 0x11,
-	0x8b, 0x2e, 0xe1, 0x23, 0x70, 0xef, 0xbf, 0xe3, 0xd8, 0x09, 0x1d, 0x65, 0x43, 0x05, 0x79, 0xa1,
-	0x57, 0x6d, 0xf4, 0x1e, 0x65, 0x08, 0x3e, 0x04, 0x8d, 0x55, 0x6c, 0xd9, 0x3d, 0xd7, 0x53, 0xaa,
-	0xea, 0x56, 0x5e, 0xe8, 0x1b, 0xd6, 0x28, 0xc1, 0xa4, 0xa4, 0x0f, 0x7d, 0xcb, 0x0b, 0x8e, 0x1c,
-	0x5f, 0xa9, 0x09, 0x7d, 0xc8, 0x22, 0xc2, 0xdf, 0x21, 0xd6, 0xb9, 0xfa, 0x31, 0xd3, 0xe4, 0xeb,
-	0x99, 0x26, 0xff, 0x99, 0x69, 0xf2, 0xe7, 0xb9, 0x26, 0x5d, 0xcf, 0x35, 0xe9, 0xd7, 0x5c, 0x93,
-	0xc0, 0x03, 0x4c, 0xef, 0xdc, 0x7e, 0x47, 0x29, 0x6d, 0xb2, 0xbf, 0x78, 0xe5, 0xbe, 0xfc, 0xf6,
-	0x70, 0x8c, 0xb3, 0xb3, 0x8b, 0x53, 0x23, 0xa6, 0x89, 0x79, 0xfb, 0xd3, 0x3e, 0xa6, 0xa5, 0xce,
-	0xfc, 0x70, 0x73, 0x71, 0xd9, 0x55, 0x8a, 0xf8, 0x69, 0x75, 0x79, 0x22, 0x2f, 0xfe, 0x05, 0x00,
-	0x00, 0xff, 0xff, 0x22, 0x3b, 0x74, 0x4e, 0x93, 0x02, 0x00, 0x00,
+	// 588 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0x4f, 0x4f, 0xdb, 0x30,
+	0x18, 0xc6, 0x1b, 0xfe, 0x94, 0xe2, 0x02, 0xf3, 0x2c, 0xa6, 0x95, 0x8c, 0x35, 0x19, 0x93, 0x26,
+	0x34, 0x8d, 0x44, 0xb0, 0xdb, 0x6e, 0x6d, 0x93, 0x6c, 0x91, 0x68, 0xa9, 0xd2, 0x20, 0x24, 0x2e,
+	0x28, 0xb4, 0xa6, 0x58, 0x10, 0x3b, 0xb2, 0xcd, 0xbf, 0x7d, 0x82, 0xa9, 0x27, 0x8e, 0xbb, 0x44,
+	0xe2, 0xbc, 0xf3, 0x3e, 0x04, 0x47, 0xb4, 0xd3, 0xa4, 0x49, 0x63, 0x82, 0xcb, 0xbe, 0xc1, 0xae,
+	0x53, 0xeb, 0x96, 0xe6, 0xc0, 0xcd, 0x6f, 0x9e, 0xc7, 0xbf, 0xf7, 0x89, 0xfd, 0x1a, 0xbc, 0x49,
+	0x38, 0x3b, 0xc5, 0x34, 0xa2, 0x6d, 0x6c, 0xc7, 0x11, 0x3f, 0xc2, 0xdc, 0x3e, 0x5d, 0xb7, 0xa3,
+	0x76, 0x1b, 0x0b, 0xd1, 0xe5, 0x11, 0x95, 0x56, 0xc2, 0x99, 0x64, 0x68, 0x71, 0xec, 0xb3, 0x94,
+	0xcf, 0x3a, 0x5d, 0xd7, 0x17, 0xbb, 0xac, 0xcb, 0x06, 0x06, 0xbb, 0xbf, 0x52, 0x5e, 0x7d, 0xa9,
+	0xcd, 0x44, 0xcc, 0xc4, 0x9e, 0x12, 0x54, 0x31, 0x94, 0x8c, 0x2e, 0x63, 0xdd, 0x63, 0x6c, 0x0f,
+	0xaa, 0xfd, 0x93, 0x03, 0x5b, 0x92, 0x18, 0x0b, 0x19, 0xc5, 0x89, 0x32, 0xac, 0xfc, 0xd2, 0x40,
+	0xb1, 0x32, 0xe8, 0xfe, 0xb1, 0xdf, 0x1d, 0x95, 0xc0, 0x4c, 0xd4, 0xe9, 0x70, 0x2c, 0x44, 0x49,
+	0x33, 0xb5, 0xd5, 0xd9, 0x60, 0x54, 0xa2, 0x06, 0x28, 0x26, 0x98, 0xc7, 0x44, 0x08, 0xc2, 0xa8,
+	0x28, 0x4d, 0x98, 0x93, 0xab, 0x0b, 0x1b, 0xcb, 0xd6, 0x63, 0x39, 0x2d, 0x45, 0xac, 0x2e, 0x7c,
+	0xbb, 0x35, 0x80, 0x5a, 0x6f, 0x12, 0x21, 0x83, 0x2c, 0x00, 0x39, 0x00, 0xe0, 0xf3, 0x84, 0xf0,
+	0x48, 0x12, 0x46, 0x4b, 0x93, 0xa6, 0xb6, 0x5a, 0xdc, 0xd0, 0x2d, 0x95, 0xd7, 0x1a, 0xe5, 0xb5,
+	0xc2, 0x51, 0xde, 0x6a, 0xe1, 0xfa, 0xb7, 0xa1, 0x5d, 0xde, 0x1a, 0x5a, 0x90, 0xd9, 0xf7, 0x61,
+	0xf9, 0xcb, 0x95, 0x91, 0xfb, 0x7a, 0x65, 0xe4, 0xfe, 0x5e, 0x19, 0xda, 0x8f, 0xef, 0x6b, 0x73,
+	0x99, 0x9f, 0xf1, 0xdf, 0xfe, 0x9b, 0x00, 0x79, 0xf5, 0x01, 0xbd, 0x06, 0xa8, 0x52, 0xab, 0xb9,
+	0xad, 0xd6, 0xde, 0x76, 0xa3, 0xd5, 0x74, 0x6b, 0xbe, 0xe7, 0xbb, 0x0e, 0xcc, 0xe9, 0xc5, 0x5e,
+	0x6a, 0xce, 0x6c, 0xd3, 0x23, 0xca, 0xce, 0x28, 0x5a, 0x02, 0xc5, 0xa1, 0xa9, 0xee, 0x37, 0x42,
+	0xa8, 0xe9, 0x85, 0x5e, 0x6a, 0x4e, 0xd5, 0x09, 0x95, 0x19, 0xa9, 0xba, 0x1d, 0x34, 0xe0, 0x84,
+	0x92, 0xaa, 0x27, 0x9c, 0x22, 0x03, 0x2c, 0x0c, 0x25, 0xc7, 0x6d, 0x6e, 0xb5, 0xfc, 0x10, 0x4e,
+	0x2a, 0xac, 0x83, 0x13, 0x26, 0x88, 0x44, 0xaf, 0xc0, 0x93, 0xa1, 0x61, 0xc7, 0x0f, 0x3f, 0x39,
+	0x41, 0x65, 0x07, 0x4e, 0xe9, 0x73, 0xbd, 0xd4, 0x2c, 0xec, 0x10, 0x79, 0xd8, 0xe1, 0xd1, 0x19,
+	0x7a, 0x09, 0xe6, 0x1f, 0x18, 0x9b, 0x6e, 0xe8, 0xc2, 0x69, 0x1d, 0xf4, 0x52, 0x33, 0xef, 0xe0,
+	0x63, 0x2c, 0x31, 0x7a, 0x01, 0xe6, 0x86, 0x72, 0xc5, 0xa9, 0xfb, 0x0d, 0x98, 0xd7, 0x67, 0x7b,
+	0xa9, 0x39, 0x5d, 0xe9, 0xc4, 0x84, 0x66, 0xf0, 0x61, 0x50, 0x69, 0xb4, 0x3c, 0x37, 0x80, 0x33,
+	0x0a, 0x1f, 0xf2, 0x88, 0x8a, 0x03, 0xcc, 0x33, 0x78, 0x2f, 0x70, 0xdd, 0x5d, 0x17, 0x16, 0x14,
+	0xde, 0xe3, 0x18, 0x7f, 0xc6, 0xe8, 0x1d, 0x78, 0x36, 0x92, 0xb7, 0x82, 0x9a, 0x3b, 0xe6, 0xcc,
+	0xea, 0x4f, 0x7b, 0xa9, 0x39, 0xef, 0x31, 0xde, 0xc6, 0x0f, 0xb0, 0x15, 0x00, 0x33, 0x47, 0xb1,
+	0xe7, 0x05, 0x5b, 0x75, 0x08, 0x54, 0xc3, 0xfe, 0x79, 0x78, 0x9c, 0xc5, 0xd5, 0x8b, 0xeb, 0xbb,
+	0xb2, 0x76, 0x73, 0x57, 0xd6, 0xfe, 0xdc, 0x95, 0xb5, 0xcb, 0xfb, 0x72, 0xee, 0xe6, 0xbe, 0x9c,
+	0xfb, 0x79, 0x5f, 0xce, 0x81, 0xe7, 0x84, 0x3d, 0x3a, 0x34, 0x55, 0x98, 0xb9, 0xba, 0x66, 0xff,
+	0xfe, 0x9b, 0xda, 0xee, 0x46, 0x97, 0xc8, 0xc3, 0x93, 0x7d, 0xab, 0xcd, 0x62, 0x7b, 0xbc, 0x69,
+	0x8d, 0xb0, 0x4c, 0x65, 0x9f, 0x8f, 0x5e, 0x92, 0xbc, 0x48, 0xb0, 0xd8, 0xcf, 0x0f, 0x86, 0xe7,
+	0xfd, 0xff, 0x01, 0x00, 0xc7, 0xa5, 0x89, 0xdb, 0x6b, 0x03, 0x00, 0x00,
 }
 
 func (this *AccessGrant) Equal(that interface{}) bool {
@@ -190,6 +218,13 @@ func (this *AccessGrant) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if that1.Expiration == nil {
+		if this.Expiration != nil {
+			return false
+		}
+	} else if !this.Expiration.Equal(*that1.Expiration) {
+		return false
+	}
 	return true
 }
 func (m *AccessGrant) Marshal() (dAtA []byte, err error) {
@@ -212,21 +247,31 @@ func (m *AccessGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expiration != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintAccessgrant(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Permissions) > 0 {
-		dAtA2 := make([]byte, len(m.Permissions)*10)
-		var j1 int
+		dAtA3 := make([]byte, len(m.Permissions)*10)
+		var j2 int
 		for _, num := range m.Permissions {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j2++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA3[j2] = uint8(num)
+			j2++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintAccessgrant(dAtA, i, uint64(j1))
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintAccessgrant(dAtA, i, uint64(j2))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -268,6 +313,10 @@ func (m *AccessGrant) Size() (n int) {
 		}
 		n += 1 + sovAccessgrant(uint64(l)) + l
 	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAccessgrant(uint64(l))
+	}
 	return n
 }
 
@@ -407,6 +456,42 @@ func (m *AccessGrant) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccessgrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccessgrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAccessgrant(dAtA[iNdEx:])
@@ -10,10 +10,16 @@ var (
 	_ authz.Authorization = &MarkerTransferAuthorization{}
 )
 
-// NewMarkerTransferAuthorization creates a new MarkerTransferAuthorization object.
-func NewMarkerTransferAuthorization(transferLimit sdk.Coins) *MarkerTransferAuthorization {
+// NewMarkerTransferAuthorization creates a new MarkerTransferAuthorization object.  An empty allowed list of
+// addresses allows transfers to any address.
+func NewMarkerTransferAuthorization(transferLimit sdk.Coins, allowed []sdk.AccAddress) *MarkerTransferAuthorization {
+	allowList := make([]string, len(allowed))
+	for i, addr := range allowed {
+		allowList[i] = addr.String()
+	}
 	return &MarkerTransferAuthorization{
 		TransferLimit: transferLimit,
+		AllowList:     allowList,
 	}
 }
 
@@ -26,6 +32,9 @@ func (a MarkerTransferAuthorization) MsgTypeURL() string {
 func (a MarkerTransferAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
 	switch msg := msg.(type) {
 	case *MsgTransferRequest:
+		if !a.IsAllowed(msg.ToAddress) {
+			return authz.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "cannot transfer to %s address", msg.ToAddress)
+		}
 		limitLeft, isNegative := a.DecreaseTransferLimit(msg.Amount)
 		if isNegative {
 			return authz.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFunds, "requested amount is more than spend limit")
@@ -34,10 +43,10 @@ func (a MarkerTransferAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz
 			if limitLeft.IsZero() {
 				shouldDelete = true
 			}
-			return authz.AcceptResponse{Accept: true, Delete: shouldDelete, Updated: &MarkerTransferAuthorization{TransferLimit: limitLeft}}, nil
+			return authz.AcceptResponse{Accept: true, Delete: shouldDelete, Updated: &MarkerTransferAuthorization{TransferLimit: limitLeft, AllowList: a.AllowList}}, nil
 		}
 		// does not return and an updated transfer limit, this is handled in marker module
-		return authz.AcceptResponse{Accept: true, Delete: false, Updated: &MarkerTransferAuthorization{TransferLimit: a.TransferLimit}}, nil
+		return authz.AcceptResponse{Accept: true, Delete: false, Updated: &MarkerTransferAuthorization{TransferLimit: a.TransferLimit, AllowList: a.AllowList}}, nil
 	default:
 		return authz.AcceptResponse{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidType, "type mismatch")
 	}
@@ -51,9 +60,32 @@ func (a MarkerTransferAuthorization) ValidateBasic() error {
 	if !a.TransferLimit.IsAllPositive() {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "spend limit cannot be negitive")
 	}
+	found := make(map[string]bool)
+	for _, addr := range a.AllowList {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid allow list address %s: %s", addr, err)
+		}
+		if found[addr] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "duplicate allow list address %s", addr)
+		}
+		found[addr] = true
+	}
 	return nil
 }
 
+// IsAllowed returns true if the grantee may transfer the granter's coin to the given address
+func (a MarkerTransferAuthorization) IsAllowed(toAddress string) bool {
+	if len(a.AllowList) == 0 {
+		return true
+	}
+	for _, addr := range a.AllowList {
+		if addr == toAddress {
+			return true
+		}
+	}
+	return false
+}
+
 // DecreaseTransferLimit will return the decreased transfer limit and if it is negative
 func (a MarkerTransferAuthorization) DecreaseTransferLimit(amount sdk.Coin) (sdk.Coins, bool) {
 	return a.TransferLimit.SafeSub(sdk.NewCoins(amount))
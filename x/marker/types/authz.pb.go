@@ -31,6 +31,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type MarkerTransferAuthorization struct {
 	// transfer_limit is the total amount the grantee can transfer
 	TransferLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=transfer_limit,json=transferLimit,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"transfer_limit"`
+	// allow_list specifies an optional list of addresses to whom the grantee can transfer the granter's coin, if empty
+	// the grantee can transfer to any address.
+	AllowList []string `protobuf:"bytes,2,rep,name=allow_list,json=allowList" json:"allow_list,omitempty"`
 }
 
 func (m *MarkerTransferAuthorization) Reset()         { *m = MarkerTransferAuthorization{} }
@@ -73,6 +76,13 @@ func (m *MarkerTransferAuthorization) GetTransferLimit() github_com_cosmos_cosmo
 	return nil
 }
 
+func (m *MarkerTransferAuthorization) GetAllowList() []string {
+	if m != nil {
+		return m.AllowList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MarkerTransferAuthorization)(nil), "provenance.marker.v1.MarkerTransferAuthorization")
 }
@@ -80,26 +90,27 @@ func init() {
 func init() { proto.RegisterFile("provenance/marker/v1/authz.proto", fileDescriptor_e86b03f937f368fb) }
 
 var fileDescriptor_e86b03f937f368fb = []byte{
-	// 289 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x50, 0xb1, 0x4e, 0xc3, 0x30,
-	0x14, 0x8c, 0xc5, 0x16, 0x54, 0x24, 0xaa, 0x4a, 0xd0, 0x22, 0xb9, 0x15, 0x53, 0x97, 0xd8, 0xa4,
-	0x23, 0x1b, 0x65, 0x05, 0x09, 0x21, 0x26, 0x96, 0xca, 0x09, 0x26, 0xb1, 0x4a, 0xfc, 0x22, 0xdb,
-	0x89, 0xa0, 0x5f, 0xc1, 0x47, 0x30, 0x31, 0xf3, 0x11, 0x1d, 0x2b, 0x26, 0x26, 0x40, 0xc9, 0x8f,
-	0xa0, 0xc4, 0xae, 0x5a, 0xd4, 0xc9, 0x3e, 0xdd, 0xbd, 0xbb, 0xd3, 0xf9, 0xa3, 0x5c, 0x41, 0xc9,
-	0x25, 0x93, 0x31, 0xa7, 0x19, 0x53, 0x73, 0xae, 0x68, 0x19, 0x52, 0x56, 0x98, 0x74, 0x41, 0x72,
-	0x05, 0x06, 0xba, 0xbd, 0x8d, 0x82, 0x58, 0x05, 0x29, 0xc3, 0x41, 0x2f, 0x81, 0x04, 0x5a, 0x01,
-	0x6d, 0x7e, 0x56, 0x3b, 0xe8, 0xc7, 0xa0, 0x33, 0xd0, 0x33, 0x4b, 0x58, 0xe0, 0x28, 0x6c, 0x11,
-	0x8d, 0x98, 0xe6, 0xb4, 0x0c, 0x23, 0x6e, 0x58, 0x48, 0x63, 0x10, 0xd2, 0xf2, 0xa7, 0x6f, 0xc8,
-	0x3f, 0xb9, 0x6e, 0xed, 0xef, 0x14, 0x93, 0xfa, 0x91, 0xab, 0x8b, 0xc2, 0xa4, 0xa0, 0xc4, 0x82,
-	0x19, 0x01, 0xb2, 0xab, 0xfc, 0x03, 0xe3, 0x88, 0xd9, 0x93, 0xc8, 0x84, 0x39, 0x46, 0xa3, 0xbd,
-	0xf1, 0xfe, 0xa4, 0x4f, 0x5c, 0x4c, 0x63, 0x4c, 0x9c, 0x31, 0xb9, 0x04, 0x21, 0xa7, 0x67, 0xcb,
-	0xef, 0xa1, 0xf7, 0xfe, 0x33, 0x1c, 0x27, 0xc2, 0xa4, 0x45, 0x44, 0x62, 0xc8, 0x5c, 0x27, 0xf7,
-	0x04, 0xfa, 0x61, 0x4e, 0xcd, 0x4b, 0xce, 0x75, 0x7b, 0xa0, 0x6f, 0x3b, 0xeb, 0x88, 0xab, 0x26,
-	0xe1, 0xfc, 0xf0, 0xf3, 0x23, 0xe8, 0xfc, 0xab, 0x31, 0x4d, 0x96, 0x15, 0x46, 0xab, 0x0a, 0xa3,
-	0xdf, 0x0a, 0xa3, 0xd7, 0x1a, 0x7b, 0xab, 0x1a, 0x7b, 0x5f, 0x35, 0xf6, 0xfc, 0x23, 0xd1, 0xae,
-	0xb0, 0x33, 0xd5, 0x0d, 0xba, 0x9f, 0x6c, 0x15, 0xd8, 0x48, 0x02, 0x01, 0x5b, 0x88, 0x3e, 0xaf,
-	0xf7, 0x6f, 0x0b, 0xfd, 0x05, 0x00, 0x00, 0xff, 0xff, 0xd8, 0x99, 0xb2, 0x4d, 0x99, 0x01, 0x00,
-	0x00,
+	// 312 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0x31, 0x4f, 0x32, 0x31,
+	0x1c, 0xc6, 0xaf, 0x2f, 0x13, 0x7d, 0x83, 0x89, 0x84, 0x44, 0xc0, 0x58, 0x88, 0x13, 0x0b, 0xad,
+	0xc7, 0xe8, 0x26, 0xae, 0x98, 0x18, 0xe2, 0xe4, 0x42, 0xca, 0x59, 0x8f, 0x86, 0xbb, 0xfe, 0x49,
+	0x5b, 0x4e, 0xe5, 0x53, 0xf8, 0x39, 0x9c, 0xfd, 0x06, 0x2e, 0x8c, 0xc4, 0xc9, 0x49, 0x0d, 0x7c,
+	0x11, 0x73, 0xd7, 0x12, 0x30, 0x71, 0x6a, 0xff, 0x7d, 0x7e, 0x7d, 0x9e, 0x27, 0x2d, 0x6e, 0xcf,
+	0x34, 0x64, 0x42, 0x71, 0x15, 0x09, 0x96, 0x72, 0x3d, 0x15, 0x9a, 0x65, 0x21, 0xe3, 0x73, 0x3b,
+	0x59, 0xd0, 0x99, 0x06, 0x0b, 0xd5, 0xda, 0x8e, 0xa0, 0x8e, 0xa0, 0x59, 0xd8, 0xac, 0xc5, 0x10,
+	0x43, 0x01, 0xb0, 0x7c, 0xe7, 0xd8, 0x66, 0x23, 0x02, 0x93, 0x82, 0x19, 0x39, 0xc1, 0x0d, 0x5e,
+	0x22, 0x6e, 0x62, 0x63, 0x6e, 0x04, 0xcb, 0xc2, 0xb1, 0xb0, 0x3c, 0x64, 0x11, 0x48, 0xe5, 0xf4,
+	0xd3, 0x37, 0x84, 0x8f, 0xaf, 0x0a, 0xfb, 0x1b, 0xcd, 0x95, 0xb9, 0x17, 0xfa, 0x62, 0x6e, 0x27,
+	0xa0, 0xe5, 0x82, 0x5b, 0x09, 0xaa, 0xaa, 0xf1, 0x81, 0xf5, 0xc2, 0x28, 0x91, 0xa9, 0xb4, 0x75,
+	0xd4, 0x2e, 0x75, 0xfe, 0xf7, 0x1a, 0xd4, 0xc7, 0xe4, 0xc6, 0xd4, 0x1b, 0xd3, 0x4b, 0x90, 0xaa,
+	0x7f, 0xb6, 0xfc, 0x6c, 0x05, 0x2f, 0x5f, 0xad, 0x4e, 0x2c, 0xed, 0x64, 0x3e, 0xa6, 0x11, 0xa4,
+	0xbe, 0x93, 0x5f, 0xba, 0xe6, 0x6e, 0xca, 0xec, 0xd3, 0x4c, 0x98, 0xe2, 0x82, 0x19, 0x56, 0xb6,
+	0x11, 0x83, 0x3c, 0xa1, 0x7a, 0x82, 0x31, 0x4f, 0x12, 0x78, 0x18, 0x25, 0xd2, 0xd8, 0xfa, 0xbf,
+	0x76, 0xa9, 0x53, 0x1e, 0x96, 0x8b, 0x93, 0x81, 0x34, 0xf6, 0xfc, 0xf0, 0xfd, 0xb5, 0x5b, 0xf9,
+	0xd5, 0xb2, 0x1f, 0x2f, 0xd7, 0x04, 0xad, 0xd6, 0x04, 0x7d, 0xaf, 0x09, 0x7a, 0xde, 0x90, 0x60,
+	0xb5, 0x21, 0xc1, 0xc7, 0x86, 0x04, 0xf8, 0x48, 0x02, 0xfd, 0xeb, 0x25, 0xaf, 0xd1, 0x6d, 0x6f,
+	0xaf, 0xdf, 0x0e, 0xe9, 0x4a, 0xd8, 0x9b, 0xd8, 0xe3, 0xf6, 0x7b, 0x8a, 0xbe, 0x3f, 0x03, 0x00,
+	0x02, 0xa2, 0x9a, 0xa9, 0xb8, 0x01, 0x00, 0x00,
 }
 
 func (m *MarkerTransferAuthorization) Marshal() (dAtA []byte, err error) {
@@ -122,6 +133,15 @@ func (m *MarkerTransferAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowList) > 0 {
+		for iNdEx := len(m.AllowList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowList[iNdEx])
+			copy(dAtA[i:], m.AllowList[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowList[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.TransferLimit) > 0 {
 		for iNdEx := len(m.TransferLimit) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -162,6 +182,12 @@ func (m *MarkerTransferAuthorization) Size() (n int) {
 			n += 1 + l + sovAuthz(uint64(l))
 		}
 	}
+	if len(m.AllowList) > 0 {
+		for _, s := range m.AllowList {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -234,6 +260,38 @@ func (m *MarkerTransferAuthorization) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowList = append(m.AllowList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
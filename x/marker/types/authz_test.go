@@ -0,0 +1,31 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarkerTransferAuthorizationAllowList(t *testing.T) {
+	allowed := testAddress()
+	other := testAddress()
+	limit := sdk.NewCoins(sdk.NewInt64Coin("restricted", 10))
+
+	auth := NewMarkerTransferAuthorization(limit, []sdk.AccAddress{allowed})
+	require.NoError(t, auth.ValidateBasic())
+	require.True(t, auth.IsAllowed(allowed.String()))
+	require.False(t, auth.IsAllowed(other.String()))
+	require.True(t, NewMarkerTransferAuthorization(limit, nil).IsAllowed(other.String()), "empty allow list allows any address")
+
+	_, err := auth.Accept(sdk.Context{}, &MsgTransferRequest{Amount: sdk.NewInt64Coin("restricted", 5), ToAddress: other.String()})
+	require.EqualError(t, err, "cannot transfer to "+other.String()+" address: unauthorized")
+
+	res, err := auth.Accept(sdk.Context{}, &MsgTransferRequest{Amount: sdk.NewInt64Coin("restricted", 5), ToAddress: allowed.String()})
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.Equal(t, auth.AllowList, res.Updated.(*MarkerTransferAuthorization).AllowList, "allow list should be kept")
+
+	require.Error(t, NewMarkerTransferAuthorization(limit, []sdk.AccAddress{allowed, allowed}).ValidateBasic())
+	require.Error(t, (&MarkerTransferAuthorization{TransferLimit: limit, AllowList: []string{"invalid"}}).ValidateBasic())
+}
@@ -58,6 +58,17 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgWithdrawRequest{},
 		&MsgTransferRequest{},
 		&MsgSetDenomMetadataRequest{},
+		&MsgFreezeAccountRequest{},
+		&MsgUnfreezeAccountRequest{},
+		&MsgForceTransferRequest{},
+		&MsgDistributeRequest{},
+		&MsgMultiTransferRequest{},
+		&MsgChangeManagerRequest{},
+		&MsgScheduleWithdrawRequest{},
+		&MsgCancelScheduledWithdrawRequest{},
+		&MsgAddFinalizeActivateMarkerRequest{},
+		&MsgBurnFromRequest{},
+		&MsgSetTransferRestrictionContractRequest{},
 	)
 
 	registry.RegisterImplementations(
@@ -70,6 +81,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&ChangeStatusProposal{},
 		&WithdrawEscrowProposal{},
 		&SetDenomMetadataProposal{},
+		&ChangeManagerProposal{},
 	)
 
 	registry.RegisterImplementations(
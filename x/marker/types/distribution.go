@@ -0,0 +1,108 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// MaxDistributionHoldersPerBlock is the maximum number of holders paid their share of a distribution in one end block.
+const MaxDistributionHoldersPerBlock = 500
+
+// NewMarkerDistribution creates a record of a distribution of the given amount to the holders of a marker denom.
+func NewMarkerDistribution(denom string, admin sdk.AccAddress, amount sdk.Coins, totalHeld sdk.Int) MarkerDistribution { // nolint:interfacer
+	return MarkerDistribution{
+		Denom:         denom,
+		Administrator: admin.String(),
+		Amount:        amount,
+		Remaining:     amount,
+		TotalHeld:     totalHeld,
+	}
+}
+
+// Validate performs basic sanity checks over the distribution record.
+func (d MarkerDistribution) Validate() error {
+	if err := sdk.ValidateDenom(d.Denom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(d.Administrator); err != nil {
+		return fmt.Errorf("invalid distribution administrator for %s: %w", d.Denom, err)
+	}
+	if err := d.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid distribution amount for %s: %w", d.Denom, err)
+	}
+	if err := d.Remaining.Validate(); err != nil {
+		return fmt.Errorf("invalid distribution remaining amount for %s: %w", d.Denom, err)
+	}
+	if !d.Amount.IsAllGTE(d.Remaining) {
+		return fmt.Errorf("distribution remaining amount %s exceeds total amount %s", d.Remaining, d.Amount)
+	}
+	if d.TotalHeld.IsNil() || !d.TotalHeld.IsPositive() {
+		return fmt.Errorf("distribution for %s must have a positive total held", d.Denom)
+	}
+	if d.LastHolder != "" {
+		if _, err := sdk.AccAddressFromBech32(d.LastHolder); err != nil {
+			return fmt.Errorf("invalid distribution last holder for %s: %w", d.Denom, err)
+		}
+	}
+	return nil
+}
+
+// HasReached returns true if the holders of the denom have been paid up to and including the given account.  Holders
+// are paid in the order of their holder index keys so any account ordered after the last holder paid has not been
+// reached yet.
+func (d MarkerDistribution) HasReached(holder sdk.AccAddress) bool {
+	if d.LastHolder == "" {
+		return false
+	}
+	last, err := sdk.AccAddressFromBech32(d.LastHolder)
+	if err != nil {
+		return false
+	}
+	return bytes.Compare(address.MustLengthPrefix(holder), address.MustLengthPrefix(last)) <= 0
+}
+
+// NewMarkerDistributionBalance creates a record of the balance of a marker denom held by an account when a
+// distribution to the holders of the denom was requested.  The balance is recorded on the first change to it while the
+// distribution is in progress.
+func NewMarkerDistributionBalance(denom string, holder sdk.AccAddress, balance sdk.Int) MarkerDistributionBalance { // nolint:interfacer
+	return MarkerDistributionBalance{
+		Denom:   denom,
+		Address: holder.String(),
+		Balance: balance,
+	}
+}
+
+// Validate performs basic sanity checks over the recorded holder balance.
+func (b MarkerDistributionBalance) Validate() error {
+	if err := sdk.ValidateDenom(b.Denom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(b.Address); err != nil {
+		return fmt.Errorf("invalid distribution holder for %s: %w", b.Denom, err)
+	}
+	if b.Balance.IsNil() || b.Balance.IsNegative() {
+		return fmt.Errorf("distribution balance of %s for %s can not be negative", b.Address, b.Denom)
+	}
+	return nil
+}
+
+// ShareOf returns the portion of the remaining distribution owed to an account holding the given balance of the
+// marker's denom.  Any fractional amounts are truncated, leaving the dust in the remaining amount.
+func (d MarkerDistribution) ShareOf(balance sdk.Int) sdk.Coins {
+	share := sdk.NewCoins()
+	if !balance.IsPositive() {
+		return share
+	}
+	for _, coin := range d.Amount {
+		amount := coin.Amount.Mul(balance).Quo(d.TotalHeld)
+		// never pay out more than is left of the distribution.
+		if left := d.Remaining.AmountOf(coin.Denom); amount.GT(left) {
+			amount = left
+		}
+		share = share.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	return share
+}
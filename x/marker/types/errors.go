@@ -12,4 +12,5 @@ var (
 	ErrInvalidMarkerStatus     = sdkerrors.Register(ModuleName, 5, "invalid marker status")
 	ErrAccessTypeNotGranted    = sdkerrors.Register(ModuleName, 6, "access type not granted")
 	ErrMarkerNotFound          = sdkerrors.Register(ModuleName, 7, "marker not found")
+	ErrAccountFrozen           = sdkerrors.Register(ModuleName, 8, "account is frozen")
 )
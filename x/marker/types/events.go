@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -29,12 +30,22 @@ const (
 	EventTelemetryLabelAdministrator string = "administrator"
 	// EventTelemetryKeyBurn burn telemetry metrics key
 	EventTelemetryKeyBurn string = "burn"
+	// EventTelemetryKeyBurnFrom burn from telemetry metrics key
+	EventTelemetryKeyBurnFrom string = "burn_from"
 	// EventTelemetryKeyMint mint telemetry metrics key
 	EventTelemetryKeyMint string = "mint"
 	// EventTelemetryKeyTransfer transfer telemetry metrics key
 	EventTelemetryKeyTransfer string = "transfer"
+	// EventTelemetryKeyForceTransfer force transfer telemetry metrics key
+	EventTelemetryKeyForceTransfer string = "force_transfer"
 	// EventTelemetryKeyWithdraw withdraw telemetry metrics key
 	EventTelemetryKeyWithdraw string = "withdraw"
+	// EventTelemetryKeyDistribute distribute telemetry metrics key
+	EventTelemetryKeyDistribute string = "distribute"
+	// EventTelemetryKeyMultiTransfer multi transfer telemetry metrics key
+	EventTelemetryKeyMultiTransfer string = "multi_transfer"
+	// EventTelemetryKeyScheduleWithdraw schedule withdraw telemetry metrics key
+	EventTelemetryKeyScheduleWithdraw string = "schedule_withdraw"
 )
 
 func NewEventMarkerAdd(denom string, amount string, status string, manager string, markerType string) *EventMarkerAdd {
@@ -74,6 +85,13 @@ func NewEventMarkerDeleteAccess(removeAddress string, denom string, administrato
 	}
 }
 
+func NewEventMarkerAccessExpired(address string, denom string) *EventMarkerAccessExpired {
+	return &EventMarkerAccessExpired{
+		Address: address,
+		Denom:   denom,
+	}
+}
+
 func NewEventMarkerFinalize(denom string, administrator string) *EventMarkerFinalize {
 	return &EventMarkerFinalize{
 		Denom:         denom,
@@ -118,6 +136,15 @@ func NewEventMarkerBurn(amount string, denom string, administrator string) *Even
 	}
 }
 
+func NewEventMarkerBurnFrom(amount string, denom string, administrator string, fromAddress string) *EventMarkerBurnFrom {
+	return &EventMarkerBurnFrom{
+		Amount:        amount,
+		Denom:         denom,
+		Administrator: administrator,
+		FromAddress:   fromAddress,
+	}
+}
+
 func NewEventMarkerWithdraw(coins string, denom string, administrator string, toAddress string) *EventMarkerWithdraw {
 	return &EventMarkerWithdraw{
 		Coins:         coins,
@@ -127,6 +154,40 @@ func NewEventMarkerWithdraw(coins string, denom string, administrator string, to
 	}
 }
 
+func NewEventMarkerWithdrawScheduled(schedule MarkerWithdrawSchedule) *EventMarkerWithdrawScheduled {
+	return &EventMarkerWithdrawScheduled{
+		Id:            schedule.Id,
+		Coins:         schedule.Amount.String(),
+		Denom:         schedule.Denom,
+		Administrator: schedule.Administrator,
+		ToAddress:     schedule.ToAddress,
+		ReleaseTime:   schedule.ReleaseTime.UTC().Format(time.RFC3339Nano),
+	}
+}
+
+func NewEventMarkerWithdrawScheduleCancelled(id uint64, denom string, administrator string) *EventMarkerWithdrawScheduleCancelled {
+	return &EventMarkerWithdrawScheduleCancelled{
+		Id:            id,
+		Denom:         denom,
+		Administrator: administrator,
+	}
+}
+
+func NewEventMarkerWithdrawScheduleReleased(id uint64, denom string) *EventMarkerWithdrawScheduleReleased {
+	return &EventMarkerWithdrawScheduleReleased{
+		Id:    id,
+		Denom: denom,
+	}
+}
+
+func NewEventMarkerWithdrawScheduleFailed(id uint64, denom string, reason string) *EventMarkerWithdrawScheduleFailed {
+	return &EventMarkerWithdrawScheduleFailed{
+		Id:     id,
+		Denom:  denom,
+		Reason: reason,
+	}
+}
+
 func NewEventMarkerTransfer(amount string, denom string, administrator string, toAddress string, fromAddress string) *EventMarkerTransfer {
 	return &EventMarkerTransfer{
 		Amount:        amount,
@@ -137,6 +198,73 @@ func NewEventMarkerTransfer(amount string, denom string, administrator string, t
 	}
 }
 
+func NewEventMarkerMultiTransfer(amount string, administrator string, transfers int) *EventMarkerMultiTransfer {
+	return &EventMarkerMultiTransfer{
+		Amount:        amount,
+		Administrator: administrator,
+		Transfers:     fmt.Sprint(transfers),
+	}
+}
+
+func NewEventMarkerChangeManager(denom string, administrator string, oldManager string, newManager string) *EventMarkerChangeManager {
+	return &EventMarkerChangeManager{
+		Denom:         denom,
+		Administrator: administrator,
+		OldManager:    oldManager,
+		NewManager:    newManager,
+	}
+}
+
+func NewEventMarkerSetTransferRestrictionContract(denom string, administrator string, contract string) *EventMarkerSetTransferRestrictionContract {
+	return &EventMarkerSetTransferRestrictionContract{
+		Denom:         denom,
+		Administrator: administrator,
+		Contract:      contract,
+	}
+}
+
+func NewEventMarkerForceTransfer(amount string, denom string, administrator string, toAddress string, fromAddress string) *EventMarkerForceTransfer {
+	return &EventMarkerForceTransfer{
+		Amount:        amount,
+		Denom:         denom,
+		Administrator: administrator,
+		ToAddress:     toAddress,
+		FromAddress:   fromAddress,
+	}
+}
+
+func NewEventMarkerDistribute(amount string, denom string, administrator string) *EventMarkerDistribute {
+	return &EventMarkerDistribute{
+		Amount:        amount,
+		Denom:         denom,
+		Administrator: administrator,
+	}
+}
+
+func NewEventMarkerDistributionComplete(distributed string, returned string, denom string) *EventMarkerDistributionComplete {
+	return &EventMarkerDistributionComplete{
+		Distributed: distributed,
+		Returned:    returned,
+		Denom:       denom,
+	}
+}
+
+func NewEventMarkerFreezeAccount(denom string, administrator string, address string) *EventMarkerFreezeAccount {
+	return &EventMarkerFreezeAccount{
+		Denom:         denom,
+		Administrator: administrator,
+		Address:       address,
+	}
+}
+
+func NewEventMarkerUnfreezeAccount(denom string, administrator string, address string) *EventMarkerUnfreezeAccount {
+	return &EventMarkerUnfreezeAccount{
+		Denom:         denom,
+		Administrator: administrator,
+		Address:       address,
+	}
+}
+
 func NewEventMarkerSetDenomMetadata(metadata banktypes.Metadata, administrator string) *EventMarkerSetDenomMetadata {
 	metadataDenomUnits := make([]*EventDenomUnit, len(metadata.DenomUnits))
 	for i, du := range metadata.DenomUnits {
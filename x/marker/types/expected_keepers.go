@@ -4,6 +4,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
 )
 
 // AccountKeeper defines the expected account keeper (noalias)
@@ -53,3 +57,15 @@ type BankKeeper interface {
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 }
+
+// AttributeKeeper defines the attribute functionality needed by the marker module.
+type AttributeKeeper interface {
+	// Used to check the required attributes of restricted marker recipients.
+	GetAllAttributes(ctx sdk.Context, acc sdk.AccAddress) ([]attrtypes.Attribute, error)
+}
+
+// IbcTransferKeeper defines the ibc transfer functionality needed by the marker module.
+type IbcTransferKeeper interface {
+	// Used to check that the voucher denom of an ibc enabled marker was created by the transfer module.
+	GetDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) (ibctransfertypes.DenomTrace, bool)
+}
@@ -2,8 +2,10 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new GenesisState object
@@ -21,6 +23,51 @@ func (state GenesisState) Validate() error {
 			return err
 		}
 	}
+	for _, frozen := range state.FrozenAccounts {
+		if err := sdk.ValidateDenom(frozen.Denom); err != nil {
+			return err
+		}
+		for _, address := range frozen.Addresses {
+			if _, err := sdk.AccAddressFromBech32(address); err != nil {
+				return fmt.Errorf("invalid frozen account address for %s: %w", frozen.Denom, err)
+			}
+		}
+	}
+	distributions := make(map[string]bool, len(state.Distributions))
+	for _, d := range state.Distributions {
+		if err := d.Validate(); err != nil {
+			return err
+		}
+		distributions[d.Denom] = true
+	}
+	for _, b := range state.DistributionBalances {
+		if err := b.Validate(); err != nil {
+			return err
+		}
+		if !distributions[b.Denom] {
+			return fmt.Errorf("distribution balance of %s recorded for %s without a distribution", b.Address, b.Denom)
+		}
+	}
+	for _, e := range state.History {
+		if err := e.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, a := range state.SupplyAdjustments {
+		if err := a.Validate(); err != nil {
+			return err
+		}
+	}
+	seen := make(map[uint64]bool, len(state.WithdrawSchedules))
+	for _, w := range state.WithdrawSchedules {
+		if err := w.Validate(); err != nil {
+			return err
+		}
+		if seen[w.Id] {
+			return fmt.Errorf("duplicate scheduled withdrawal id %d", w.Id)
+		}
+		seen[w.Id] = true
+	}
 	return nil
 }
 
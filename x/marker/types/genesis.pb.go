@@ -29,6 +29,18 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// A collection of marker accounts to create on start
 	Markers []MarkerAccount `protobuf:"bytes,2,rep,name=markers,proto3" json:"markers"`
+	// accounts frozen from transferring the coin of restricted markers
+	FrozenAccounts []FrozenAccounts `protobuf:"bytes,3,rep,name=frozen_accounts,json=frozenAccounts,proto3" json:"frozen_accounts"`
+	// distributions of escrowed coin to marker holders that have not completed
+	Distributions []MarkerDistribution `protobuf:"bytes,4,rep,name=distributions,proto3" json:"distributions"`
+	// the recorded status transitions and supply changes of markers
+	History []MarkerHistoryEntry `protobuf:"bytes,5,rep,name=history,proto3" json:"history"`
+	// the last automatic supply adjustment made to each marker
+	SupplyAdjustments []MarkerSupplyAdjustment `protobuf:"bytes,6,rep,name=supply_adjustments,json=supplyAdjustments,proto3" json:"supply_adjustments"`
+	// withdrawals of escrowed coin that are scheduled and have not been released
+	WithdrawSchedules []MarkerWithdrawSchedule `protobuf:"bytes,7,rep,name=withdraw_schedules,json=withdrawSchedules,proto3" json:"withdraw_schedules"`
+	// the balances of holders recorded for the distributions that have not completed
+	DistributionBalances []MarkerDistributionBalance `protobuf:"bytes,8,rep,name=distribution_balances,json=distributionBalances,proto3" json:"distribution_balances"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -64,8 +76,64 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+// FrozenAccounts holds the addresses of all accounts frozen for a marker denom
+type FrozenAccounts struct {
+	// denom of the restricted marker
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// addresses of the frozen accounts
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *FrozenAccounts) Reset()         { *m = FrozenAccounts{} }
+func (m *FrozenAccounts) String() string { return proto.CompactTextString(m) }
+func (*FrozenAccounts) ProtoMessage()    {}
+func (*FrozenAccounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dcc4ab7c9d2f78f, []int{1}
+}
+func (m *FrozenAccounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrozenAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrozenAccounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FrozenAccounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrozenAccounts.Merge(m, src)
+}
+func (m *FrozenAccounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *FrozenAccounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrozenAccounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrozenAccounts proto.InternalMessageInfo
+
+func (m *FrozenAccounts) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FrozenAccounts) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "provenance.marker.v1.GenesisState")
+	proto.RegisterType((*FrozenAccounts)(nil), "provenance.marker.v1.FrozenAccounts")
 }
 
 func init() {
@@ -73,23 +141,37 @@ func init() {
 }
 
 var fileDescriptor_5dcc4ab7c9d2f78f = []byte{
-	// 251 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2a, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd2, 0x2f, 0x33,
-	0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12,
-	0x41, 0xa8, 0xd1, 0x83, 0xa8, 0xd1, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x2b,
-	0xd0, 0x07, 0xb1, 0x20, 0x6a, 0xa5, 0x14, 0xb1, 0x9a, 0x07, 0xd5, 0x05, 0x56, 0xa2, 0x34, 0x93,
-	0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x41, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x15, 0x17, 0x5b, 0x41,
-	0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x8c, 0x1e, 0x36, 0x0b,
-	0xf5, 0x02, 0xc0, 0x6a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0xea, 0x10, 0x72, 0xe6,
-	0x62, 0x87, 0xa8, 0x28, 0x96, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xc6, 0xae, 0xd9, 0x17,
-	0xcc, 0x72, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x81, 0x9a, 0x01, 0xd3, 0x69, 0xc5, 0xd1, 0xb1,
-	0x40, 0x9e, 0xe1, 0xc5, 0x02, 0x79, 0x06, 0xa7, 0xf4, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92,
-	0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c,
-	0x96, 0x63, 0xe0, 0x12, 0xcf, 0xcc, 0xc7, 0x6a, 0x72, 0x00, 0x63, 0x94, 0x51, 0x7a, 0x66, 0x49,
-	0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0x42, 0x89, 0x6e, 0x66, 0x3e, 0x12, 0x4f, 0xbf,
-	0x02, 0x16, 0x1c, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0xb0, 0x30, 0x06, 0x04, 0x00,
-	0x00, 0xff, 0xff, 0xa8, 0x55, 0x2c, 0xc0, 0x80, 0x01, 0x00, 0x00,
+	// 475 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x13, 0xb6, 0xb6, 0xab, 0x07, 0x43, 0x58, 0x45, 0x44, 0xd3, 0x94, 0x8e, 0xc2, 0xa1,
+	0x07, 0x48, 0xb4, 0x72, 0xdb, 0x6d, 0x63, 0xc0, 0x2e, 0x48, 0x53, 0x8b, 0x84, 0xc4, 0xa5, 0x72,
+	0x13, 0x2f, 0xf5, 0x68, 0xe2, 0xc8, 0x9f, 0xd3, 0x52, 0x9e, 0x80, 0x23, 0x8f, 0xb0, 0x47, 0xe1,
+	0xb8, 0xe3, 0x8e, 0x9c, 0x10, 0x6a, 0x2f, 0x3c, 0x06, 0x8a, 0xed, 0xd0, 0x76, 0x44, 0xeb, 0x6e,
+	0xf6, 0xe7, 0xff, 0xff, 0xf7, 0xff, 0x6c, 0x7d, 0x46, 0xad, 0x54, 0xf0, 0x31, 0x4d, 0x48, 0x12,
+	0x50, 0x3f, 0x26, 0xe2, 0x33, 0x15, 0xfe, 0xf8, 0xc0, 0x8f, 0x68, 0x42, 0x81, 0x81, 0x97, 0x0a,
+	0x2e, 0x39, 0x6e, 0x2c, 0x34, 0x9e, 0xd6, 0x78, 0xe3, 0x83, 0xdd, 0x46, 0xc4, 0x23, 0xae, 0x04,
+	0x7e, 0xbe, 0xd2, 0xda, 0xdd, 0xa7, 0xa5, 0x3c, 0xe3, 0x52, 0x92, 0xd6, 0x8f, 0x0a, 0xba, 0xff,
+	0x4e, 0x07, 0xf4, 0x24, 0x91, 0x14, 0x1f, 0xa2, 0x6a, 0x4a, 0x04, 0x89, 0xc1, 0xb1, 0xf7, 0xed,
+	0xf6, 0x76, 0x67, 0xcf, 0x2b, 0x0b, 0xf4, 0xce, 0x94, 0xe6, 0x78, 0xf3, 0xea, 0x57, 0xd3, 0xea,
+	0x1a, 0x07, 0x7e, 0x8d, 0x6a, 0x5a, 0x01, 0xce, 0xbd, 0xfd, 0x8d, 0xf6, 0x76, 0xe7, 0x59, 0xb9,
+	0xf9, 0xbd, 0x5a, 0x1d, 0x05, 0x01, 0xcf, 0x12, 0x69, 0x18, 0x85, 0x13, 0xf7, 0xd0, 0xc3, 0x73,
+	0xc1, 0xbf, 0xd2, 0xa4, 0x4f, 0xb4, 0x00, 0x9c, 0x0d, 0x05, 0x7b, 0x5e, 0x0e, 0x7b, 0xab, 0xc4,
+	0x06, 0x56, 0x74, 0xb4, 0x73, 0xbe, 0x52, 0xc5, 0x1f, 0xd0, 0x83, 0x90, 0x81, 0x14, 0x6c, 0x90,
+	0x49, 0xc6, 0x13, 0x70, 0x36, 0x15, 0xb2, 0x7d, 0x5b, 0x7f, 0x27, 0x4b, 0x06, 0x83, 0x5d, 0x85,
+	0xe0, 0x53, 0x54, 0x1b, 0x32, 0x90, 0x5c, 0x4c, 0x9d, 0xca, 0x7a, 0xde, 0xa9, 0x96, 0xbe, 0x49,
+	0xa4, 0x98, 0x16, 0x97, 0x36, 0x76, 0x4c, 0x10, 0x86, 0x2c, 0x4d, 0x47, 0xd3, 0x3e, 0x09, 0x2f,
+	0x32, 0x90, 0x31, 0xcd, 0xef, 0x5d, 0x55, 0xd0, 0x17, 0xb7, 0x41, 0x7b, 0xca, 0x75, 0xf4, 0xcf,
+	0x64, 0xc0, 0x8f, 0xe0, 0x46, 0x1d, 0xf2, 0x88, 0x09, 0x93, 0xc3, 0x50, 0x90, 0x49, 0x1f, 0x82,
+	0x21, 0x0d, 0xb3, 0x11, 0x05, 0xa7, 0xb6, 0x3e, 0xe2, 0xa3, 0x71, 0xf5, 0x8c, 0xa9, 0x88, 0x98,
+	0xdc, 0xa8, 0x03, 0xbe, 0x40, 0x8f, 0x97, 0x1f, 0xa8, 0x3f, 0x20, 0xa3, 0x9c, 0x08, 0xce, 0x96,
+	0x4a, 0xf1, 0xef, 0xfc, 0xda, 0xda, 0x67, 0x82, 0x1a, 0xe1, 0xff, 0x47, 0x70, 0xb8, 0xf5, 0xed,
+	0xb2, 0x69, 0xfd, 0xb9, 0x6c, 0x5a, 0xad, 0x13, 0xb4, 0xb3, 0x3a, 0x03, 0xb8, 0x81, 0x2a, 0x21,
+	0x4d, 0x78, 0xac, 0x46, 0xb8, 0xde, 0xd5, 0x1b, 0xbc, 0x87, 0xea, 0x24, 0x0c, 0x05, 0x05, 0xa0,
+	0x7a, 0x3e, 0xeb, 0xdd, 0x45, 0xe1, 0x38, 0xba, 0x9a, 0xb9, 0xf6, 0xf5, 0xcc, 0xb5, 0x7f, 0xcf,
+	0x5c, 0xfb, 0xfb, 0xdc, 0xb5, 0xae, 0xe7, 0xae, 0xf5, 0x73, 0xee, 0x5a, 0xe8, 0x09, 0xe3, 0xa5,
+	0x8d, 0x9f, 0xd9, 0x9f, 0x3a, 0x11, 0x93, 0xc3, 0x6c, 0xe0, 0x05, 0x3c, 0xf6, 0x17, 0x92, 0x97,
+	0x8c, 0x2f, 0xed, 0xfc, 0x2f, 0xc5, 0xdf, 0x93, 0xd3, 0x94, 0xc2, 0xa0, 0xaa, 0x3e, 0xde, 0xab,
+	0xbf, 0x03, 0x00, 0x0f, 0x5b, 0x14, 0x34, 0xed, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -112,6 +194,90 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DistributionBalances) > 0 {
+		for iNdEx := len(m.DistributionBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.WithdrawSchedules) > 0 {
+		for iNdEx := len(m.WithdrawSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.SupplyAdjustments) > 0 {
+		for iNdEx := len(m.SupplyAdjustments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SupplyAdjustments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Distributions) > 0 {
+		for iNdEx := len(m.Distributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FrozenAccounts) > 0 {
+		for iNdEx := len(m.FrozenAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Markers) > 0 {
 		for iNdEx := len(m.Markers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -139,6 +305,45 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FrozenAccounts) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrozenAccounts) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrozenAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -164,6 +369,61 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FrozenAccounts) > 0 {
+		for _, e := range m.FrozenAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Distributions) > 0 {
+		for _, e := range m.Distributions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SupplyAdjustments) > 0 {
+		for _, e := range m.SupplyAdjustments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WithdrawSchedules) > 0 {
+		for _, e := range m.WithdrawSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DistributionBalances) > 0 {
+		for _, e := range m.DistributionBalances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FrozenAccounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -269,6 +529,324 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAccounts = append(m.FrozenAccounts, FrozenAccounts{})
+			if err := m.FrozenAccounts[len(m.FrozenAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributions = append(m.Distributions, MarkerDistribution{})
+			if err := m.Distributions[len(m.Distributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, MarkerHistoryEntry{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyAdjustments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplyAdjustments = append(m.SupplyAdjustments, MarkerSupplyAdjustment{})
+			if err := m.SupplyAdjustments[len(m.SupplyAdjustments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawSchedules = append(m.WithdrawSchedules, MarkerWithdrawSchedule{})
+			if err := m.WithdrawSchedules[len(m.WithdrawSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionBalances = append(m.DistributionBalances, MarkerDistributionBalance{})
+			if err := m.DistributionBalances[len(m.DistributionBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FrozenAccounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FrozenAccounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FrozenAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxMarkerHistoryEntries is the maximum number of history entries kept for a marker, older entries are pruned.
+const MaxMarkerHistoryEntries = 100
+
+// NewMarkerHistoryEntry creates a history entry recording a change made to a marker by the given actor.
+func NewMarkerHistoryEntry(
+	denom string, sequence uint64, height int64, blockTime time.Time,
+	action MarkerHistoryAction, actor string, amount sdk.Int, status MarkerStatus,
+) MarkerHistoryEntry {
+	return MarkerHistoryEntry{
+		Denom:    denom,
+		Sequence: sequence,
+		Height:   height,
+		Time:     blockTime,
+		Action:   action,
+		Actor:    actor,
+		Amount:   amount,
+		Status:   status,
+	}
+}
+
+// Validate performs basic sanity checks over the history entry.
+func (e MarkerHistoryEntry) Validate() error {
+	if err := sdk.ValidateDenom(e.Denom); err != nil {
+		return err
+	}
+	if e.Sequence == 0 {
+		return fmt.Errorf("history entry for %s must have a positive sequence", e.Denom)
+	}
+	if _, ok := MarkerHistoryAction_name[int32(e.Action)]; !ok || e.Action == HistoryActionUnspecified {
+		return fmt.Errorf("invalid history action %d for %s", e.Action, e.Denom)
+	}
+	if !ValidMarkerStatus(e.Status) {
+		return fmt.Errorf("invalid marker status %s in history entry for %s", e.Status, e.Denom)
+	}
+	if len(e.Actor) > 0 {
+		if _, err := sdk.AccAddressFromBech32(e.Actor); err != nil {
+			return fmt.Errorf("invalid history actor for %s: %w", e.Denom, err)
+		}
+	}
+	if e.Amount.IsNil() || e.Amount.IsNegative() {
+		return fmt.Errorf("history entry for %s must have a non-negative amount", e.Denom)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TransferRestrictionHook is called before restricted marker coin is transferred by the marker module.  A hook may
+// reject the transfer by returning an error, in which case no coin is moved.
+type TransferRestrictionHook interface {
+	BeforeRestrictedTransfer(ctx sdk.Context, marker MarkerAccountI, from, to, admin sdk.AccAddress, amount sdk.Coin) error
+}
+
+var _ TransferRestrictionHook = MultiTransferRestrictionHooks{}
+
+// MultiTransferRestrictionHooks combines multiple transfer restriction hooks, all of which must allow a transfer.
+type MultiTransferRestrictionHooks []TransferRestrictionHook
+
+// NewMultiTransferRestrictionHooks returns a hook that calls each of the given hooks in order.
+func NewMultiTransferRestrictionHooks(hooks ...TransferRestrictionHook) MultiTransferRestrictionHooks {
+	return hooks
+}
+
+// BeforeRestrictedTransfer calls each hook in order and returns the first error encountered.
+func (h MultiTransferRestrictionHooks) BeforeRestrictedTransfer(
+	ctx sdk.Context, marker MarkerAccountI, from, to, admin sdk.AccAddress, amount sdk.Coin,
+) error {
+	for _, hook := range h {
+		if err := hook.BeforeRestrictedTransfer(ctx, marker, from, to, admin, amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
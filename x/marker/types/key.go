@@ -1,7 +1,9 @@
 package types
 
 import (
+	"encoding/binary"
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -31,6 +33,42 @@ const (
 var (
 	// MarkerStoreKeyPrefix prefix for marker-address reference (improves iterator performance over auth accounts)
 	MarkerStoreKeyPrefix = []byte{0x02}
+
+	// MarkerHolderKeyPrefix prefix for marker-holder address references (avoids scanning all balances for holders)
+	MarkerHolderKeyPrefix = []byte{0x03}
+
+	// MarkerSupplyCheckKeyPrefix prefix for marker addresses queued for a supply check in the next begin block
+	MarkerSupplyCheckKeyPrefix = []byte{0x04}
+
+	// MarkerFrozenAccountKeyPrefix prefix for accounts frozen from transferring a restricted marker's coin
+	MarkerFrozenAccountKeyPrefix = []byte{0x05}
+
+	// MarkerDistributionKeyPrefix prefix for distributions of escrowed coin to marker holders that are in progress
+	MarkerDistributionKeyPrefix = []byte{0x06}
+
+	// MarkerGrantExpirationKeyPrefix prefix for access grants ordered by the time they expire
+	MarkerGrantExpirationKeyPrefix = []byte{0x07}
+
+	// MarkerGranteeKeyPrefix prefix for markers managed by or with access granted to an address
+	MarkerGranteeKeyPrefix = []byte{0x08}
+
+	// MarkerHistoryKeyPrefix prefix for the recorded status transitions and supply changes of a marker
+	MarkerHistoryKeyPrefix = []byte{0x09}
+
+	// MarkerSupplyAdjustmentKeyPrefix prefix for the last automatic supply adjustment made to a marker
+	MarkerSupplyAdjustmentKeyPrefix = []byte{0x0A}
+
+	// MarkerWithdrawScheduleKeyPrefix prefix for withdrawals of escrowed coin scheduled against a marker
+	MarkerWithdrawScheduleKeyPrefix = []byte{0x0B}
+
+	// MarkerWithdrawReleaseKeyPrefix prefix for scheduled withdrawals ordered by the time they are released
+	MarkerWithdrawReleaseKeyPrefix = []byte{0x0C}
+
+	// MarkerWithdrawScheduleSequenceKey key for the last identifier assigned to a scheduled withdrawal
+	MarkerWithdrawScheduleSequenceKey = []byte{0x0D}
+
+	// MarkerDistributionBalanceKeyPrefix prefix for the holder balances recorded for a distribution in progress
+	MarkerDistributionBalanceKeyPrefix = []byte{0x0E}
 )
 
 // MarkerAddress returns the module account address for the given denomination
@@ -59,3 +97,129 @@ func MarkerStoreKey(addr sdk.AccAddress) []byte {
 func SplitMarkerStoreKey(key []byte) sdk.AccAddress {
 	return sdk.AccAddress(key[2 : key[1]+2])
 }
+
+// MarkerHolderKeyPrefixForDenom returns the key prefix for all holder references of the given marker denom
+func MarkerHolderKeyPrefixForDenom(denom string) []byte {
+	return append(MarkerHolderKeyPrefix, address.MustLengthPrefix(MustGetMarkerAddress(denom).Bytes())...)
+}
+
+// MarkerHolderKey returns the key used to reference an account holding the given marker denom
+func MarkerHolderKey(denom string, holder sdk.AccAddress) []byte {
+	return append(MarkerHolderKeyPrefixForDenom(denom), address.MustLengthPrefix(holder.Bytes())...)
+}
+
+// MarkerSupplyCheckKey returns the key used to queue a marker for a supply check
+func MarkerSupplyCheckKey(addr sdk.AccAddress) []byte {
+	return append(MarkerSupplyCheckKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// MarkerFrozenAccountKeyPrefixForDenom returns the key prefix for all frozen accounts of the given marker denom
+func MarkerFrozenAccountKeyPrefixForDenom(denom string) []byte {
+	return append(MarkerFrozenAccountKeyPrefix, address.MustLengthPrefix(MustGetMarkerAddress(denom).Bytes())...)
+}
+
+// MarkerFrozenAccountKey returns the key used to record an account frozen for the given marker denom
+func MarkerFrozenAccountKey(denom string, addr sdk.AccAddress) []byte {
+	return append(MarkerFrozenAccountKeyPrefixForDenom(denom), address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// MarkerDistributionKey returns the key used to record a distribution to the holders of the given marker denom
+func MarkerDistributionKey(denom string) []byte {
+	return append(MarkerDistributionKeyPrefix, address.MustLengthPrefix(MustGetMarkerAddress(denom).Bytes())...)
+}
+
+// MarkerDistributionBalanceKeyPrefixForDenom returns the key prefix for all holder balances recorded for the
+// distribution to the holders of the given marker denom
+func MarkerDistributionBalanceKeyPrefixForDenom(denom string) []byte {
+	return append(MarkerDistributionBalanceKeyPrefix, address.MustLengthPrefix(MustGetMarkerAddress(denom).Bytes())...)
+}
+
+// MarkerDistributionBalanceKey returns the key used to store the balance of a holder recorded for a distribution
+func MarkerDistributionBalanceKey(denom string, holder sdk.AccAddress) []byte {
+	return append(MarkerDistributionBalanceKeyPrefixForDenom(denom), address.MustLengthPrefix(holder.Bytes())...)
+}
+
+// MarkerGrantExpirationKeyPrefixForTime returns the key prefix for all access grants that expire at the given time
+func MarkerGrantExpirationKeyPrefixForTime(expiration time.Time) []byte {
+	return append(MarkerGrantExpirationKeyPrefix, sdk.FormatTimeBytes(expiration)...)
+}
+
+// MarkerGrantExpirationKey returns the key used to reference an access grant on a marker that expires at the given time
+func MarkerGrantExpirationKey(expiration time.Time, markerAddr sdk.AccAddress, grantee sdk.AccAddress) []byte {
+	key := append(MarkerGrantExpirationKeyPrefixForTime(expiration), address.MustLengthPrefix(markerAddr.Bytes())...)
+	return append(key, address.MustLengthPrefix(grantee.Bytes())...)
+}
+
+// SplitMarkerGrantExpirationKey returns the marker and grantee addresses from an access grant expiration key
+func SplitMarkerGrantExpirationKey(key []byte) (markerAddr, grantee sdk.AccAddress) {
+	// skip the prefix and the fixed length formatted time
+	addrs := key[len(MarkerGrantExpirationKeyPrefix)+len(sdk.FormatTimeBytes(time.Time{})):]
+	markerAddr = addrs[1 : addrs[0]+1]
+	addrs = addrs[addrs[0]+1:]
+	grantee = addrs[1 : addrs[0]+1]
+	return sdk.AccAddress(markerAddr), sdk.AccAddress(grantee)
+}
+
+// MarkerGranteeKeyPrefixForAddress returns the key prefix for all markers managed by or with access granted to an address
+func MarkerGranteeKeyPrefixForAddress(grantee sdk.AccAddress) []byte {
+	return append(MarkerGranteeKeyPrefix, address.MustLengthPrefix(grantee.Bytes())...)
+}
+
+// MarkerGranteeKey returns the key used to reference a marker managed by or with access granted to an address
+func MarkerGranteeKey(grantee sdk.AccAddress, markerAddr sdk.AccAddress) []byte {
+	return append(MarkerGranteeKeyPrefixForAddress(grantee), address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// MarkerHistoryKeyPrefixForDenom returns the key prefix for all history entries of the given marker denom
+func MarkerHistoryKeyPrefixForDenom(denom string) []byte {
+	return MarkerHistoryKeyPrefixForAddress(MustGetMarkerAddress(denom))
+}
+
+// MarkerHistoryKeyPrefixForAddress returns the key prefix for all history entries of the marker with the given address
+func MarkerHistoryKeyPrefixForAddress(markerAddr sdk.AccAddress) []byte {
+	return append(MarkerHistoryKeyPrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// MarkerHistoryKey returns the key used to record a history entry of the given marker denom, ordered by sequence
+func MarkerHistoryKey(denom string, sequence uint64) []byte {
+	return append(MarkerHistoryKeyPrefixForDenom(denom), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// SplitMarkerHistoryKey returns the sequence of a history entry from its key (with or without the denom prefix)
+func SplitMarkerHistoryKey(key []byte) uint64 {
+	return binary.BigEndian.Uint64(key[len(key)-8:])
+}
+
+// MarkerSupplyAdjustmentKey returns the key used to record the last automatic supply adjustment made to a marker
+func MarkerSupplyAdjustmentKey(addr sdk.AccAddress) []byte {
+	return append(MarkerSupplyAdjustmentKeyPrefix, address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// MarkerWithdrawScheduleKeyPrefixForAddress returns the key prefix for all withdrawals scheduled against a marker
+func MarkerWithdrawScheduleKeyPrefixForAddress(markerAddr sdk.AccAddress) []byte {
+	return append(MarkerWithdrawScheduleKeyPrefix, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// MarkerWithdrawScheduleKey returns the key used to record a withdrawal scheduled against a marker, ordered by id
+func MarkerWithdrawScheduleKey(markerAddr sdk.AccAddress, id uint64) []byte {
+	return append(MarkerWithdrawScheduleKeyPrefixForAddress(markerAddr), sdk.Uint64ToBigEndian(id)...)
+}
+
+// MarkerWithdrawReleaseKeyPrefixForTime returns the key prefix for all scheduled withdrawals released at the given time
+func MarkerWithdrawReleaseKeyPrefixForTime(releaseTime time.Time) []byte {
+	return append(MarkerWithdrawReleaseKeyPrefix, sdk.FormatTimeBytes(releaseTime)...)
+}
+
+// MarkerWithdrawReleaseKey returns the key used to reference a withdrawal scheduled against a marker by release time
+func MarkerWithdrawReleaseKey(releaseTime time.Time, markerAddr sdk.AccAddress, id uint64) []byte {
+	key := append(MarkerWithdrawReleaseKeyPrefixForTime(releaseTime), address.MustLengthPrefix(markerAddr.Bytes())...)
+	return append(key, sdk.Uint64ToBigEndian(id)...)
+}
+
+// SplitMarkerWithdrawReleaseKey returns the marker address and id of a scheduled withdrawal from its release key
+func SplitMarkerWithdrawReleaseKey(key []byte) (markerAddr sdk.AccAddress, id uint64) {
+	// skip the prefix and the fixed length formatted time
+	rest := key[len(MarkerWithdrawReleaseKeyPrefix)+len(sdk.FormatTimeBytes(time.Time{})):]
+	markerAddr = rest[1 : rest[0]+1]
+	return markerAddr, binary.BigEndian.Uint64(rest[rest[0]+1:])
+}
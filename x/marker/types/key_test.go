@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
@@ -20,3 +21,64 @@ func TestSplitMarkerStoreKey(t *testing.T) {
 	assert.Equal(t, addr, SplitMarkerStoreKey(MarkerStoreKey(addr)), "should parse a marker of length 20 from key")
 	assert.Equal(t, largerLengthAddr, SplitMarkerStoreKey(MarkerStoreKey(largerLengthAddr)), "should parse a marker of length 24 from key")
 }
+
+func TestMarkerHolderKey(t *testing.T) {
+	holder := sdk.AccAddress("FFFFFFFFFFFFFFFFFFFFFFFF")
+	key := MarkerHolderKey("nhash", holder)
+	prefix := MarkerHolderKeyPrefixForDenom("nhash")
+	assert.Equal(t, MarkerHolderKeyPrefix[0], key[0], "key should start with the marker holder prefix")
+	assert.Equal(t, prefix, key[:len(prefix)], "key should start with the denom holder prefix")
+	assert.Equal(t, byte(len(holder)), key[len(prefix)], "holder address should be length prefixed")
+	assert.Equal(t, holder, sdk.AccAddress(key[len(prefix)+1:]), "key should end with the holder address")
+	assert.NotEqual(t, prefix, MarkerHolderKeyPrefixForDenom("nhashx"), "denom prefixes should be distinct")
+}
+
+func TestMarkerDistributionBalanceKey(t *testing.T) {
+	holder := sdk.AccAddress("FFFFFFFFFFFFFFFFFFFFFFFF")
+	key := MarkerDistributionBalanceKey("nhash", holder)
+	prefix := MarkerDistributionBalanceKeyPrefixForDenom("nhash")
+	assert.Equal(t, MarkerDistributionBalanceKeyPrefix[0], key[0], "key should start with the distribution balance prefix")
+	assert.Equal(t, prefix, key[:len(prefix)], "key should start with the denom distribution balance prefix")
+	assert.Equal(t, byte(len(holder)), key[len(prefix)], "holder address should be length prefixed")
+	assert.Equal(t, holder, sdk.AccAddress(key[len(prefix)+1:]), "key should end with the holder address")
+}
+
+func TestMarkerGranteeKey(t *testing.T) {
+	grantee := sdk.AccAddress("FFFFFFFFFFFFFFFFFFFFFFFF")
+	markerAddr := MustGetMarkerAddress("nhash")
+	key := MarkerGranteeKey(grantee, markerAddr)
+	prefix := MarkerGranteeKeyPrefixForAddress(grantee)
+	assert.Equal(t, MarkerGranteeKeyPrefix[0], key[0], "key should start with the marker grantee prefix")
+	assert.Equal(t, prefix, key[:len(prefix)], "key should start with the grantee prefix")
+	assert.Equal(t, byte(len(markerAddr)), key[len(prefix)], "marker address should be length prefixed")
+	assert.Equal(t, markerAddr, sdk.AccAddress(key[len(prefix)+1:]), "key should end with the marker address")
+}
+
+func TestMarkerHistoryKey(t *testing.T) {
+	key := MarkerHistoryKey("nhash", 258)
+	prefix := MarkerHistoryKeyPrefixForDenom("nhash")
+	assert.Equal(t, MarkerHistoryKeyPrefix[0], key[0], "key should start with the marker history prefix")
+	assert.Equal(t, prefix, key[:len(prefix)], "key should start with the denom history prefix")
+	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 1, 2}, key[len(prefix):], "key should end with the big endian sequence")
+	assert.Equal(t, uint64(258), SplitMarkerHistoryKey(key), "sequence should be parsed from the key")
+	assert.True(t, string(MarkerHistoryKey("nhash", 2)) < string(MarkerHistoryKey("nhash", 10)), "keys should sort by sequence")
+}
+
+func TestMarkerWithdrawReleaseKey(t *testing.T) {
+	markerAddr := MustGetMarkerAddress("nhash")
+	releaseTime := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	key := MarkerWithdrawReleaseKey(releaseTime, markerAddr, 258)
+	prefix := MarkerWithdrawReleaseKeyPrefixForTime(releaseTime)
+	assert.Equal(t, MarkerWithdrawReleaseKeyPrefix[0], key[0], "key should start with the withdraw release prefix")
+	assert.Equal(t, prefix, key[:len(prefix)], "key should start with the release time prefix")
+	addr, id := SplitMarkerWithdrawReleaseKey(key)
+	assert.Equal(t, markerAddr, addr, "marker address should be parsed from the key")
+	assert.Equal(t, uint64(258), id, "id should be parsed from the key")
+	assert.True(t, string(key) < string(MarkerWithdrawReleaseKey(releaseTime.Add(time.Second), markerAddr, 1)),
+		"keys should sort by release time")
+
+	scheduleKey := MarkerWithdrawScheduleKey(markerAddr, 258)
+	assert.Equal(t, MarkerWithdrawScheduleKeyPrefix[0], scheduleKey[0], "key should start with the withdraw schedule prefix")
+	assert.Equal(t, MarkerWithdrawScheduleKeyPrefixForAddress(markerAddr), scheduleKey[:len(scheduleKey)-8],
+		"key should start with the marker schedule prefix")
+}
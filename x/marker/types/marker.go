@@ -3,13 +3,18 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/modules/apps/transfer/types"
 	proto "github.com/gogo/protobuf/proto"
 )
 
+// ibcVoucherDenomPrefix is the prefix of the denoms the transfer module uses for coin received over IBC
+const ibcVoucherDenomPrefix = ibctransfertypes.DenomPrefix + "/"
+
 var (
 	// ensure the MarkerAccount correctly extends the following interfaces
 	_ authtypes.AccountI       = (*MarkerAccount)(nil)
@@ -28,6 +33,7 @@ type MarkerAccountI interface {
 
 	GetDenom() string
 	GetManager() sdk.AccAddress
+	SetManager(sdk.AccAddress) error
 	GetMarkerType() MarkerType
 
 	GetStatus() MarkerStatus
@@ -37,14 +43,24 @@ type MarkerAccountI interface {
 	SetSupply(sdk.Coin) error
 	HasFixedSupply() bool
 
+	GetMaxSupply() sdk.Int
+	SetMaxSupply(sdk.Int) error
+	ExceedsMaxSupply(sdk.Int) bool
+
 	GrantAccess(AccessGrantI) error
 	RevokeAccess(sdk.AccAddress) error
+	RevokeExpiredAccess(sdk.AccAddress, time.Time) []AccessGrant
 	GetAccessList() []AccessGrant
 
-	AddressHasAccess(sdk.AccAddress, Access) bool
-	AddressListForPermission(Access) []sdk.AccAddress
+	AddressHasAccess(sdk.AccAddress, Access, time.Time) bool
+	AddressListForPermission(Access, time.Time) []sdk.AccAddress
 
 	HasGovernanceEnabled() bool
+
+	GetRequiredAttributes() []string
+	GetTransferRestrictionContract() string
+	SetTransferRestrictionContract(string) error
+	HasIbcEnabled() bool
 }
 
 // NewEmptyMarkerAccount creates a new empty marker account in a Proposed state
@@ -56,6 +72,7 @@ func NewEmptyMarkerAccount(denom, manager string, grants []AccessGrant) *MarkerA
 		Denom:                  denom,
 		Manager:                manager,
 		Supply:                 sdk.ZeroInt(),
+		MaxSupply:              sdk.ZeroInt(),
 		Status:                 StatusProposed,
 		MarkerType:             MarkerType_Coin,
 		SupplyFixed:            true,
@@ -81,6 +98,7 @@ func NewMarkerAccount(
 		Denom:                  totalSupply.Denom,
 		Manager:                manager.String(),
 		Supply:                 totalSupply.Amount,
+		MaxSupply:              sdk.ZeroInt(),
 		AccessControl:          accessControls,
 		Status:                 status,
 		MarkerType:             markerType,
@@ -104,11 +122,34 @@ func (ma MarkerAccount) HasFixedSupply() bool { return ma.SupplyFixed }
 // HasGovernanceEnabled returns true if this marker allows governance proposals to control this marker
 func (ma MarkerAccount) HasGovernanceEnabled() bool { return ma.AllowGovernanceControl }
 
+// GetRequiredAttributes returns the list of attribute names an account must hold to receive restricted coin
+// from this marker without a transfer agent
+func (ma MarkerAccount) GetRequiredAttributes() []string { return ma.RequiredAttributes }
+
+// GetTransferRestrictionContract returns the address of the smart contract that is queried to approve each transfer
+// of restricted coin from this marker, empty if there is none
+func (ma MarkerAccount) GetTransferRestrictionContract() string {
+	return ma.TransferRestrictionContract
+}
+
+// SetTransferRestrictionContract sets the address of the smart contract that approves transfers, an empty address
+// clears it
+func (ma *MarkerAccount) SetTransferRestrictionContract(contract string) error {
+	if err := ValidateTransferRestrictionContract(ma.MarkerType, contract); err != nil {
+		return err
+	}
+	ma.TransferRestrictionContract = contract
+	return nil
+}
+
+// HasIbcEnabled returns true if this marker wraps an IBC voucher denom whose supply is managed by the transfer module
+func (ma MarkerAccount) HasIbcEnabled() bool { return ma.IbcEnabled }
+
 // AddressHasAccess returns true if the provided address has been assigned the provided
-// role within the current MarkerAccount AccessControl
-func (ma *MarkerAccount) AddressHasAccess(addr sdk.AccAddress, role Access) bool {
+// role within the current MarkerAccount AccessControl by a grant that has not expired at the given block time
+func (ma *MarkerAccount) AddressHasAccess(addr sdk.AccAddress, role Access, blockTime time.Time) bool {
 	for _, g := range ma.AccessControl {
-		if g.Address == addr.String() && g.HasAccess(role) {
+		if g.Address == addr.String() && g.HasAccess(role) && !g.IsExpired(blockTime) {
 			return true
 		}
 	}
@@ -116,12 +157,15 @@ func (ma *MarkerAccount) AddressHasAccess(addr sdk.AccAddress, role Access) bool
 }
 
 // AddressListForPermission returns a list of all addresses with the provided rule within the
-// current MarkerAccount AccessControl list
-func (ma *MarkerAccount) AddressListForPermission(role Access) []sdk.AccAddress {
+// current MarkerAccount AccessControl list by a grant that has not expired at the given block time.  A zero block time
+// includes grants regardless of their expiration.
+func (ma *MarkerAccount) AddressListForPermission(role Access, blockTime time.Time) []sdk.AccAddress {
 	var addressList []sdk.AccAddress
 
+	seen := make(map[string]bool)
 	for _, g := range ma.AccessControl {
-		if g.HasAccess(role) {
+		if g.HasAccess(role) && (blockTime.IsZero() || !g.IsExpired(blockTime)) && !seen[g.Address] {
+			seen[g.Address] = true
 			addressList = append(addressList, g.GetAddress())
 		}
 	}
@@ -137,10 +181,16 @@ func (ma MarkerAccount) Validate() error {
 	if ma.Supply.IsNegative() {
 		return fmt.Errorf("total supply must be greater than or equal to zero")
 	}
-	if ma.Status < StatusActive && ma.Manager == "" && len(ma.AddressListForPermission(Access_Admin)) == 0 {
+	if maxSupply := ma.GetMaxSupply(); maxSupply.IsNegative() {
+		return fmt.Errorf("max supply must be greater than or equal to zero")
+	} else if ma.ExceedsMaxSupply(ma.Supply) {
+		return fmt.Errorf("total supply %s exceeds max supply %s", ma.Supply, maxSupply)
+	}
+	if ma.Status < StatusActive && ma.Manager == "" && len(ma.AddressListForPermission(Access_Admin, time.Time{})) == 0 {
 		return fmt.Errorf("a manager is required if there are no accounts with ACCESS_ADMIN and marker is not ACTIVE")
 	}
-	if ma.Status == StatusFinalized && len(ma.AddressListForPermission(Access_Mint)) == 0 && ma.Supply.IsZero() {
+	if ma.Status == StatusFinalized && !ma.IbcEnabled && len(ma.AddressListForPermission(Access_Mint, time.Time{})) == 0 &&
+		ma.Supply.IsZero() {
 		return fmt.Errorf("cannot create a marker with zero total supply and no authorization for minting more")
 	}
 	// unlikely as this is set using a Coin which prohibits this value.
@@ -157,13 +207,26 @@ func (ma MarkerAccount) Validate() error {
 	if err := ValidateGrantsForMarkerType(ma.MarkerType, ma.AccessControl...); err != nil {
 		return fmt.Errorf("invalid access privileges granted: %w", err)
 	}
-	selfGrant := GrantsForAddress(ma.GetAddress(), ma.AccessControl...).GetAccessList()
-	if len(selfGrant) > 0 {
-		return fmt.Errorf("permissions cannot be granted to '%s' marker account: %v", ma.Denom, selfGrant)
+	for _, grant := range ma.AccessControl {
+		if grant.Address == ma.Address && len(grant.Permissions) > 0 {
+			return fmt.Errorf("permissions cannot be granted to '%s' marker account: %v", ma.Denom, grant.Permissions)
+		}
 	}
 	if ma.Manager == ma.GetAddress().String() {
 		return fmt.Errorf("marker can not be self managed")
 	}
+	if len(ma.RequiredAttributes) > 0 && ma.MarkerType != MarkerType_RestrictedCoin {
+		return fmt.Errorf("required attributes are only supported for restricted markers")
+	}
+	if err := ValidateRequiredAttributes(ma.RequiredAttributes); err != nil {
+		return err
+	}
+	if err := ValidateTransferRestrictionContract(ma.MarkerType, ma.TransferRestrictionContract); err != nil {
+		return err
+	}
+	if err := ValidateIbcEnabled(ma.MarkerType, ma.Denom, ma.SupplyFixed, ma.IbcEnabled); err != nil {
+		return err
+	}
 	return ma.BaseAccount.Validate()
 }
 
@@ -178,10 +241,11 @@ func ValidateGrantsForMarkerType(markerType MarkerType, grants ...AccessGrant) e
 						return fmt.Errorf("%v is not supported for marker type %v", access, markerType)
 					}
 				}
-			// Restricted Coins also support Transfer access
+			// Restricted Coins also support Transfer, Freeze, ForceTransfer and BurnFrom access
 			case MarkerType_RestrictedCoin:
 				{
-					if !access.IsOneOf(Access_Admin, Access_Burn, Access_Delete, Access_Deposit, Access_Mint, Access_Withdraw, Access_Transfer) {
+					if !access.IsOneOf(Access_Admin, Access_Burn, Access_Delete, Access_Deposit, Access_Mint, Access_Withdraw,
+						Access_Transfer, Access_Freeze, Access_ForceTransfer, Access_BurnFrom) {
 						return fmt.Errorf("%v is not supported for marker type %v", access, markerType)
 					}
 				}
@@ -193,6 +257,62 @@ func ValidateGrantsForMarkerType(markerType MarkerType, grants ...AccessGrant) e
 	return ValidateGrants(grants...)
 }
 
+// ValidateTransferRestrictionContract checks that a transfer restriction contract is a valid address and is only set
+// on a restricted marker
+func ValidateTransferRestrictionContract(markerType MarkerType, contract string) error {
+	if len(contract) == 0 {
+		return nil
+	}
+	if markerType != MarkerType_RestrictedCoin {
+		return fmt.Errorf("transfer restriction contract is only supported for restricted markers")
+	}
+	if _, err := sdk.AccAddressFromBech32(contract); err != nil {
+		return fmt.Errorf("invalid transfer restriction contract address: %w", err)
+	}
+	return nil
+}
+
+// ValidateIbcEnabled checks that only IBC enabled markers use IBC voucher denoms and that IBC enabled markers are coin
+// markers without a fixed supply
+func ValidateIbcEnabled(markerType MarkerType, denom string, supplyFixed bool, ibcEnabled bool) error {
+	isVoucher := strings.HasPrefix(denom, ibcVoucherDenomPrefix)
+	if !ibcEnabled {
+		if isVoucher {
+			return fmt.Errorf("ibc voucher denom %s can only be used by ibc enabled markers", denom)
+		}
+		return nil
+	}
+	if markerType != MarkerType_Coin {
+		return fmt.Errorf("ibc enabled markers are only supported for coin markers")
+	}
+	if supplyFixed {
+		return fmt.Errorf("ibc enabled markers can not have a fixed supply")
+	}
+	if !isVoucher {
+		return fmt.Errorf("ibc enabled marker denom %s is not an ibc voucher denom", denom)
+	}
+	if err := ibctransfertypes.ValidateIBCDenom(denom); err != nil {
+		return fmt.Errorf("invalid ibc voucher denom: %w", err)
+	}
+	return nil
+}
+
+// ValidateRequiredAttributes checks a list of required attribute names for empty and duplicate entries
+func ValidateRequiredAttributes(requiredAttributes []string) error {
+	seen := make(map[string]bool, len(requiredAttributes))
+	for _, name := range requiredAttributes {
+		normalized := strings.ToLower(strings.TrimSpace(name))
+		if len(normalized) == 0 {
+			return fmt.Errorf("required attribute names cannot be empty")
+		}
+		if seen[normalized] {
+			return fmt.Errorf("required attribute %q is listed more than once", normalized)
+		}
+		seen[normalized] = true
+	}
+	return nil
+}
+
 // GetPubKey implements authtypes.Account (but there are no public keys associated with the account for signing)
 func (ma MarkerAccount) GetPubKey() cryptotypes.PubKey {
 	return nil
@@ -255,10 +375,11 @@ func (ma MarkerAccount) GetManager() sdk.AccAddress {
 	return addr
 }
 
-// SetManager sets the manager/owner address for proposed marker accounts
+// SetManager sets the manager/owner address for marker accounts that are not destroyed.  The manager of an active
+// marker is cleared on activation and can only be assigned again through a change manager message or proposal.
 func (ma *MarkerAccount) SetManager(manager sdk.AccAddress) error {
-	if !manager.Empty() && ma.Status != StatusProposed {
-		return fmt.Errorf("manager address is only valid for proposed markers, use access grants instead")
+	if !manager.Empty() && ma.Status == StatusDestroyed {
+		return fmt.Errorf("manager address is not valid for %s markers", ma.Status)
 	}
 	if err := sdk.VerifyAddressFormat(manager); err != nil {
 		return err
@@ -281,28 +402,66 @@ func (ma MarkerAccount) GetSupply() sdk.Coin {
 	return sdk.NewCoin(ma.Denom, ma.Supply)
 }
 
-// GrantAccess appends the access grant to the marker account.
+// GetMaxSupply returns the upper limit on the supply of the marker, zero indicates no limit.
+func (ma MarkerAccount) GetMaxSupply() sdk.Int {
+	// markers stored before max supply was introduced will not have a value set.
+	if ma.MaxSupply.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return ma.MaxSupply
+}
+
+// SetMaxSupply sets the upper limit on the supply of the marker, this value may only be set on proposed markers.
+func (ma *MarkerAccount) SetMaxSupply(maxSupply sdk.Int) error {
+	if ma.Status != StatusProposed {
+		return fmt.Errorf("max supply can only be set on proposed markers")
+	}
+	if maxSupply.IsNegative() {
+		return fmt.Errorf("max supply must be greater than or equal to zero")
+	}
+	ma.MaxSupply = maxSupply
+	return nil
+}
+
+// ExceedsMaxSupply returns true if the marker has a max supply set and the given total is greater than it.
+func (ma MarkerAccount) ExceedsMaxSupply(total sdk.Int) bool {
+	maxSupply := ma.GetMaxSupply()
+	return maxSupply.IsPositive() && total.GT(maxSupply)
+}
+
+// GrantAccess appends the access grant to the marker account.  The permissions are merged into the address's existing
+// grant with the same expiration, an address holds a separate grant for each expiration so that each permission keeps
+// the expiration it was granted with.
 func (ma *MarkerAccount) GrantAccess(access AccessGrantI) error {
 	if err := access.Validate(); err != nil {
 		return fmt.Errorf(err.Error())
 	}
-	// Find any existing permissions and append specified permissions
+	grant := NewAccessGrant(access.GetAddress(), access.GetAccessList())
+	grant.Expiration = access.GetExpiration()
+	// Find any existing permissions with the same expiration and append specified permissions
+	var accessList []AccessGrant
 	for _, ac := range ma.AccessControl {
-		if ac.GetAddress().Equals(access.GetAddress()) {
-			if err := access.MergeAdd(*NewAccessGrant(ac.GetAddress(), ac.GetAccessList())); err != nil {
+		if ac.Address == grant.Address && sameExpiration(ac.Expiration, grant.Expiration) {
+			if err := grant.MergeAdd(*NewAccessGrant(ac.GetAddress(), ac.GetAccessList())); err != nil {
 				return err
 			}
+			continue
 		}
+		accessList = append(accessList, ac)
 	}
-	// Revoke existing (no errors from this as we have validated above)
-	if err := ma.RevokeAccess(access.GetAddress()); err != nil {
-		return err
-	}
-	// Append the new record
-	ma.AccessControl = append(ma.AccessControl, *NewAccessGrant(access.GetAddress(), access.GetAccessList()))
+	// Append the new record in place of the existing one
+	ma.AccessControl = append(accessList, *grant)
 	return nil
 }
 
+// sameExpiration returns true if both expirations are unset or both are set to the same time.
+func sameExpiration(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
+
 // RevokeAccess removes any AccessGrant for the given address on this marker.
 func (ma *MarkerAccount) RevokeAccess(addr sdk.AccAddress) error {
 	if err := sdk.VerifyAddressFormat(addr); err != nil {
@@ -320,6 +479,21 @@ func (ma *MarkerAccount) RevokeAccess(addr sdk.AccAddress) error {
 	return nil
 }
 
+// RevokeExpiredAccess removes the grants for the given address that have expired at the given block time, returning the
+// grants removed.  Grants held by the address that have not expired are kept.
+func (ma *MarkerAccount) RevokeExpiredAccess(addr sdk.AccAddress, blockTime time.Time) []AccessGrant {
+	var accessList, removed []AccessGrant
+	for _, ac := range ma.AccessControl {
+		if ac.Address == addr.String() && ac.IsExpired(blockTime) {
+			removed = append(removed, ac)
+			continue
+		}
+		accessList = append(accessList, ac)
+	}
+	ma.AccessControl = accessList
+	return removed
+}
+
 // GetAccessList returns the full access list for the marker
 func (ma *MarkerAccount) GetAccessList() []AccessGrant {
 	return ma.AccessControl
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -39,17 +40,17 @@ func TestNewEmptyMarkerValidate(t *testing.T) {
 	require.EqualValues(t, "proposed", m.GetStatus().String())
 	require.True(t, m.HasGovernanceEnabled())
 	require.True(t, m.HasFixedSupply())
-	require.True(t, m.AddressHasAccess(creatorAddr, Access_Mint), "creator was assigned mint permission")
-	require.False(t, m.AddressHasAccess(creatorAddr, Access_Burn), "creator was not assigned burn permission")
-	require.ElementsMatch(t, m.AddressListForPermission(Access_Mint), []sdk.AccAddress{creatorAddr})
+	require.True(t, m.AddressHasAccess(creatorAddr, Access_Mint, time.Time{}), "creator was assigned mint permission")
+	require.False(t, m.AddressHasAccess(creatorAddr, Access_Burn, time.Time{}), "creator was not assigned burn permission")
+	require.ElementsMatch(t, m.AddressListForPermission(Access_Mint, time.Time{}), []sdk.AccAddress{creatorAddr})
 
 	require.NoError(t, m.GrantAccess(NewAccessGrant(creatorAddr, []Access{Access_Burn})))
-	require.True(t, m.AddressHasAccess(creatorAddr, Access_Mint), "creator still has mint permission")
-	require.True(t, m.AddressHasAccess(creatorAddr, Access_Burn), "creator also has burn permission")
+	require.True(t, m.AddressHasAccess(creatorAddr, Access_Mint, time.Time{}), "creator still has mint permission")
+	require.True(t, m.AddressHasAccess(creatorAddr, Access_Burn, time.Time{}), "creator also has burn permission")
 
 	require.Error(t, m.RevokeAccess(sdk.AccAddress([]byte{})), "can't revoke for an empty/invalid address")
 	require.NoError(t, m.RevokeAccess(creatorAddr))
-	require.False(t, m.AddressHasAccess(creatorAddr, Access_Burn), "creator permissions were revoked")
+	require.False(t, m.AddressHasAccess(creatorAddr, Access_Burn, time.Time{}), "creator permissions were revoked")
 	require.NoError(t,
 		m.GrantAccess(NewAccessGrant(creatorAddr, []Access{Access_Mint, Access_Admin})), "permissions restored")
 
@@ -62,11 +63,13 @@ func TestNewEmptyMarkerValidate(t *testing.T) {
 	require.NoError(t, m.SetManager(creatorAddr), "should be able to set manager for proposed status event")
 	require.NoError(t, m.SetStatus(StatusFinalized), "no error expected from setting a valid status")
 
-	require.Error(t, m.SetManager(creatorAddr), "should not be able to set manager for active status event")
+	require.NoError(t, m.SetManager(creatorAddr), "should be able to set manager for finalized status event")
 
 	require.EqualValues(t, m.GetManager(), creatorAddr, "creator address should match manager")
 	require.NoError(t, m.SetStatus(StatusActive), "no error expected from setting a valid status")
 	require.EqualValues(t, m.GetManager(), sdk.AccAddress([]byte{}), "manager should be empty on active status")
+	require.NoError(t, m.SetManager(creatorAddr), "should be able to hand off the manager of an active marker")
+	require.EqualValues(t, m.GetManager(), creatorAddr, "creator address should match manager")
 
 	require.EqualValues(t, m.GetSupply(), sdk.NewCoin("test", sdk.ZeroInt()), "initial supply will be zero")
 	require.NoError(t, m.SetSupply(sdk.NewCoin("test", sdk.OneInt())))
@@ -95,6 +98,8 @@ func TestNewMarkerValidate(t *testing.T) {
 	mAddr := MustGetMarkerAddress("test")
 	fmt.Printf("Marker address: %s", mAddr)
 	baseAcc := authtypes.NewBaseAccount(mAddr, nil, 0, 0)
+	voucher := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	voucherAcc := authtypes.NewBaseAccount(MustGetMarkerAddress(voucher), nil, 0, 0)
 	tests := []struct {
 		name   string
 		acc    authtypes.GenesisAccount
@@ -147,6 +152,61 @@ func TestNewMarkerValidate(t *testing.T) {
 					Permissions: []Access{Access_Mint, Access_Admin, Access_Transfer}}}, StatusActive, MarkerType_Coin),
 			fmt.Errorf("invalid access privileges granted: ACCESS_TRANSFER is not supported for marker type MARKER_TYPE_COIN"),
 		},
+		{
+			"supply exceeds max supply",
+			&MarkerAccount{BaseAccount: baseAcc, Denom: "test", Manager: manager.String(), Supply: sdk.NewInt(10),
+				MaxSupply: sdk.NewInt(5), Status: StatusProposed, MarkerType: MarkerType_Coin},
+			fmt.Errorf("total supply 10 exceeds max supply 5"),
+		},
+		{
+			"negative max supply",
+			&MarkerAccount{BaseAccount: baseAcc, Denom: "test", Manager: manager.String(), Supply: sdk.NewInt(10),
+				MaxSupply: sdk.NewInt(-5), Status: StatusProposed, MarkerType: MarkerType_Coin},
+			fmt.Errorf("max supply must be greater than or equal to zero"),
+		},
+		{
+			"transfer restriction contract on coin marker",
+			&MarkerAccount{BaseAccount: baseAcc, Denom: "test", Manager: manager.String(), Supply: sdk.NewInt(10),
+				Status: StatusProposed, MarkerType: MarkerType_Coin, TransferRestrictionContract: manager.String()},
+			fmt.Errorf("transfer restriction contract is only supported for restricted markers"),
+		},
+		{
+			"invalid transfer restriction contract",
+			&MarkerAccount{BaseAccount: baseAcc, Denom: "test", Manager: manager.String(), Supply: sdk.NewInt(10),
+				Status: StatusProposed, MarkerType: MarkerType_RestrictedCoin, TransferRestrictionContract: "contract"},
+			fmt.Errorf("invalid transfer restriction contract address: decoding bech32 failed: invalid index of 1"),
+		},
+		{
+			"ibc voucher denom on marker that is not ibc enabled",
+			&MarkerAccount{BaseAccount: voucherAcc, Denom: voucher, Manager: manager.String(), Supply: sdk.ZeroInt(),
+				Status: StatusProposed, MarkerType: MarkerType_Coin},
+			fmt.Errorf("ibc voucher denom %s can only be used by ibc enabled markers", voucher),
+		},
+		{
+			"ibc enabled restricted marker",
+			&MarkerAccount{BaseAccount: voucherAcc, Denom: voucher, Manager: manager.String(), Supply: sdk.ZeroInt(),
+				Status: StatusProposed, MarkerType: MarkerType_RestrictedCoin, IbcEnabled: true},
+			fmt.Errorf("ibc enabled markers are only supported for coin markers"),
+		},
+		{
+			"ibc enabled marker with fixed supply",
+			&MarkerAccount{BaseAccount: voucherAcc, Denom: voucher, Manager: manager.String(), Supply: sdk.ZeroInt(),
+				Status: StatusProposed, MarkerType: MarkerType_Coin, SupplyFixed: true, IbcEnabled: true},
+			fmt.Errorf("ibc enabled markers can not have a fixed supply"),
+		},
+		{
+			"ibc enabled marker without voucher denom",
+			&MarkerAccount{BaseAccount: baseAcc, Denom: "test", Manager: manager.String(), Supply: sdk.ZeroInt(),
+				Status: StatusProposed, MarkerType: MarkerType_Coin, IbcEnabled: true},
+			fmt.Errorf("ibc enabled marker denom test is not an ibc voucher denom"),
+		},
+		{
+			"valid ibc enabled marker with zero supply",
+			&MarkerAccount{BaseAccount: voucherAcc, Denom: voucher, Supply: sdk.ZeroInt(),
+				Status: StatusFinalized, MarkerType: MarkerType_Coin, IbcEnabled: true,
+				AccessControl: []AccessGrant{*NewAccessGrant(manager, AccessListByNames("admin"))}},
+			nil,
+		},
 		{
 			"valid marker account",
 			NewMarkerAccount(baseAcc, sdk.NewCoin("test", sdk.OneInt()), manager, nil, StatusProposed, MarkerType_Coin),
@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -10,18 +11,29 @@ import (
 )
 
 const (
-	TypeAddMarkerRequest    = "addmarker"
-	TypeAddAccessRequest    = "addaccess"
-	TypeDeleteAccessRequest = "deleteaccess"
-	TypeFinalizeRequest     = "finalize"
-	TypeActivateRequest     = "activate"
-	TypeCancelRequest       = "cancel"
-	TypeDeleteRequest       = "delete"
-	TypeMintRequest         = "mint"
-	TypeBurnRequest         = "burn"
-	TypeWithdrawRequest     = "withdraw"
-	TypeTransferRequest     = "transfer"
-	TypeSetMetadataRequest  = "setmetadata"
+	TypeAddMarkerRequest                 = "addmarker"
+	TypeAddAccessRequest                 = "addaccess"
+	TypeDeleteAccessRequest              = "deleteaccess"
+	TypeFinalizeRequest                  = "finalize"
+	TypeActivateRequest                  = "activate"
+	TypeCancelRequest                    = "cancel"
+	TypeDeleteRequest                    = "delete"
+	TypeMintRequest                      = "mint"
+	TypeBurnRequest                      = "burn"
+	TypeWithdrawRequest                  = "withdraw"
+	TypeTransferRequest                  = "transfer"
+	TypeSetMetadataRequest               = "setmetadata"
+	TypeFreezeAccountRequest             = "freezeaccount"
+	TypeUnfreezeAccountRequest           = "unfreezeaccount"
+	TypeForceTransferRequest             = "forcetransfer"
+	TypeDistributeRequest                = "distribute"
+	TypeMultiTransferRequest             = "multitransfer"
+	TypeChangeManagerRequest             = "changemanager"
+	TypeScheduleWithdrawRequest          = "schedulewithdraw"
+	TypeCancelScheduledWithdrawRequest   = "cancelscheduledwithdraw"
+	TypeAddFinalizeActivateMarkerRequest = "addfinalizeactivatemarker"
+	TypeBurnFromRequest                  = "burnfrom"
+	TypeSetTransferRestrictionContract   = "settransferrestrictioncontract"
 )
 
 // Compile time interface check.
@@ -37,6 +49,17 @@ var (
 	_ sdk.Msg = &MsgBurnRequest{}
 	_ sdk.Msg = &MsgWithdrawRequest{}
 	_ sdk.Msg = &MsgTransferRequest{}
+	_ sdk.Msg = &MsgFreezeAccountRequest{}
+	_ sdk.Msg = &MsgUnfreezeAccountRequest{}
+	_ sdk.Msg = &MsgForceTransferRequest{}
+	_ sdk.Msg = &MsgDistributeRequest{}
+	_ sdk.Msg = &MsgMultiTransferRequest{}
+	_ sdk.Msg = &MsgChangeManagerRequest{}
+	_ sdk.Msg = &MsgScheduleWithdrawRequest{}
+	_ sdk.Msg = &MsgCancelScheduledWithdrawRequest{}
+	_ sdk.Msg = &MsgAddFinalizeActivateMarkerRequest{}
+	_ sdk.Msg = &MsgBurnFromRequest{}
+	_ sdk.Msg = &MsgSetTransferRestrictionContractRequest{}
 )
 
 // Type returns the message action.
@@ -75,6 +98,40 @@ func (msg MsgTransferRequest) Type() string { return TypeTransferRequest }
 // Type returns the message action.
 func (msg MsgSetDenomMetadataRequest) Type() string { return TypeSetMetadataRequest }
 
+// Type returns the message action.
+func (msg MsgFreezeAccountRequest) Type() string { return TypeFreezeAccountRequest }
+
+// Type returns the message action.
+func (msg MsgUnfreezeAccountRequest) Type() string { return TypeUnfreezeAccountRequest }
+
+// Type returns the message action.
+func (msg MsgForceTransferRequest) Type() string { return TypeForceTransferRequest }
+
+// Type returns the message action.
+func (msg MsgDistributeRequest) Type() string { return TypeDistributeRequest }
+
+// Type returns the message action.
+func (msg MsgMultiTransferRequest) Type() string { return TypeMultiTransferRequest }
+
+// Type returns the message action.
+func (msg MsgChangeManagerRequest) Type() string { return TypeChangeManagerRequest }
+
+// Type returns the message action.
+func (msg MsgScheduleWithdrawRequest) Type() string { return TypeScheduleWithdrawRequest }
+
+// Type returns the message action.
+func (msg MsgCancelScheduledWithdrawRequest) Type() string { return TypeCancelScheduledWithdrawRequest }
+
+// Type returns the message action.
+func (msg MsgAddFinalizeActivateMarkerRequest) Type() string {
+	return TypeAddFinalizeActivateMarkerRequest
+}
+
+// Type returns the message action.
+func (msg MsgSetTransferRestrictionContractRequest) Type() string {
+	return TypeSetTransferRestrictionContract
+}
+
 // NewMsgAddMarkerRequest creates a new marker in a proposed state with a given total supply a denomination
 func NewMsgAddMarkerRequest(
 	denom string, totalSupply sdk.Int, fromAddress sdk.AccAddress, manager sdk.AccAddress, markerType MarkerType, supplyFixed bool, allowGovernanceControl bool, // nolint:interfacer
@@ -112,6 +169,29 @@ func (msg MsgAddMarkerRequest) ValidateBasic() error {
 	if !testCoin.IsValid() {
 		return fmt.Errorf("invalid marker denom/total supply: %w", sdkerrors.ErrInvalidCoins)
 	}
+	if len(msg.RequiredAttributes) > 0 && msg.MarkerType != MarkerType_RestrictedCoin {
+		return fmt.Errorf("required attributes are only supported for restricted markers")
+	}
+	if err := ValidateRequiredAttributes(msg.RequiredAttributes); err != nil {
+		return err
+	}
+	if err := ValidateTransferRestrictionContract(msg.MarkerType, msg.TransferRestrictionContract); err != nil {
+		return err
+	}
+	if err := ValidateIbcEnabled(msg.MarkerType, msg.Amount.Denom, msg.SupplyFixed, msg.IbcEnabled); err != nil {
+		return err
+	}
+	if !msg.MaxSupply.IsNil() && !msg.MaxSupply.IsZero() {
+		if msg.MaxSupply.IsNegative() {
+			return fmt.Errorf("max supply must be greater than or equal to zero")
+		}
+		if msg.Status != StatusProposed {
+			return fmt.Errorf("max supply can only be set on proposed markers")
+		}
+		if msg.Amount.Amount.GT(msg.MaxSupply) {
+			return fmt.Errorf("total supply %s exceeds max supply %s", msg.Amount.Amount, msg.MaxSupply)
+		}
+	}
 
 	return nil
 }
@@ -546,3 +626,518 @@ func (msg MsgSetDenomMetadataRequest) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgFreezeAccountRequest creates a request to freeze an account holding a restricted marker's coin
+func NewMsgFreezeAccountRequest(denom string, admin, address sdk.AccAddress) *MsgFreezeAccountRequest { // nolint:interfacer
+	return &MsgFreezeAccountRequest{
+		Denom:         denom,
+		Administrator: admin.String(),
+		Address:       address.String(),
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgFreezeAccountRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgFreezeAccountRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return err
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Address)
+	return err
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgFreezeAccountRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgFreezeAccountRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgUnfreezeAccountRequest creates a request to unfreeze an account holding a restricted marker's coin
+func NewMsgUnfreezeAccountRequest(denom string, admin, address sdk.AccAddress) *MsgUnfreezeAccountRequest { // nolint:interfacer
+	return &MsgUnfreezeAccountRequest{
+		Denom:         denom,
+		Administrator: admin.String(),
+		Address:       address.String(),
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgUnfreezeAccountRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgUnfreezeAccountRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return err
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Address)
+	return err
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgUnfreezeAccountRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgUnfreezeAccountRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgForceTransferRequest creates a request to move restricted marker coin without the approval of the holder
+func NewMsgForceTransferRequest(
+	admin, fromAddress, toAddress sdk.AccAddress, amount sdk.Coin, // nolint:interfacer
+) *MsgForceTransferRequest {
+	return &MsgForceTransferRequest{
+		Administrator: admin.String(),
+		ToAddress:     toAddress.String(),
+		FromAddress:   fromAddress.String(),
+		Amount:        amount,
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgForceTransferRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgForceTransferRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return err
+	}
+	if !msg.Amount.IsPositive() {
+		return fmt.Errorf("force transfer amount must be positive: %s", msg.Amount)
+	}
+	return msg.Amount.Validate()
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgForceTransferRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgForceTransferRequest) GetSigners() []sdk.AccAddress {
+	adminAddr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{adminAddr}
+}
+
+// NewMsgDistributeRequest creates a request to pay out escrowed coin to the holders of a marker
+func NewMsgDistributeRequest(admin sdk.AccAddress, denom string, amount sdk.Coins) *MsgDistributeRequest { // nolint:interfacer
+	return &MsgDistributeRequest{
+		Denom:         denom,
+		Administrator: admin.String(),
+		Amount:        amount,
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgDistributeRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgDistributeRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return err
+	}
+	if msg.Amount.Empty() {
+		return fmt.Errorf("distribution amount cannot be empty")
+	}
+	if msg.Amount.AmountOf(msg.Denom).IsPositive() {
+		return fmt.Errorf("cannot distribute %s to its own holders", msg.Denom)
+	}
+	return msg.Amount.Validate()
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgDistributeRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgDistributeRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewTransferLeg creates a single transfer of restricted coin for use in a multi transfer request
+func NewTransferLeg(fromAddress, toAddress sdk.AccAddress, amount sdk.Coin) TransferLeg { // nolint:interfacer
+	return TransferLeg{
+		Amount:      amount,
+		FromAddress: fromAddress.String(),
+		ToAddress:   toAddress.String(),
+	}
+}
+
+// NewMsgMultiTransferRequest creates a request to transfer restricted coin between many accounts at once
+func NewMsgMultiTransferRequest(admin sdk.AccAddress, legs []TransferLeg) *MsgMultiTransferRequest { // nolint:interfacer
+	return &MsgMultiTransferRequest{
+		Administrator: admin.String(),
+		Legs:          legs,
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgMultiTransferRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgMultiTransferRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return err
+	}
+	if len(msg.Legs) == 0 {
+		return fmt.Errorf("at least one transfer is required")
+	}
+	for i, leg := range msg.Legs {
+		if _, err := sdk.AccAddressFromBech32(leg.FromAddress); err != nil {
+			return fmt.Errorf("invalid from address in transfer %d: %w", i, err)
+		}
+		if _, err := sdk.AccAddressFromBech32(leg.ToAddress); err != nil {
+			return fmt.Errorf("invalid to address in transfer %d: %w", i, err)
+		}
+		if err := leg.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid amount in transfer %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgMultiTransferRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgMultiTransferRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgChangeManagerRequest creates a request to assign a new manager to a marker
+func NewMsgChangeManagerRequest(denom string, admin, newManager sdk.AccAddress) *MsgChangeManagerRequest { // nolint:interfacer
+	return &MsgChangeManagerRequest{
+		Denom:         denom,
+		Administrator: admin.String(),
+		NewManager:    newManager.String(),
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgChangeManagerRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgChangeManagerRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return err
+	}
+	_, err := sdk.AccAddressFromBech32(msg.NewManager)
+	return err
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgChangeManagerRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgChangeManagerRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgScheduleWithdrawRequest creates a request to withdraw coin from a marker's escrow at a future release time
+func NewMsgScheduleWithdrawRequest(
+	admin sdk.AccAddress, toAddress sdk.AccAddress, denom string, amount sdk.Coins, releaseTime time.Time, // nolint:interfacer
+) *MsgScheduleWithdrawRequest {
+	if toAddress.Empty() {
+		toAddress = admin
+	}
+	return &MsgScheduleWithdrawRequest{
+		Denom:         denom,
+		Administrator: admin.String(),
+		ToAddress:     toAddress.String(),
+		Amount:        amount,
+		ReleaseTime:   releaseTime,
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgScheduleWithdrawRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgScheduleWithdrawRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+		return err
+	}
+	if msg.Amount.Empty() {
+		return fmt.Errorf("scheduled withdrawal amount cannot be empty")
+	}
+	if msg.ReleaseTime.IsZero() {
+		return fmt.Errorf("scheduled withdrawal release time cannot be empty")
+	}
+	return msg.Amount.Validate()
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgScheduleWithdrawRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgScheduleWithdrawRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgCancelScheduledWithdrawRequest creates a request to remove a scheduled withdrawal before it is released
+func NewMsgCancelScheduledWithdrawRequest(denom string, admin sdk.AccAddress, id uint64) *MsgCancelScheduledWithdrawRequest { // nolint:interfacer
+	return &MsgCancelScheduledWithdrawRequest{
+		Denom:         denom,
+		Administrator: admin.String(),
+		Id:            id,
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgCancelScheduledWithdrawRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgCancelScheduledWithdrawRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return err
+	}
+	if msg.Id == 0 {
+		return fmt.Errorf("scheduled withdrawal id must be positive")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgCancelScheduledWithdrawRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgCancelScheduledWithdrawRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgAddFinalizeActivateMarkerRequest creates a request that adds, finalizes, and activates a marker with the
+// given total supply in one step.  The from address manages the marker until it is activated.
+func NewMsgAddFinalizeActivateMarkerRequest(
+	denom string, totalSupply sdk.Int, fromAddress sdk.AccAddress, markerType MarkerType, supplyFixed bool, allowGovernanceControl bool, accessGrants []AccessGrant, // nolint:interfacer
+) *MsgAddFinalizeActivateMarkerRequest {
+	return &MsgAddFinalizeActivateMarkerRequest{
+		Amount:                 sdk.NewCoin(denom, totalSupply),
+		FromAddress:            fromAddress.String(),
+		MarkerType:             markerType,
+		AccessList:             accessGrants,
+		SupplyFixed:            supplyFixed,
+		AllowGovernanceControl: allowGovernanceControl,
+	}
+}
+
+// AddMarkerRequest returns the request that adds the proposed marker before it is finalized and activated.
+func (msg MsgAddFinalizeActivateMarkerRequest) AddMarkerRequest() *MsgAddMarkerRequest {
+	return &MsgAddMarkerRequest{
+		Amount:                      msg.Amount,
+		Manager:                     msg.FromAddress,
+		FromAddress:                 msg.FromAddress,
+		Status:                      StatusProposed,
+		MarkerType:                  msg.MarkerType,
+		AccessList:                  msg.AccessList,
+		SupplyFixed:                 msg.SupplyFixed,
+		AllowGovernanceControl:      msg.AllowGovernanceControl,
+		RequiredAttributes:          msg.RequiredAttributes,
+		MaxSupply:                   msg.MaxSupply,
+		TransferRestrictionContract: msg.TransferRestrictionContract,
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgAddFinalizeActivateMarkerRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgAddFinalizeActivateMarkerRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return fmt.Errorf("invalid from address: %w", err)
+	}
+	if err := msg.AddMarkerRequest().ValidateBasic(); err != nil {
+		return err
+	}
+	if msg.Metadata != nil {
+		if msg.Metadata.Base != msg.Amount.Denom {
+			return fmt.Errorf("denom metadata base %s does not match marker denom %s", msg.Metadata.Base, msg.Amount.Denom)
+		}
+		if err := ValidateDenomMetadataBasic(*msg.Metadata); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgAddFinalizeActivateMarkerRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgAddFinalizeActivateMarkerRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgBurnFromRequest creates a request to burn restricted marker coin from the balance of an account holding it
+func NewMsgBurnFromRequest(admin, fromAddress sdk.AccAddress, amount sdk.Coin) *MsgBurnFromRequest { // nolint:interfacer
+	return &MsgBurnFromRequest{
+		Administrator: admin.String(),
+		FromAddress:   fromAddress.String(),
+		Amount:        amount,
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgBurnFromRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgBurnFromRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return err
+	}
+	if !msg.Amount.IsPositive() {
+		return fmt.Errorf("burn from amount must be positive: %s", msg.Amount)
+	}
+	return msg.Amount.Validate()
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgBurnFromRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgBurnFromRequest) GetSigners() []sdk.AccAddress {
+	adminAddr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{adminAddr}
+}
+
+// NewMsgSetTransferRestrictionContractRequest creates a request to set or clear the transfer restriction contract of a
+// restricted marker
+func NewMsgSetTransferRestrictionContractRequest(denom string, admin sdk.AccAddress, contract string) *MsgSetTransferRestrictionContractRequest { // nolint:interfacer
+	return &MsgSetTransferRestrictionContractRequest{
+		Denom:         denom,
+		Administrator: admin.String(),
+		Contract:      contract,
+	}
+}
+
+// Route returns the name of the module.
+func (msg MsgSetTransferRestrictionContractRequest) Route() string { return ModuleName }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgSetTransferRestrictionContractRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Administrator); err != nil {
+		return err
+	}
+	if len(msg.Contract) > 0 {
+		if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+			return fmt.Errorf("invalid transfer restriction contract address: %w", err)
+		}
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing.
+func (msg MsgSetTransferRestrictionContractRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners indicates that the message must have been signed by the address provided.
+func (msg MsgSetTransferRestrictionContractRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Administrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
@@ -16,6 +16,8 @@ const (
 	DefaultMaxTotalSupply = uint64(100000000000)
 	// DefaultUnrestrictedDenomRegex is a regex that denoms created by normal requests must pass.
 	DefaultUnrestrictedDenomRegex = `[a-zA-Z][a-zA-Z0-9\-\.]{2,64}`
+	// DefaultEnableForceTransfer (true) indicates that forced transfers of restricted marker coin are allowed
+	DefaultEnableForceTransfer = true
 )
 
 var (
@@ -25,6 +27,8 @@ var (
 	ParamStoreKeyMaxTotalSupply = []byte("MaxTotalSupply")
 	// ParamStoreKeyUnrestrictedDenomRegex is the validation regex for validating denoms supplied by users.
 	ParamStoreKeyUnrestrictedDenomRegex = []byte("UnrestrictedDenomRegex")
+	// ParamStoreKeyEnableForceTransfer indicates if forced transfers of restricted marker coin are enabled
+	ParamStoreKeyEnableForceTransfer = []byte("EnableForceTransfer")
 )
 
 // ParamKeyTable for marker module
@@ -37,11 +41,13 @@ func NewParams(
 	maxTotalSupply uint64,
 	enableGovernance bool,
 	unrestrictedDenomRegex string,
+	enableForceTransfer bool,
 ) Params {
 	return Params{
 		EnableGovernance:       enableGovernance,
 		MaxTotalSupply:         maxTotalSupply,
 		UnrestrictedDenomRegex: unrestrictedDenomRegex,
+		EnableForceTransfer:    enableForceTransfer,
 	}
 }
 
@@ -51,6 +57,7 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableGovernance, &p.EnableGovernance, validateEnableGovernance),
 		paramtypes.NewParamSetPair(ParamStoreKeyMaxTotalSupply, &p.MaxTotalSupply, validateIntParam),
 		paramtypes.NewParamSetPair(ParamStoreKeyUnrestrictedDenomRegex, &p.UnrestrictedDenomRegex, validateRegexParam),
+		paramtypes.NewParamSetPair(ParamStoreKeyEnableForceTransfer, &p.EnableForceTransfer, validateEnableForceTransfer),
 	}
 }
 
@@ -60,6 +67,7 @@ func DefaultParams() Params {
 		DefaultMaxTotalSupply,
 		DefaultEnableGovernance,
 		DefaultUnrestrictedDenomRegex,
+		DefaultEnableForceTransfer,
 	)
 }
 
@@ -98,6 +106,9 @@ func (p *Params) Equal(that interface{}) bool {
 	if p.UnrestrictedDenomRegex != that1.UnrestrictedDenomRegex {
 		return false
 	}
+	if p.EnableForceTransfer != that1.EnableForceTransfer {
+		return false
+	}
 	return true
 }
 
@@ -118,6 +129,14 @@ func validateEnableGovernance(i interface{}) error {
 	return nil
 }
 
+func validateEnableForceTransfer(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
 func validateRegexParam(i interface{}) error {
 	exp, ok := i.(string)
 	if !ok {
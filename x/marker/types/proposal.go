@@ -26,6 +26,8 @@ const (
 	ProposalTypeWithdrawEscrow string = "WithdrawEscrow"
 	// ProposalTypeSetDenomMetadata is a proposal to set denom metatdata.
 	ProposalTypeSetDenomMetadata string = "SetDenomMetadata"
+	// ProposalTypeChangeManager is a proposal to assign a new manager to a marker
+	ProposalTypeChangeManager string = "ChangeManager"
 )
 
 var (
@@ -37,6 +39,7 @@ var (
 	_ govtypes.Content = &ChangeStatusProposal{}
 	_ govtypes.Content = &WithdrawEscrowProposal{}
 	_ govtypes.Content = &SetDenomMetadataProposal{}
+	_ govtypes.Content = &ChangeManagerProposal{}
 )
 
 func init() {
@@ -61,6 +64,9 @@ func init() {
 
 	govtypes.RegisterProposalType(ProposalTypeSetDenomMetadata)
 	govtypes.RegisterProposalTypeCodec(SetDenomMetadataProposal{}, "provenance/marker/SetDenomMetadataProposal")
+
+	govtypes.RegisterProposalType(ProposalTypeChangeManager)
+	govtypes.RegisterProposalTypeCodec(ChangeManagerProposal{}, "provenance/marker/ChangeManagerProposal")
 }
 
 // NewAddMarkerProposal creates a new proposal
@@ -108,6 +114,9 @@ func (amp AddMarkerProposal) ValidateBasic() error {
 	if !testCoin.IsValid() {
 		return fmt.Errorf("invalid marker denom/total supply: %w", sdkerrors.ErrInvalidCoins)
 	}
+	if err := ValidateIbcEnabled(amp.MarkerType, amp.Amount.Denom, amp.SupplyFixed, amp.IbcEnabled); err != nil {
+		return err
+	}
 	return govtypes.ValidateAbstract(&amp)
 }
 
@@ -298,3 +307,27 @@ func (sdmdp SetDenomMetadataProposal) String() string {
   Metadata:    %s
 `, sdmdp.Metadata.Base, sdmdp.Title, sdmdp.Description, sdmdp.Metadata.String())
 }
+
+func NewChangeManagerProposal(title, description, denom string, newManager sdk.AccAddress) *ChangeManagerProposal { // nolint:interfacer
+	return &ChangeManagerProposal{title, description, denom, newManager.String()}
+}
+
+// Implements Proposal Interface
+
+func (cmp ChangeManagerProposal) ProposalRoute() string { return RouterKey }
+func (cmp ChangeManagerProposal) ProposalType() string  { return ProposalTypeChangeManager }
+func (cmp ChangeManagerProposal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(cmp.NewManager); err != nil {
+		return fmt.Errorf("new manager address is invalid: %w", err)
+	}
+	return govtypes.ValidateAbstract(&cmp)
+}
+
+func (cmp ChangeManagerProposal) String() string {
+	return fmt.Sprintf(`MarkerAccount Change Manager Proposal:
+  Marker:      %s
+  Title:       %s
+  Description: %s
+  Change Manager To: %s
+`, cmp.Denom, cmp.Title, cmp.Description, cmp.NewManager)
+}
@@ -38,6 +38,9 @@ type AddMarkerProposal struct {
 	AccessList             []AccessGrant                           `protobuf:"bytes,7,rep,name=access_list,json=accessList,proto3" json:"access_list"`
 	SupplyFixed            bool                                    `protobuf:"varint,8,opt,name=supply_fixed,json=supplyFixed,proto3" json:"supply_fixed,omitempty"`
 	AllowGovernanceControl bool                                    `protobuf:"varint,9,opt,name=allow_governance_control,json=allowGovernanceControl,proto3" json:"allow_governance_control,omitempty"`
+	// true if the marker wraps an existing ibc voucher denom (ibc/{hash}), only coin markers without a fixed supply can
+	// be ibc enabled
+	IbcEnabled bool `protobuf:"varint,10,opt,name=ibc_enabled,json=ibcEnabled,proto3" json:"ibc_enabled,omitempty"`
 }
 
 func (m *AddMarkerProposal) Reset()      { *m = AddMarkerProposal{} }
@@ -128,6 +131,13 @@ func (m *AddMarkerProposal) GetAllowGovernanceControl() bool {
 	return false
 }
 
+func (m *AddMarkerProposal) GetIbcEnabled() bool {
+	if m != nil {
+		return m.IbcEnabled
+	}
+	return false
+}
+
 // SupplyIncreaseProposal defines a governance proposal to administer a marker and increase total supply of the marker
 // through minting coin and placing it within the marker or assigning it directly to an account
 type SupplyIncreaseProposal struct {
@@ -579,6 +589,74 @@ func (m *SetDenomMetadataProposal) GetDescription() string {
 	return ""
 }
 
+// ChangeManagerProposal defines a governance proposal to assign a new manager to a marker, including active markers
+type ChangeManagerProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Denom       string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	NewManager  string `protobuf:"bytes,4,opt,name=new_manager,json=newManager,proto3" json:"new_manager,omitempty"`
+}
+
+func (m *ChangeManagerProposal) Reset()      { *m = ChangeManagerProposal{} }
+func (*ChangeManagerProposal) ProtoMessage() {}
+func (*ChangeManagerProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_345320af87f4ec37, []int{8}
+}
+func (m *ChangeManagerProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChangeManagerProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChangeManagerProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChangeManagerProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeManagerProposal.Merge(m, src)
+}
+func (m *ChangeManagerProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChangeManagerProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeManagerProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangeManagerProposal proto.InternalMessageInfo
+
+func (m *ChangeManagerProposal) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *ChangeManagerProposal) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *ChangeManagerProposal) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ChangeManagerProposal) GetNewManager() string {
+	if m != nil {
+		return m.NewManager
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*AddMarkerProposal)(nil), "provenance.marker.v1.AddMarkerProposal")
 	proto.RegisterType((*SupplyIncreaseProposal)(nil), "provenance.marker.v1.SupplyIncreaseProposal")
@@ -588,6 +666,7 @@ func init() {
 	proto.RegisterType((*ChangeStatusProposal)(nil), "provenance.marker.v1.ChangeStatusProposal")
 	proto.RegisterType((*WithdrawEscrowProposal)(nil), "provenance.marker.v1.WithdrawEscrowProposal")
 	proto.RegisterType((*SetDenomMetadataProposal)(nil), "provenance.marker.v1.SetDenomMetadataProposal")
+	proto.RegisterType((*ChangeManagerProposal)(nil), "provenance.marker.v1.ChangeManagerProposal")
 }
 
 func init() {
@@ -595,54 +674,57 @@ func init() {
 }
 
 var fileDescriptor_345320af87f4ec37 = []byte{
-	// 750 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcf, 0x6b, 0x13, 0x4d,
-	0x18, 0xce, 0x7c, 0xf9, 0xd1, 0x64, 0xf2, 0x7d, 0xfd, 0x70, 0x09, 0x75, 0xad, 0x98, 0xa4, 0x45,
-	0x6d, 0x2e, 0xdd, 0x35, 0xf1, 0x22, 0xb9, 0x48, 0xd2, 0x6a, 0x15, 0x2c, 0x94, 0xad, 0x20, 0x78,
-	0x09, 0x93, 0xdd, 0x71, 0xbb, 0x24, 0x3b, 0xb3, 0xcc, 0x4c, 0x92, 0xf6, 0xbf, 0xf0, 0xe8, 0x49,
-	0x7a, 0xf6, 0x26, 0xde, 0x3d, 0xf7, 0x66, 0x8f, 0xe2, 0xa1, 0x4a, 0x8b, 0xe0, 0xbf, 0x20, 0x78,
-	0x90, 0x9d, 0x99, 0xa4, 0x0b, 0x0d, 0xa1, 0x52, 0x2a, 0xf4, 0xb4, 0x33, 0xef, 0xfb, 0xbc, 0x3f,
-	0x9e, 0xd9, 0xe7, 0x9d, 0x81, 0xb7, 0x23, 0x46, 0x87, 0x98, 0x20, 0xe2, 0x62, 0x3b, 0x44, 0xac,
-	0x87, 0x99, 0x3d, 0xac, 0xdb, 0x11, 0xa3, 0x11, 0xe5, 0xa8, 0xcf, 0xad, 0x88, 0x51, 0x41, 0x8d,
-	0xd2, 0x29, 0xca, 0x52, 0x28, 0x6b, 0x58, 0x5f, 0x2c, 0xf9, 0xd4, 0xa7, 0x12, 0x60, 0xc7, 0x2b,
-	0x85, 0x5d, 0x2c, 0xbb, 0x94, 0x87, 0x94, 0xdb, 0x5d, 0x44, 0x7a, 0xf6, 0xb0, 0xde, 0xc5, 0x02,
-	0xd5, 0xe5, 0xe6, 0x8c, 0x9f, 0xe3, 0x89, 0xdf, 0xa5, 0x01, 0xd1, 0xfe, 0xa5, 0xa9, 0x1d, 0xe9,
-	0xaa, 0x0a, 0x72, 0x77, 0x2a, 0x04, 0xb9, 0x2e, 0xe6, 0xdc, 0x67, 0x88, 0x08, 0x85, 0x5b, 0xfe,
-	0x99, 0x86, 0xd7, 0x5a, 0x9e, 0xb7, 0x29, 0x21, 0x5b, 0x9a, 0x93, 0x51, 0x82, 0x59, 0x11, 0x88,
-	0x3e, 0x36, 0x41, 0x15, 0xd4, 0x0a, 0x8e, 0xda, 0x18, 0x55, 0x58, 0xf4, 0x30, 0x77, 0x59, 0x10,
-	0x89, 0x80, 0x12, 0xf3, 0x1f, 0xe9, 0x4b, 0x9a, 0x8c, 0x2e, 0xcc, 0xa1, 0x90, 0x0e, 0x88, 0x30,
-	0xd3, 0x55, 0x50, 0x2b, 0x36, 0x6e, 0x58, 0x8a, 0x89, 0x15, 0x33, 0xb1, 0x34, 0x13, 0x6b, 0x8d,
-	0x06, 0xa4, 0x6d, 0x1f, 0x1c, 0x55, 0x52, 0x5f, 0x8e, 0x2a, 0x2b, 0x7e, 0x20, 0x76, 0x06, 0x5d,
-	0xcb, 0xa5, 0xa1, 0xad, 0x69, 0xab, 0xcf, 0x2a, 0xf7, 0x7a, 0xb6, 0xd8, 0x8b, 0x30, 0x97, 0x01,
-	0x8e, 0xce, 0x6c, 0x98, 0x70, 0x2e, 0x44, 0x04, 0xf9, 0x98, 0x99, 0x19, 0xd9, 0xc1, 0x78, 0x6b,
-	0x34, 0x61, 0x8e, 0x0b, 0x24, 0x06, 0xdc, 0xcc, 0x56, 0x41, 0x6d, 0xbe, 0xb1, 0x6c, 0x4d, 0xfb,
-	0x27, 0x96, 0xe2, 0xba, 0x2d, 0x91, 0x8e, 0x8e, 0x30, 0x5a, 0xb0, 0xa8, 0x10, 0x9d, 0xb8, 0xa4,
-	0x99, 0x93, 0x09, 0xaa, 0xb3, 0x12, 0x3c, 0xdf, 0x8b, 0xb0, 0x03, 0xc3, 0xc9, 0xda, 0x78, 0x02,
-	0x8b, 0xea, 0x7c, 0x3b, 0xfd, 0x80, 0x0b, 0x73, 0xae, 0x9a, 0xae, 0x15, 0x1b, 0x4b, 0xd3, 0x53,
-	0xb4, 0x24, 0x70, 0x23, 0xfe, 0x11, 0xed, 0x4c, 0x7c, 0x12, 0x0e, 0x54, 0xb1, 0xcf, 0x02, 0x2e,
-	0x8c, 0x25, 0xf8, 0x2f, 0x1f, 0x44, 0x51, 0x7f, 0xaf, 0xf3, 0x2a, 0xd8, 0xc5, 0x9e, 0x99, 0xaf,
-	0x82, 0x5a, 0xde, 0x29, 0x2a, 0xdb, 0xe3, 0xd8, 0x64, 0x3c, 0x80, 0x26, 0xea, 0xf7, 0xe9, 0xa8,
-	0xe3, 0xd3, 0x21, 0x66, 0x32, 0x7d, 0xc7, 0xa5, 0x44, 0x30, 0xda, 0x37, 0x0b, 0x12, 0xbe, 0x20,
-	0xfd, 0x1b, 0x13, 0xf7, 0x9a, 0xf2, 0x36, 0xf3, 0x6f, 0xf6, 0x2b, 0xa9, 0x1f, 0xfb, 0x15, 0xb0,
-	0xfc, 0x1d, 0xc0, 0x85, 0x6d, 0x99, 0xf3, 0x29, 0x71, 0x19, 0x46, 0x1c, 0x5f, 0x09, 0x01, 0xdc,
-	0x81, 0xf3, 0x02, 0x31, 0x1f, 0x8b, 0x0e, 0xf2, 0x3c, 0x86, 0x39, 0xd7, 0x3a, 0xf8, 0x4f, 0x59,
-	0x5b, 0xca, 0x98, 0xe0, 0xf9, 0x71, 0xc2, 0x73, 0x1d, 0x5f, 0x1d, 0x9e, 0x09, 0x02, 0x1f, 0x00,
-	0x34, 0xb7, 0x63, 0x66, 0x61, 0x40, 0x02, 0x2e, 0x18, 0x12, 0xf4, 0xe2, 0xb3, 0x5a, 0x82, 0x59,
-	0x0f, 0x13, 0x1a, 0x4a, 0x06, 0x05, 0x47, 0x6d, 0x8c, 0x87, 0x30, 0xa7, 0x84, 0x68, 0x66, 0xfe,
-	0x4c, 0xbf, 0x3a, 0x2c, 0xd1, 0xf5, 0x5b, 0x00, 0x6f, 0x3a, 0x38, 0xa4, 0x43, 0xfc, 0x37, 0x1a,
-	0x5f, 0x81, 0xff, 0x33, 0x59, 0xcc, 0x4b, 0xc8, 0x22, 0x5d, 0x2b, 0x38, 0xf3, 0xda, 0x7c, 0x56,
-	0x17, 0xef, 0x01, 0x2c, 0xad, 0xed, 0x20, 0xe2, 0x63, 0x75, 0x19, 0x5c, 0x52, 0x67, 0x2d, 0x08,
-	0x09, 0x1e, 0x75, 0xf4, 0xd5, 0x94, 0x39, 0xf7, 0xd5, 0x54, 0x20, 0x78, 0xa4, 0x96, 0x89, 0x9e,
-	0x7f, 0x01, 0xb8, 0xf0, 0x22, 0x10, 0x3b, 0x1e, 0x43, 0xa3, 0x47, 0xdc, 0x65, 0x74, 0x74, 0x49,
-	0x5d, 0xbb, 0x13, 0x85, 0x2b, 0x21, 0xcc, 0x50, 0xf8, 0xbd, 0x58, 0x00, 0xef, 0xbe, 0x56, 0x6a,
-	0xe7, 0x54, 0x38, 0x9f, 0x31, 0xca, 0xd9, 0xd9, 0xa3, 0xfc, 0x49, 0x4d, 0xc2, 0x7a, 0xdc, 0xe2,
-	0x26, 0x16, 0xc8, 0x43, 0x02, 0x5d, 0xf8, 0x00, 0x06, 0x30, 0x1f, 0xea, 0x5c, 0x7a, 0x9c, 0x6f,
-	0x9d, 0x92, 0x25, 0xbd, 0x09, 0xd9, 0x71, 0xc1, 0x76, 0x53, 0x8f, 0x74, 0x63, 0x26, 0xe1, 0x5d,
-	0xf5, 0xbe, 0x2b, 0xde, 0xe3, 0x58, 0x67, 0x52, 0xaa, 0x99, 0x89, 0x59, 0xb5, 0xfd, 0x83, 0xe3,
-	0x32, 0x38, 0x3c, 0x2e, 0x83, 0x6f, 0xc7, 0x65, 0xf0, 0xfa, 0xa4, 0x9c, 0x3a, 0x3c, 0x29, 0xa7,
-	0x3e, 0x9f, 0x94, 0x53, 0xf0, 0x7a, 0x40, 0xa7, 0xaa, 0x64, 0x0b, 0xbc, 0x4c, 0x16, 0x3e, 0x85,
-	0xac, 0x06, 0x34, 0xb1, 0xb3, 0x77, 0xc7, 0x0f, 0xbf, 0xec, 0xa0, 0x9b, 0x93, 0x0f, 0xfe, 0xfd,
-	0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x41, 0xe2, 0xdb, 0x22, 0xcf, 0x08, 0x00, 0x00,
+	// 795 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xc1, 0x6f, 0xfb, 0x34,
+	0x14, 0xae, 0x59, 0xdb, 0x5f, 0xeb, 0xc0, 0x10, 0x51, 0x19, 0xe1, 0x87, 0x68, 0xbb, 0x0a, 0x58,
+	0x2f, 0x4b, 0x68, 0xb9, 0xa0, 0x5e, 0x50, 0xbb, 0x8d, 0x81, 0xc4, 0xa4, 0x29, 0x43, 0x42, 0xe2,
+	0x12, 0x39, 0x89, 0xc9, 0xac, 0x26, 0x76, 0x64, 0xbb, 0xed, 0xf6, 0x5f, 0xec, 0x88, 0x38, 0xa0,
+	0x9d, 0xb9, 0x21, 0xee, 0x9c, 0x77, 0x63, 0x47, 0xc4, 0x61, 0xa0, 0x4d, 0x48, 0xfc, 0x11, 0x1c,
+	0x50, 0xec, 0xb4, 0x0d, 0x5a, 0x55, 0x0d, 0x4d, 0x43, 0xda, 0xa9, 0xf1, 0x7b, 0x9f, 0xdf, 0x7b,
+	0xdf, 0xf3, 0xf7, 0x5c, 0xc3, 0xf7, 0x52, 0xce, 0xa6, 0x98, 0x22, 0x1a, 0x60, 0x27, 0x41, 0x7c,
+	0x8c, 0xb9, 0x33, 0xed, 0x39, 0x29, 0x67, 0x29, 0x13, 0x28, 0x16, 0x76, 0xca, 0x99, 0x64, 0x66,
+	0x63, 0x89, 0xb2, 0x35, 0xca, 0x9e, 0xf6, 0x5e, 0x36, 0x22, 0x16, 0x31, 0x05, 0x70, 0xb2, 0x2f,
+	0x8d, 0x7d, 0xd9, 0x0c, 0x98, 0x48, 0x98, 0x70, 0x7c, 0x44, 0xc7, 0xce, 0xb4, 0xe7, 0x63, 0x89,
+	0x7a, 0x6a, 0x71, 0xcf, 0x2f, 0xf0, 0xc2, 0x1f, 0x30, 0x42, 0x73, 0xff, 0xf6, 0xca, 0x8a, 0xf2,
+	0xac, 0x1a, 0xf2, 0xc1, 0x4a, 0x08, 0x0a, 0x02, 0x2c, 0x44, 0xc4, 0x11, 0x95, 0x1a, 0xd7, 0xf9,
+	0xae, 0x0c, 0xdf, 0x18, 0x86, 0xe1, 0x91, 0x82, 0x1c, 0xe7, 0x9c, 0xcc, 0x06, 0xac, 0x48, 0x22,
+	0x63, 0x6c, 0x81, 0x36, 0xe8, 0xd6, 0x5d, 0xbd, 0x30, 0xdb, 0xd0, 0x08, 0xb1, 0x08, 0x38, 0x49,
+	0x25, 0x61, 0xd4, 0x7a, 0x45, 0xf9, 0x8a, 0x26, 0xd3, 0x87, 0x55, 0x94, 0xb0, 0x09, 0x95, 0xd6,
+	0x46, 0x1b, 0x74, 0x8d, 0xfe, 0xdb, 0xb6, 0x66, 0x62, 0x67, 0x4c, 0xec, 0x9c, 0x89, 0xbd, 0xc7,
+	0x08, 0x1d, 0x39, 0x57, 0x37, 0xad, 0xd2, 0x6f, 0x37, 0xad, 0x9d, 0x88, 0xc8, 0xd3, 0x89, 0x6f,
+	0x07, 0x2c, 0x71, 0x72, 0xda, 0xfa, 0x67, 0x57, 0x84, 0x63, 0x47, 0x9e, 0xa7, 0x58, 0xa8, 0x0d,
+	0x6e, 0x1e, 0xd9, 0xb4, 0xe0, 0x8b, 0x04, 0x51, 0x14, 0x61, 0x6e, 0x95, 0x55, 0x05, 0xf3, 0xa5,
+	0x39, 0x80, 0x55, 0x21, 0x91, 0x9c, 0x08, 0xab, 0xd2, 0x06, 0xdd, 0xcd, 0x7e, 0xc7, 0x5e, 0x75,
+	0x26, 0xb6, 0xe6, 0x7a, 0xa2, 0x90, 0x6e, 0xbe, 0xc3, 0x1c, 0x42, 0x43, 0x23, 0xbc, 0x2c, 0xa5,
+	0x55, 0x55, 0x01, 0xda, 0xeb, 0x02, 0x7c, 0x79, 0x9e, 0x62, 0x17, 0x26, 0x8b, 0x6f, 0xf3, 0x33,
+	0x68, 0xe8, 0xfe, 0x7a, 0x31, 0x11, 0xd2, 0x7a, 0xd1, 0xde, 0xe8, 0x1a, 0xfd, 0xed, 0xd5, 0x21,
+	0x86, 0x0a, 0x78, 0x98, 0x1d, 0xc4, 0xa8, 0x9c, 0x75, 0xc2, 0x85, 0x7a, 0xef, 0x17, 0x44, 0x48,
+	0x73, 0x1b, 0xbe, 0x2a, 0x26, 0x69, 0x1a, 0x9f, 0x7b, 0xdf, 0x90, 0x33, 0x1c, 0x5a, 0xb5, 0x36,
+	0xe8, 0xd6, 0x5c, 0x43, 0xdb, 0x3e, 0xcd, 0x4c, 0xe6, 0xc7, 0xd0, 0x42, 0x71, 0xcc, 0x66, 0x5e,
+	0xc4, 0xa6, 0x98, 0xab, 0xf0, 0x5e, 0xc0, 0xa8, 0xe4, 0x2c, 0xb6, 0xea, 0x0a, 0xbe, 0xa5, 0xfc,
+	0x87, 0x0b, 0xf7, 0x9e, 0xf6, 0x9a, 0x2d, 0x68, 0x10, 0x3f, 0xf0, 0x30, 0x45, 0x7e, 0x8c, 0x43,
+	0x0b, 0x2a, 0x30, 0x24, 0x7e, 0x70, 0xa0, 0x2d, 0x83, 0xda, 0xb7, 0x97, 0xad, 0xd2, 0x5f, 0x97,
+	0x2d, 0xd0, 0xf9, 0x13, 0xc0, 0xad, 0x13, 0x95, 0xf4, 0x73, 0x1a, 0x70, 0x8c, 0x04, 0x7e, 0x16,
+	0x0a, 0x79, 0x1f, 0x6e, 0x4a, 0xc4, 0x23, 0x2c, 0x3d, 0x14, 0x86, 0x1c, 0x0b, 0x91, 0x0b, 0xe5,
+	0x35, 0x6d, 0x1d, 0x6a, 0x63, 0x81, 0xe7, 0xcf, 0x0b, 0x9e, 0xfb, 0xf8, 0xf9, 0xf0, 0x2c, 0x10,
+	0xf8, 0x09, 0x40, 0xeb, 0x24, 0x63, 0x96, 0x10, 0x4a, 0x84, 0xe4, 0x48, 0xb2, 0xc7, 0x0f, 0x73,
+	0x03, 0x56, 0x42, 0x4c, 0x59, 0xa2, 0x18, 0xd4, 0x5d, 0xbd, 0x30, 0x3f, 0x81, 0x55, 0xad, 0x54,
+	0xab, 0xfc, 0xdf, 0x04, 0x9e, 0x6f, 0x2b, 0x54, 0xfd, 0x3d, 0x80, 0xef, 0xb8, 0x38, 0x61, 0x53,
+	0xfc, 0x7f, 0x14, 0xbe, 0x03, 0x5f, 0xe7, 0x2a, 0x59, 0x58, 0x90, 0xc5, 0x46, 0xb7, 0xee, 0x6e,
+	0xe6, 0xe6, 0xfb, 0xba, 0xf8, 0x11, 0xc0, 0xc6, 0xde, 0x29, 0xa2, 0x11, 0xd6, 0xb7, 0xc5, 0x13,
+	0x55, 0x36, 0x84, 0x90, 0xe2, 0x99, 0x97, 0xdf, 0x5d, 0xe5, 0x07, 0xdf, 0x5d, 0x75, 0x8a, 0x67,
+	0xfa, 0xb3, 0x50, 0xf3, 0xdf, 0x00, 0x6e, 0x7d, 0x45, 0xe4, 0x69, 0xc8, 0xd1, 0xec, 0x40, 0x04,
+	0x9c, 0xcd, 0x9e, 0xa8, 0xea, 0x60, 0xa1, 0x70, 0x2d, 0x84, 0x35, 0x0a, 0xff, 0x30, 0x13, 0xc0,
+	0x0f, 0xbf, 0xb7, 0xba, 0x0f, 0x54, 0xb8, 0x58, 0x33, 0xca, 0x95, 0xf5, 0xa3, 0xfc, 0x8b, 0x9e,
+	0x84, 0xfd, 0xac, 0xc4, 0x23, 0x2c, 0x51, 0x88, 0x24, 0x7a, 0x74, 0x03, 0x26, 0xb0, 0x96, 0xe4,
+	0xb1, 0xf2, 0x71, 0x7e, 0x77, 0x49, 0x96, 0x8e, 0x17, 0x64, 0xe7, 0x09, 0x47, 0x83, 0x7c, 0xa4,
+	0xfb, 0x6b, 0x09, 0x9f, 0xe9, 0x07, 0x80, 0xe6, 0x3d, 0xdf, 0xeb, 0x2e, 0x52, 0x0d, 0xca, 0x19,
+	0xab, 0xce, 0x05, 0x80, 0x6f, 0x6a, 0x11, 0x1e, 0xe9, 0xff, 0xb9, 0x27, 0x3a, 0xcf, 0x16, 0x34,
+	0x32, 0x15, 0xfe, 0xfb, 0xbf, 0x35, 0x13, 0x66, 0x9e, 0x76, 0xd9, 0xe4, 0x51, 0x74, 0x75, 0xdb,
+	0x04, 0xd7, 0xb7, 0x4d, 0xf0, 0xc7, 0x6d, 0x13, 0x5c, 0xdc, 0x35, 0x4b, 0xd7, 0x77, 0xcd, 0xd2,
+	0xaf, 0x77, 0xcd, 0x12, 0x7c, 0x8b, 0xb0, 0x95, 0xc2, 0x3d, 0x06, 0x5f, 0x17, 0x7b, 0xb1, 0x84,
+	0xec, 0x12, 0x56, 0x58, 0x39, 0x67, 0xf3, 0xc7, 0x8a, 0x6a, 0x8a, 0x5f, 0x55, 0x8f, 0x94, 0x8f,
+	0xfe, 0x19, 0x00, 0x99, 0xd2, 0x76, 0x2c, 0x83, 0x09, 0x00, 0x00,
 }
 
 func (this *AddMarkerProposal) Equal(that interface{}) bool {
@@ -696,6 +778,9 @@ func (this *AddMarkerProposal) Equal(that interface{}) bool {
 	if this.AllowGovernanceControl != that1.AllowGovernanceControl {
 		return false
 	}
+	if this.IbcEnabled != that1.IbcEnabled {
+		return false
+	}
 	return true
 }
 func (this *SupplyIncreaseProposal) Equal(that interface{}) bool {
@@ -911,6 +996,39 @@ func (this *WithdrawEscrowProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ChangeManagerProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ChangeManagerProposal)
+	if !ok {
+		that2, ok := that.(ChangeManagerProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.NewManager != that1.NewManager {
+		return false
+	}
+	return true
+}
 func (m *AddMarkerProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -931,6 +1049,16 @@ func (m *AddMarkerProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IbcEnabled {
+		i--
+		if m.IbcEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.AllowGovernanceControl {
 		i--
 		if m.AllowGovernanceControl {
@@ -1382,6 +1510,57 @@ func (m *SetDenomMetadataProposal) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *ChangeManagerProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeManagerProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangeManagerProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewManager) > 0 {
+		i -= len(m.NewManager)
+		copy(dAtA[i:], m.NewManager)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.NewManager)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProposals(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintProposals(dAtA []byte, offset int, v uint64) int {
 	offset -= sovProposals(v)
 	base := offset
@@ -1431,6 +1610,9 @@ func (m *AddMarkerProposal) Size() (n int) {
 	if m.AllowGovernanceControl {
 		n += 2
 	}
+	if m.IbcEnabled {
+		n += 2
+	}
 	return n
 }
 
@@ -1604,6 +1786,31 @@ func (m *SetDenomMetadataProposal) Size() (n int) {
 	return n
 }
 
+func (m *ChangeManagerProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	l = len(m.NewManager)
+	if l > 0 {
+		n += 1 + l + sovProposals(uint64(l))
+	}
+	return n
+}
+
 func sovProposals(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1880,6 +2087,26 @@ func (m *AddMarkerProposal) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowGovernanceControl = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IbcEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProposals(dAtA[iNdEx:])
@@ -3109,6 +3336,184 @@ func (m *SetDenomMetadataProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ChangeManagerProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposals
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeManagerProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeManagerProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewManager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposals
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposals
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewManager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposals(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposals
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipProposals(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -197,3 +197,24 @@ func TestProposalTypeSetDenomMetadataProposal_Format(t *testing.T) {
   Metadata:    %s
 `, m.Metadata.String()), m.String())
 }
+
+func TestProposalTypeChangeManager_Format(t *testing.T) {
+	manager := MustGetMarkerAddress("manager")
+	m := NewChangeManagerProposal("title", "description", "test", manager)
+	require.NotNil(t, m)
+
+	require.Equal(t, RouterKey, m.ProposalRoute())
+	require.Equal(t, ProposalTypeChangeManager, m.ProposalType())
+
+	err := m.ValidateBasic()
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf(`MarkerAccount Change Manager Proposal:
+  Marker:      test
+  Title:       title
+  Description: description
+  Change Manager To: %s
+`, manager), m.String())
+
+	m.NewManager = "invalid"
+	require.Error(t, m.ValidateBasic())
+}
@@ -132,29 +132,28 @@ func init() {
 func init() { proto.RegisterFile("provenance/marker/v1/si.proto", fileDescriptor_22bfd0db7139ab62) }
 
 var fileDescriptor_22bfd0db7139ab62 = []byte{
-	// 371 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2d, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd2, 0x2f, 0x33,
-	0xd4, 0x2f, 0xce, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x41, 0x48, 0xeb, 0x41, 0xa4,
-	0xf5, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x0a, 0xf4, 0x41, 0x2c, 0x88, 0x5a,
-	0xad, 0x69, 0x2c, 0x5c, 0x1c, 0xc1, 0x9e, 0x01, 0x45, 0xa9, 0x69, 0x99, 0x15, 0x42, 0x42, 0x5c,
-	0x7c, 0xc1, 0x9e, 0xf1, 0x01, 0x41, 0xae, 0x6e, 0x9e, 0x11, 0xf1, 0x7e, 0xfe, 0x7e, 0xae, 0x02,
-	0x0c, 0xa8, 0x62, 0x2e, 0xae, 0xde, 0x8e, 0x02, 0x8c, 0x42, 0xc2, 0x5c, 0xfc, 0x08, 0x31, 0x0f,
-	0x57, 0xe7, 0x10, 0x7f, 0x01, 0x26, 0x54, 0x85, 0xde, 0x9e, 0x3e, 0xfe, 0x02, 0xcc, 0xa8, 0x62,
-	0xbe, 0xae, 0xee, 0x8e, 0x02, 0x6c, 0xa8, 0x62, 0xee, 0x9e, 0xee, 0x8e, 0x02, 0x9c, 0xa8, 0x62,
-	0x21, 0xae, 0x41, 0x8e, 0x02, 0x3c, 0xa8, 0x62, 0x01, 0xae, 0x21, 0x8e, 0x02, 0xfc, 0x42, 0x82,
-	0x5c, 0xbc, 0x08, 0x31, 0xd7, 0x08, 0x47, 0x01, 0x21, 0x54, 0xb7, 0x44, 0xb9, 0x86, 0x84, 0x38,
-	0x0a, 0x88, 0xa2, 0x0a, 0x46, 0xfa, 0x83, 0x04, 0x25, 0x84, 0xa4, 0x51, 0x7d, 0xe2, 0xec, 0x29,
-	0xf0, 0x1f, 0x06, 0x18, 0x85, 0x64, 0x90, 0x75, 0x38, 0xbb, 0xfa, 0x85, 0x78, 0x0a, 0xfc, 0xc3,
-	0x21, 0xeb, 0xeb, 0xe9, 0xe3, 0xe3, 0x29, 0xf0, 0x17, 0xa7, 0xac, 0x73, 0x90, 0xbf, 0xc0, 0x2f,
-	0x84, 0x2c, 0x8a, 0xb5, 0x7e, 0x8e, 0x7e, 0xfe, 0x02, 0xdf, 0x71, 0x48, 0x06, 0x78, 0x3a, 0xfb,
-	0x0b, 0x7c, 0xc1, 0x61, 0xae, 0x9b, 0xab, 0x6f, 0x88, 0xbf, 0xc0, 0x47, 0x1c, 0x5a, 0x1d, 0x43,
-	0x42, 0xfc, 0x05, 0xde, 0xe1, 0xd0, 0x1a, 0xe5, 0x1a, 0x10, 0xe2, 0x2f, 0xf0, 0x1a, 0x87, 0x6c,
-	0xa4, 0x3f, 0x28, 0xfe, 0x5e, 0xc0, 0x65, 0xa5, 0x58, 0x3a, 0x16, 0xcb, 0x31, 0x38, 0xa5, 0x9f,
-	0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31,
-	0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x03, 0x97, 0x78, 0x26, 0x38, 0xf1, 0x60, 0xa4,
-	0xb0, 0x00, 0xc6, 0x28, 0xa3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d,
-	0x84, 0x12, 0xdd, 0xcc, 0x7c, 0x24, 0x9e, 0x7e, 0x05, 0x2c, 0xcd, 0x96, 0x54, 0x16, 0xa4, 0x16,
-	0x27, 0xb1, 0x81, 0x13, 0xa2, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x46, 0x91, 0x1a, 0xfe, 0xd5,
-	0x02, 0x00, 0x00,
+	// 367 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4f, 0x4b, 0x3a, 0x41,
+	0x18, 0xc7, 0x77, 0x7f, 0x3f, 0x91, 0x5a, 0x2a, 0x9f, 0x26, 0xa3, 0xb0, 0xda, 0x17, 0x10, 0xb4,
+	0x8b, 0xf5, 0x0a, 0xa6, 0x6d, 0xb4, 0x41, 0xdd, 0x59, 0x74, 0x0e, 0xea, 0x45, 0x54, 0xb6, 0x6d,
+	0x09, 0x1d, 0x59, 0x4d, 0xec, 0x1d, 0x74, 0xec, 0xd4, 0x1b, 0xe8, 0xcd, 0x74, 0xf4, 0xd8, 0x31,
+	0xf4, 0x12, 0x04, 0x41, 0x10, 0x04, 0x41, 0x7f, 0x50, 0xb1, 0x75, 0xa0, 0x9d, 0xd3, 0xcc, 0xe7,
+	0xf3, 0x7d, 0xe6, 0x0f, 0xf3, 0x68, 0x7b, 0x9d, 0x40, 0xf4, 0xdd, 0x76, 0xbd, 0xdd, 0x74, 0xcd,
+	0x56, 0x3d, 0xb8, 0x70, 0x03, 0xb3, 0x9f, 0x36, 0xbb, 0xbe, 0xd1, 0x09, 0x44, 0x4f, 0xa0, 0x64,
+	0xa8, 0x8d, 0x99, 0x36, 0xfa, 0xe9, 0x54, 0xd2, 0x13, 0x9e, 0x98, 0x06, 0xcc, 0xc9, 0x6c, 0x96,
+	0xdd, 0xbf, 0x8d, 0x69, 0x4b, 0x25, 0xea, 0x04, 0xee, 0x99, 0x3f, 0x40, 0x48, 0x5b, 0x2b, 0xd1,
+	0x9a, 0x53, 0x24, 0x19, 0x5a, 0xae, 0xd9, 0xcc, 0x26, 0xa0, 0xc8, 0xec, 0x84, 0xe4, 0x30, 0xa8,
+	0x68, 0x43, 0x4b, 0x84, 0xec, 0x94, 0x58, 0x9c, 0xc1, 0x3f, 0x39, 0x98, 0xa3, 0x79, 0x06, 0xff,
+	0x65, 0x56, 0x20, 0x59, 0x0c, 0x71, 0x99, 0x65, 0x69, 0x16, 0xc3, 0xb2, 0xcc, 0x38, 0x29, 0x62,
+	0x58, 0x91, 0x99, 0x43, 0x38, 0x86, 0x04, 0x5a, 0xd7, 0x56, 0x43, 0x46, 0xca, 0x18, 0x90, 0x7c,
+	0x97, 0x2a, 0xe1, 0x1c, 0xc3, 0xa6, 0x0c, 0x2b, 0x6c, 0x02, 0xb7, 0xd1, 0x8e, 0xfc, 0x12, 0x8b,
+	0xc2, 0xf7, 0x7c, 0xa8, 0x68, 0x77, 0xb1, 0xc2, 0x22, 0x36, 0xa7, 0xf0, 0x15, 0x61, 0x0b, 0x34,
+	0x9f, 0xa7, 0xf0, 0x19, 0x69, 0xad, 0x22, 0x83, 0x8f, 0xd0, 0x4a, 0xc7, 0xda, 0xd8, 0x66, 0xf0,
+	0x1e, 0x21, 0x1d, 0x6a, 0x31, 0x78, 0x8b, 0xd8, 0x37, 0x43, 0x0a, 0x9c, 0xc1, 0x6b, 0x44, 0x29,
+	0xe6, 0x9c, 0xc1, 0x4b, 0x44, 0x69, 0x95, 0x38, 0x9c, 0xc1, 0x73, 0x84, 0xad, 0xb0, 0xc9, 0xff,
+	0x3d, 0xfd, 0xda, 0x54, 0xec, 0xfa, 0x4e, 0x57, 0x8e, 0xbd, 0xfb, 0x91, 0xae, 0x0e, 0x47, 0xba,
+	0xfa, 0x38, 0xd2, 0xd5, 0x9b, 0xb1, 0xae, 0x0c, 0xc7, 0xba, 0xf2, 0x30, 0xd6, 0x15, 0x6d, 0xcb,
+	0x17, 0xc6, 0x5f, 0x1d, 0xe6, 0xa8, 0xd5, 0x43, 0xcf, 0xef, 0x9d, 0x5f, 0x36, 0x8c, 0xa6, 0x68,
+	0x99, 0x61, 0xe4, 0xc0, 0x17, 0x0b, 0x2b, 0x73, 0x30, 0xef, 0xd9, 0xde, 0x55, 0xc7, 0xed, 0x36,
+	0xe2, 0xd3, 0x46, 0x3c, 0xfa, 0x19, 0x00, 0x46, 0x91, 0x1a, 0xfe, 0xd5, 0x02, 0x00, 0x00,
 }
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewMarkerSupplyAdjustment creates a record of an automatic adjustment of a marker's total supply to its required supply.
+func NewMarkerSupplyAdjustment(
+	denom string, previousSupply, requiredSupply sdk.Int, height int64, blockTime time.Time,
+) MarkerSupplyAdjustment {
+	return MarkerSupplyAdjustment{
+		Denom:          denom,
+		PreviousSupply: previousSupply,
+		RequiredSupply: requiredSupply,
+		Height:         height,
+		Time:           blockTime,
+	}
+}
+
+// Validate performs basic sanity checks over the supply adjustment record.
+func (a MarkerSupplyAdjustment) Validate() error {
+	if err := sdk.ValidateDenom(a.Denom); err != nil {
+		return err
+	}
+	if a.PreviousSupply.IsNil() || a.PreviousSupply.IsNegative() {
+		return fmt.Errorf("supply adjustment for %s must have a non-negative previous supply", a.Denom)
+	}
+	if a.RequiredSupply.IsNil() || a.RequiredSupply.IsNegative() {
+		return fmt.Errorf("supply adjustment for %s must have a non-negative required supply", a.Denom)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxWithdrawSchedulesPerBlock is the maximum number of scheduled withdrawals released in one end block, any others
+// that are due are released in following blocks.
+const MaxWithdrawSchedulesPerBlock = 100
+
+// NewMarkerWithdrawSchedule creates a withdrawal of the given amount from a marker's escrow to be released at the
+// given time.
+func NewMarkerWithdrawSchedule(
+	id uint64, denom string, admin sdk.AccAddress, to sdk.AccAddress, amount sdk.Coins, releaseTime time.Time, // nolint:interfacer
+) MarkerWithdrawSchedule {
+	return MarkerWithdrawSchedule{
+		Id:            id,
+		Denom:         denom,
+		Administrator: admin.String(),
+		ToAddress:     to.String(),
+		Amount:        amount,
+		ReleaseTime:   releaseTime,
+	}
+}
+
+// Validate performs basic sanity checks over the scheduled withdrawal.
+func (s MarkerWithdrawSchedule) Validate() error {
+	if err := sdk.ValidateDenom(s.Denom); err != nil {
+		return err
+	}
+	if s.Id == 0 {
+		return fmt.Errorf("scheduled withdrawal from %s must have a positive id", s.Denom)
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Administrator); err != nil {
+		return fmt.Errorf("invalid scheduled withdrawal administrator for %s: %w", s.Denom, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(s.ToAddress); err != nil {
+		return fmt.Errorf("invalid scheduled withdrawal recipient for %s: %w", s.Denom, err)
+	}
+	if err := s.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid scheduled withdrawal amount for %s: %w", s.Denom, err)
+	}
+	if s.Amount.IsZero() {
+		return fmt.Errorf("scheduled withdrawal from %s must have a positive amount", s.Denom)
+	}
+	if s.ReleaseTime.IsZero() {
+		return fmt.Errorf("scheduled withdrawal from %s must have a release time", s.Denom)
+	}
+	return nil
+}
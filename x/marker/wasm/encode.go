@@ -5,10 +5,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/provenance-io/provenance/internal/provwasm"
 	"github.com/provenance-io/provenance/x/marker/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // MarkerMsgParams are params for encoding []sdk.Msg types from the marker module.
@@ -36,6 +40,74 @@ type MarkerMsgParams struct {
 	Withdraw *WithdrawParams `json:"withdraw_coins,omitempty"`
 	// Params for encoding a MsgTransferRequest
 	Transfer *TransferParams `json:"transfer_marker_coins,omitempty"`
+	// Params for encoding a MsgBurnFromRequest
+	BurnFrom *BurnFromParams `json:"burn_marker_supply_from,omitempty"`
+	// Params for encoding a MsgAddFinalizeActivateMarkerRequest
+	CreateFinalizeActivate *CreateFinalizeActivateMarkerParams `json:"create_finalize_activate_marker,omitempty"`
+
+	// The following messages require version 2 of the marker bindings, see BindingsV2.
+
+	// Params for encoding a MsgSetDenomMetadataRequest
+	SetDenomMetadata *SetDenomMetadataParams `json:"set_denom_metadata,omitempty"`
+	// Params for encoding a MsgFreezeAccountRequest
+	Freeze *FreezeAccountParams `json:"freeze_account,omitempty"`
+	// Params for encoding a MsgUnfreezeAccountRequest
+	Unfreeze *UnfreezeAccountParams `json:"unfreeze_account,omitempty"`
+	// Params for encoding a MsgForceTransferRequest
+	ForceTransfer *ForceTransferParams `json:"force_transfer_marker_coins,omitempty"`
+	// Params for encoding a MsgDistributeRequest
+	Distribute *DistributeParams `json:"distribute_escrow,omitempty"`
+	// Params for encoding a MsgMultiTransferRequest
+	MultiTransfer *MultiTransferParams `json:"multi_transfer_marker_coins,omitempty"`
+	// Params for encoding a MsgChangeManagerRequest
+	ChangeManager *ChangeManagerParams `json:"change_marker_manager,omitempty"`
+	// Params for encoding a MsgScheduleWithdrawRequest
+	ScheduleWithdraw *ScheduleWithdrawParams `json:"schedule_withdraw_coins,omitempty"`
+	// Params for encoding a MsgCancelScheduledWithdrawRequest
+	CancelScheduledWithdraw *CancelScheduledWithdrawParams `json:"cancel_scheduled_withdraw,omitempty"`
+	// Params for encoding an authz MsgGrant of a MarkerTransferAuthorization
+	GrantTransferAuthorization *GrantTransferAuthorizationParams `json:"grant_marker_transfer_authorization,omitempty"`
+	// Params for encoding an authz MsgRevoke of a MarkerTransferAuthorization
+	RevokeTransferAuthorization *RevokeTransferAuthorizationParams `json:"revoke_marker_transfer_authorization,omitempty"`
+}
+
+// BindingsV2 is the version of the marker bindings that added the messages and queries covering the rest of the
+// marker module.  Contracts built against earlier bindings keep the original messages, queries, and response types.
+var BindingsV2 = provwasm.MustParseVersion("2.0.0")
+
+// encoder creates the marker module messages for a set of params.
+type encoder interface {
+	Encode(contract sdk.AccAddress) ([]sdk.Msg, error)
+}
+
+// v2Encoder returns the encoder and name for params that require version 2 of the bindings, nil if none are set.
+func (params *MarkerMsgParams) v2Encoder() (encoder, string) {
+	switch {
+	case params.SetDenomMetadata != nil:
+		return params.SetDenomMetadata, "set_denom_metadata"
+	case params.Freeze != nil:
+		return params.Freeze, "freeze_account"
+	case params.Unfreeze != nil:
+		return params.Unfreeze, "unfreeze_account"
+	case params.ForceTransfer != nil:
+		return params.ForceTransfer, "force_transfer_marker_coins"
+	case params.Distribute != nil:
+		return params.Distribute, "distribute_escrow"
+	case params.MultiTransfer != nil:
+		return params.MultiTransfer, "multi_transfer_marker_coins"
+	case params.ChangeManager != nil:
+		return params.ChangeManager, "change_marker_manager"
+	case params.ScheduleWithdraw != nil:
+		return params.ScheduleWithdraw, "schedule_withdraw_coins"
+	case params.CancelScheduledWithdraw != nil:
+		return params.CancelScheduledWithdraw, "cancel_scheduled_withdraw"
+	case params.GrantTransferAuthorization != nil:
+		return params.GrantTransferAuthorization, "grant_marker_transfer_authorization"
+	case params.RevokeTransferAuthorization != nil:
+		return params.RevokeTransferAuthorization, "revoke_marker_transfer_authorization"
+	default:
+		return nil, ""
+	}
 }
 
 // CreateMarkerParams are params for encoding a MsgAddMarkerRequest.
@@ -46,6 +118,30 @@ type CreateMarkerParams struct {
 	Type string `json:"marker_type,omitempty"`
 }
 
+// CreateFinalizeActivateMarkerParams are params for encoding a MsgAddFinalizeActivateMarkerRequest.
+type CreateFinalizeActivateMarkerParams struct {
+	// The marker denomination and amount
+	Coin sdk.Coin `json:"coin"`
+	// The marker type
+	Type string `json:"marker_type,omitempty"`
+	// The access granted on the marker
+	Access []AccessGrantParams `json:"access,omitempty"`
+	// Whether the supply of the marker is fixed
+	SupplyFixed bool `json:"supply_fixed,omitempty"`
+	// Whether the marker allows governance control
+	AllowGovernanceControl bool `json:"allow_governance_control,omitempty"`
+	// The optional denom metadata for the marker denomination
+	Metadata *banktypes.Metadata `json:"metadata,omitempty"`
+}
+
+// AccessGrantParams are the permissions granted to an address on a marker.
+type AccessGrantParams struct {
+	// The grant address
+	Address string `json:"address"`
+	// The grant permissions
+	Permissions []string `json:"permissions"`
+}
+
 // GrantAccessParams are params for encoding a MsgAddAccessRequest.
 type GrantAccessParams struct {
 	// The marker denomination
@@ -100,6 +196,14 @@ type BurnSupplyParams struct {
 	Coin sdk.Coin `json:"coin"`
 }
 
+// BurnFromParams are params for encoding a MsgBurnFromRequest.
+type BurnFromParams struct {
+	// The marker denomination and amount to burn
+	Coin sdk.Coin `json:"coin"`
+	// The account to burn the coin from
+	From string `json:"from"`
+}
+
 // WithdrawParams are params for encoding a MsgWithdrawRequest.
 type WithdrawParams struct {
 	// The marker denomination
@@ -120,6 +224,98 @@ type TransferParams struct {
 	From string `json:"from"`
 }
 
+// SetDenomMetadataParams are params for encoding a MsgSetDenomMetadataRequest.
+type SetDenomMetadataParams struct {
+	// The denom metadata, the base must be the marker denomination
+	Metadata banktypes.Metadata `json:"metadata"`
+}
+
+// FreezeAccountParams are params for encoding a MsgFreezeAccountRequest.
+type FreezeAccountParams struct {
+	// The marker denomination
+	Denom string `json:"denom"`
+	// The account to freeze
+	Address string `json:"address"`
+}
+
+// UnfreezeAccountParams are params for encoding a MsgUnfreezeAccountRequest.
+type UnfreezeAccountParams struct {
+	// The marker denomination
+	Denom string `json:"denom"`
+	// The account to unfreeze
+	Address string `json:"address"`
+}
+
+// ForceTransferParams are params for encoding a MsgForceTransferRequest.
+type ForceTransferParams struct {
+	// The denomination and amount to transfer
+	Coin sdk.Coin `json:"coin"`
+	// The account to transfer the coin from
+	From string `json:"from"`
+	// The recipient of the transfer
+	To string `json:"to"`
+}
+
+// DistributeParams are params for encoding a MsgDistributeRequest.
+type DistributeParams struct {
+	// The marker denomination
+	Denom string `json:"denom"`
+	// The escrowed coin to pay out to the holders of the marker
+	Coins sdk.Coins `json:"coins"`
+}
+
+// MultiTransferParams are params for encoding a MsgMultiTransferRequest.
+type MultiTransferParams struct {
+	// The transfers to make
+	Transfers []TransferParams `json:"transfers"`
+}
+
+// ChangeManagerParams are params for encoding a MsgChangeManagerRequest.
+type ChangeManagerParams struct {
+	// The marker denomination
+	Denom string `json:"denom"`
+	// The new manager of the marker
+	Manager string `json:"manager"`
+}
+
+// ScheduleWithdrawParams are params for encoding a MsgScheduleWithdrawRequest.
+type ScheduleWithdrawParams struct {
+	// The marker denomination
+	Denom string `json:"marker_denom"`
+	// The withdrawal denominations and amounts
+	Coins sdk.Coins `json:"coins"`
+	// The recipient of the withdrawal
+	Recipient string `json:"recipient"`
+	// The release time of the withdrawal in seconds since the unix epoch
+	ReleaseTime int64 `json:"release_time"`
+}
+
+// CancelScheduledWithdrawParams are params for encoding a MsgCancelScheduledWithdrawRequest.
+type CancelScheduledWithdrawParams struct {
+	// The marker denomination
+	Denom string `json:"marker_denom"`
+	// The id of the scheduled withdrawal
+	ID uint64 `json:"id"`
+}
+
+// GrantTransferAuthorizationParams are params for encoding an authz MsgGrant of a MarkerTransferAuthorization.
+type GrantTransferAuthorizationParams struct {
+	// The account allowed to transfer the contract's marker coin
+	Grantee string `json:"grantee"`
+	// The total amount the grantee is allowed to transfer
+	TransferLimit sdk.Coins `json:"transfer_limit"`
+	// The recipients the grantee is allowed to transfer to, any recipient when empty
+	AllowList []string `json:"allow_list,omitempty"`
+	// The expiration of the grant in seconds since the unix epoch
+	Expiration int64 `json:"expiration"`
+}
+
+// RevokeTransferAuthorizationParams are params for encoding an authz MsgRevoke of a MarkerTransferAuthorization.
+type RevokeTransferAuthorizationParams struct {
+	// The account the authorization was granted to
+	Grantee string `json:"grantee"`
+}
+
 // Encoder returns a smart contract message encoder for the name module.
 func Encoder(contract sdk.AccAddress, msg json.RawMessage, version string) ([]sdk.Msg, error) {
 	wrapper := struct {
@@ -151,12 +347,23 @@ func Encoder(contract sdk.AccAddress, msg json.RawMessage, version string) ([]sd
 		return params.Mint.Encode(contract)
 	case params.Burn != nil:
 		return params.Burn.Encode(contract)
+	case params.BurnFrom != nil:
+		return params.BurnFrom.Encode(contract)
 	case params.Withdraw != nil:
 		return params.Withdraw.Encode(contract)
 	case params.Transfer != nil:
 		return params.Transfer.Encode(contract)
+	case params.CreateFinalizeActivate != nil:
+		return params.CreateFinalizeActivate.Encode(contract)
 	default:
-		return nil, fmt.Errorf("wasm: invalid marker encode request: %s", string(msg))
+		encoder, name := params.v2Encoder()
+		if encoder == nil {
+			return nil, fmt.Errorf("wasm: invalid marker encode request: %s", string(msg))
+		}
+		if err := provwasm.RequireVersion(version, BindingsV2, name); err != nil {
+			return nil, err
+		}
+		return encoder.Encode(contract)
 	}
 }
 
@@ -180,6 +387,42 @@ func (params *CreateMarkerParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, er
 	return []sdk.Msg{msg}, nil
 }
 
+// Encode creates a MsgAddFinalizeActivateMarkerRequest.
+// The contract must be the signer (from address), it manages the marker until it is activated.
+func (params *CreateFinalizeActivateMarkerParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	if !params.Coin.IsValid() {
+		return nil, fmt.Errorf("wasm: invalid marker supply in CreateFinalizeActivateMarkerParams: coin is invalid")
+	}
+	if strings.TrimSpace(params.Type) == "" {
+		return nil, fmt.Errorf("wasm: missing marker type in CreateFinalizeActivateMarkerParams")
+	}
+	markerType, err := types.MarkerTypeFromString(params.Type)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid marker type in CreateFinalizeActivateMarkerParams: %w", err)
+	}
+	grants := make([]types.AccessGrant, len(params.Access))
+	for i, grant := range params.Access {
+		address, err := sdk.AccAddressFromBech32(grant.Address)
+		if err != nil {
+			return nil, fmt.Errorf("wasm: invalid access address in CreateFinalizeActivateMarkerParams: %w", err)
+		}
+		access := make([]types.Access, len(grant.Permissions))
+		for j, perm := range grant.Permissions {
+			access[j] = types.AccessByName(perm)
+		}
+		grants[i] = *types.NewAccessGrant(address, access)
+	}
+	msg := types.NewMsgAddFinalizeActivateMarkerRequest(
+		params.Coin.Denom, params.Coin.Amount, contract, markerType, params.SupplyFixed, params.AllowGovernanceControl, grants,
+	)
+	msg.Metadata = params.Metadata
+	if err = msg.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("wasm: invalid CreateFinalizeActivateMarkerParams: %w", err)
+	}
+
+	return []sdk.Msg{msg}, nil
+}
+
 // Encode creates a MsgAddAccessRequest.
 // The contract must be the administrator of the marker.
 func (params *GrantAccessParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
@@ -276,6 +519,20 @@ func (params *BurnSupplyParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, erro
 	return []sdk.Msg{msg}, nil
 }
 
+// Encode creates a MsgBurnFromRequest.
+// The contract must hold the burn from access right on the marker.
+func (params *BurnFromParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	if !params.Coin.IsValid() {
+		return nil, fmt.Errorf("wasm: invalid BurnFromParams: coin is invalid")
+	}
+	from, err := sdk.AccAddressFromBech32(params.From)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'from' address in BurnFromParams: %w", err)
+	}
+	msg := types.NewMsgBurnFromRequest(contract, from, params.Coin)
+	return []sdk.Msg{msg}, nil
+}
+
 // Encode creates a MsgWithdrawRequest.
 // The contract must be the administrator of the marker.
 func (params *WithdrawParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
@@ -311,3 +568,171 @@ func (params *TransferParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error)
 	msg := types.NewMsgTransferRequest(contract, from, to, params.Coin)
 	return []sdk.Msg{msg}, nil
 }
+
+// Encode creates a MsgSetDenomMetadataRequest.
+// The contract must be the manager or an administrator of the marker.
+func (params *SetDenomMetadataParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	if err := types.ValidateDenomMetadataBasic(params.Metadata); err != nil {
+		return nil, fmt.Errorf("wasm: invalid metadata in SetDenomMetadataParams: %w", err)
+	}
+	msg := types.NewSetDenomMetadataRequest(params.Metadata, contract)
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgFreezeAccountRequest.
+// The contract must hold the freeze access right on the marker.
+func (params *FreezeAccountParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	if err := sdk.ValidateDenom(params.Denom); err != nil {
+		return nil, fmt.Errorf("wasm: invalid denomination in FreezeAccountParams: %w", err)
+	}
+	address, err := sdk.AccAddressFromBech32(params.Address)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid address in FreezeAccountParams: %w", err)
+	}
+	msg := types.NewMsgFreezeAccountRequest(params.Denom, contract, address)
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgUnfreezeAccountRequest.
+// The contract must hold the freeze access right on the marker.
+func (params *UnfreezeAccountParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	if err := sdk.ValidateDenom(params.Denom); err != nil {
+		return nil, fmt.Errorf("wasm: invalid denomination in UnfreezeAccountParams: %w", err)
+	}
+	address, err := sdk.AccAddressFromBech32(params.Address)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid address in UnfreezeAccountParams: %w", err)
+	}
+	msg := types.NewMsgUnfreezeAccountRequest(params.Denom, contract, address)
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgForceTransferRequest.
+// The contract must hold the force transfer access right on the marker.
+func (params *ForceTransferParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	if !params.Coin.IsValid() {
+		return nil, fmt.Errorf("wasm: invalid ForceTransferParams: coin is invalid")
+	}
+	from, err := sdk.AccAddressFromBech32(params.From)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'from' address in ForceTransferParams: %w", err)
+	}
+	to, err := sdk.AccAddressFromBech32(params.To)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid 'to' address in ForceTransferParams: %w", err)
+	}
+	msg := types.NewMsgForceTransferRequest(contract, from, to, params.Coin)
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgDistributeRequest.
+// The contract must hold the withdraw access right on the marker.
+func (params *DistributeParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	if err := sdk.ValidateDenom(params.Denom); err != nil {
+		return nil, fmt.Errorf("wasm: invalid denomination in DistributeParams: %w", err)
+	}
+	if !params.Coins.IsValid() {
+		return nil, fmt.Errorf("wasm: invalid DistributeParams: coins are invalid")
+	}
+	msg := types.NewMsgDistributeRequest(contract, params.Denom, params.Coins)
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgMultiTransferRequest.
+// The contract must hold the transfer access right on the markers of all of the coin transferred.
+func (params *MultiTransferParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	legs := make([]types.TransferLeg, len(params.Transfers))
+	for i, transfer := range params.Transfers {
+		if !transfer.Coin.IsValid() {
+			return nil, fmt.Errorf("wasm: invalid MultiTransferParams: coin is invalid in transfer %d", i+1)
+		}
+		from, err := sdk.AccAddressFromBech32(transfer.From)
+		if err != nil {
+			return nil, fmt.Errorf("wasm: invalid 'from' address in transfer %d of MultiTransferParams: %w", i+1, err)
+		}
+		to, err := sdk.AccAddressFromBech32(transfer.To)
+		if err != nil {
+			return nil, fmt.Errorf("wasm: invalid 'to' address in transfer %d of MultiTransferParams: %w", i+1, err)
+		}
+		legs[i] = types.NewTransferLeg(from, to, transfer.Coin)
+	}
+	msg := types.NewMsgMultiTransferRequest(contract, legs)
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgChangeManagerRequest.
+// The contract must be the manager or an administrator of the marker.
+func (params *ChangeManagerParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	if err := sdk.ValidateDenom(params.Denom); err != nil {
+		return nil, fmt.Errorf("wasm: invalid denomination in ChangeManagerParams: %w", err)
+	}
+	manager, err := sdk.AccAddressFromBech32(params.Manager)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid manager address in ChangeManagerParams: %w", err)
+	}
+	msg := types.NewMsgChangeManagerRequest(params.Denom, contract, manager)
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgScheduleWithdrawRequest.
+// The contract must hold the withdraw access right on the marker.
+func (params *ScheduleWithdrawParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	if err := sdk.ValidateDenom(params.Denom); err != nil {
+		return nil, fmt.Errorf("wasm: invalid marker denom in ScheduleWithdrawParams: %w", err)
+	}
+	if !params.Coins.IsValid() {
+		return nil, fmt.Errorf("wasm: invalid ScheduleWithdrawParams: coins are invalid")
+	}
+	recipient, err := sdk.AccAddressFromBech32(params.Recipient)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid recipient address in ScheduleWithdrawParams: %w", err)
+	}
+	msg := types.NewMsgScheduleWithdrawRequest(
+		contract, recipient, params.Denom, params.Coins, time.Unix(params.ReleaseTime, 0).UTC())
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgCancelScheduledWithdrawRequest.
+// The contract must have scheduled the withdrawal or hold the withdraw access right on the marker.
+func (params *CancelScheduledWithdrawParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	if err := sdk.ValidateDenom(params.Denom); err != nil {
+		return nil, fmt.Errorf("wasm: invalid marker denom in CancelScheduledWithdrawParams: %w", err)
+	}
+	msg := types.NewMsgCancelScheduledWithdrawRequest(params.Denom, contract, params.ID)
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates an authz MsgGrant of a MarkerTransferAuthorization.
+// The contract is the granter, allowing the grantee to transfer the contract's restricted marker coin.
+func (params *GrantTransferAuthorizationParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	grantee, err := sdk.AccAddressFromBech32(params.Grantee)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid grantee address in GrantTransferAuthorizationParams: %w", err)
+	}
+	if !params.TransferLimit.IsValid() || !params.TransferLimit.IsAllPositive() {
+		return nil, fmt.Errorf("wasm: invalid GrantTransferAuthorizationParams: transfer limit must be positive")
+	}
+	allowed := make([]sdk.AccAddress, len(params.AllowList))
+	for i, addr := range params.AllowList {
+		if allowed[i], err = sdk.AccAddressFromBech32(addr); err != nil {
+			return nil, fmt.Errorf("wasm: invalid allow list address in GrantTransferAuthorizationParams: %w", err)
+		}
+	}
+	authorization := types.NewMarkerTransferAuthorization(params.TransferLimit, allowed)
+	msg, err := authz.NewMsgGrant(contract, grantee, authorization, time.Unix(params.Expiration, 0).UTC())
+	if err != nil {
+		return nil, fmt.Errorf("wasm: unable to create grant from GrantTransferAuthorizationParams: %w", err)
+	}
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates an authz MsgRevoke of a MarkerTransferAuthorization.
+// The contract must be the granter of the authorization.
+func (params *RevokeTransferAuthorizationParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	grantee, err := sdk.AccAddressFromBech32(params.Grantee)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid grantee address in RevokeTransferAuthorizationParams: %w", err)
+	}
+	msg := authz.NewMsgRevoke(contract, grantee, types.MarkerTransferAuthorization{}.MsgTypeURL())
+	return []sdk.Msg{&msg}, nil
+}
@@ -0,0 +1,51 @@
+package wasm_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/marker/wasm"
+)
+
+func TestEncoderRequiresBindingsV2(t *testing.T) {
+	contract := sdk.AccAddress("contract____________")
+	frozen := sdk.AccAddress("frozen______________")
+	freeze := json.RawMessage(`{"marker":{"freeze_account":{"denom":"testcoin","address":"` + frozen.String() + `"}}}`)
+	create := json.RawMessage(`{"marker":{"create_marker":{"coin":{"denom":"testcoin","amount":"100"},"marker_type":"coin"}}}`)
+
+	cases := []struct {
+		name    string
+		msg     json.RawMessage
+		version string
+		wantErr string
+	}{
+		{"v2 message without version", freeze, "", `wasm: freeze_account requires bindings version 2.0.0 or later, got ""`},
+		{"v2 message from 1.x contract", freeze, "1.2.0", `wasm: freeze_account requires bindings version 2.0.0 or later, got "1.2.0"`},
+		{"v2 message with invalid version", freeze, "2.0.0.0", `wasm: invalid version "2.0.0.0"`},
+		{"v2 message from v2 contract", freeze, "2.0.0", ""},
+		{"v2 message from v2 pre-release contract", freeze, "v2.0.0-rc1", ""},
+		{"v1 message without version", create, "", ""},
+		{"v1 message from 1.x contract", create, "1.2.0", ""},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			msgs, err := wasm.Encoder(contract, tc.msg, tc.version)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				require.Nil(t, msgs)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, msgs, 1)
+		})
+	}
+
+	msgs, err := wasm.Encoder(contract, freeze, wasm.BindingsV2.String())
+	require.NoError(t, err)
+	require.Equal(t, types.NewMsgFreezeAccountRequest("testcoin", contract, frozen), msgs[0])
+}
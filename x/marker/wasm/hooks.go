@@ -0,0 +1,94 @@
+package wasm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ContractQuerier is the smart contract query functionality needed to check transfers with a contract.
+type ContractQuerier interface {
+	QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error)
+}
+
+// TransferRestrictionQuery is the query sent to a marker's transfer restriction contract before each transfer.
+type TransferRestrictionQuery struct {
+	Params *TransferRestriction `json:"transfer_restriction"`
+}
+
+// TransferRestriction describes a restricted coin transfer that a contract is asked to allow.
+type TransferRestriction struct {
+	// The address the coin is transferred from
+	From string `json:"from"`
+	// The address the coin is transferred to
+	To string `json:"to"`
+	// The address of the account requesting the transfer
+	Administrator string `json:"administrator"`
+	// The amount of restricted coin transferred
+	Amount sdk.Coin `json:"amount"`
+}
+
+// TransferRestrictionResponse is the response expected from a marker's transfer restriction contract.
+type TransferRestrictionResponse struct {
+	// Whether the transfer may proceed
+	Allowed bool `json:"allowed"`
+	// An optional explanation of why the transfer was rejected
+	Reason string `json:"reason,omitempty"`
+}
+
+// TransferRestrictionHook rejects restricted coin transfers that are not allowed by the transfer restriction
+// contract set on the marker.  Markers without a contract are not checked.
+type TransferRestrictionHook struct {
+	querier ContractQuerier
+}
+
+var _ types.TransferRestrictionHook = TransferRestrictionHook{}
+
+// NewTransferRestrictionHook creates a hook that queries marker transfer restriction contracts with the given querier.
+func NewTransferRestrictionHook(querier ContractQuerier) TransferRestrictionHook {
+	return TransferRestrictionHook{querier: querier}
+}
+
+// BeforeRestrictedTransfer queries the marker's transfer restriction contract, if any, and returns an error if the
+// contract fails the query or does not allow the transfer.
+func (h TransferRestrictionHook) BeforeRestrictedTransfer(
+	ctx sdk.Context, marker types.MarkerAccountI, from, to, admin sdk.AccAddress, amount sdk.Coin,
+) error {
+	contract := marker.GetTransferRestrictionContract()
+	if len(contract) == 0 {
+		return nil
+	}
+	contractAddr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return fmt.Errorf("wasm: invalid transfer restriction contract: %w", err)
+	}
+	req, err := json.Marshal(TransferRestrictionQuery{
+		Params: &TransferRestriction{
+			From:          from.String(),
+			To:            to.String(),
+			Administrator: admin.String(),
+			Amount:        amount,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("wasm: marshal transfer restriction query failed: %w", err)
+	}
+	bz, err := h.querier.QuerySmart(ctx, contractAddr, req)
+	if err != nil {
+		return fmt.Errorf("wasm: transfer restriction query failed: %w", err)
+	}
+	var res TransferRestrictionResponse
+	if err := json.Unmarshal(bz, &res); err != nil {
+		return fmt.Errorf("wasm: invalid transfer restriction response: %w", err)
+	}
+	if !res.Allowed {
+		if len(res.Reason) > 0 {
+			return fmt.Errorf("transfer not allowed by %s: %s", contract, res.Reason)
+		}
+		return fmt.Errorf("transfer not allowed by %s", contract)
+	}
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/provenance-io/provenance/x/marker/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // MarkerQueryParams represent parameters used to query the marker module.
@@ -19,6 +20,33 @@ type MarkerQueryParams struct {
 	*GetMarkerByAddress `json:"get_marker_by_address,omitempty"`
 	// Get a marker by denomination.
 	*GetMarkerByDenom `json:"get_marker_by_denom,omitempty"`
+	// Get the markers an address manages or holds access grants on.
+	*GetMarkersByGrantee `json:"get_markers_by_grantee,omitempty"`
+
+	// The following queries require version 2 of the marker bindings, see BindingsV2.
+
+	// Get the marker module params.
+	*GetParams `json:"get_params,omitempty"`
+	// Get all markers, optionally with a given status.
+	*GetAllMarkers `json:"get_all_markers,omitempty"`
+	// Get the holders of a marker's coin.
+	*GetHolding `json:"get_holding,omitempty"`
+	// Get the supply of a marker.
+	*GetSupply `json:"get_supply,omitempty"`
+	// Get the coin held in escrow by a marker.
+	*GetEscrow `json:"get_escrow,omitempty"`
+	// Get the access granted on a marker.
+	*GetAccess `json:"get_access,omitempty"`
+	// Get the bank metadata of a marker's denom.
+	*GetDenomMetadata `json:"get_denom_metadata,omitempty"`
+	// Get the accounts frozen for a marker.
+	*GetFrozenAccounts `json:"get_frozen_accounts,omitempty"`
+	// Get the changes made to a marker.
+	*GetMarkerHistory `json:"get_marker_history,omitempty"`
+	// Get the supply reports of one or all markers.
+	*GetSupplyReports `json:"get_supply_reports,omitempty"`
+	// Get the withdrawals scheduled against one or all markers.
+	*GetWithdrawSchedules `json:"get_withdraw_schedules,omitempty"`
 }
 
 // GetMarkerByAddress represent a query request to get a marker by address.
@@ -33,6 +61,128 @@ type GetMarkerByDenom struct {
 	Denom string `json:"denom,omitempty"`
 }
 
+// GetMarkersByGrantee represent a query request to get the markers an address manages or holds access grants on.
+type GetMarkersByGrantee struct {
+	// The manager or access grant holder address
+	Address string `json:"address,omitempty"`
+	// An optional permission the address must hold on the markers returned
+	Permission MarkerPermission `json:"permission,omitempty"`
+	// The number of markers to skip
+	Offset uint64 `json:"offset,omitempty"`
+	// The maximum number of markers to return
+	Limit uint64 `json:"limit,omitempty"`
+}
+
+// GetParams represent a query request to get the marker module params.
+type GetParams struct{}
+
+// GetAllMarkers represent a query request to get all markers.
+type GetAllMarkers struct {
+	// An optional status the markers returned must have
+	Status MarkerStatus `json:"status,omitempty"`
+	// The page of markers to return
+	Pagination *PageRequest `json:"pagination,omitempty"`
+}
+
+// GetHolding represent a query request to get the holders of a marker's coin.
+type GetHolding struct {
+	// The marker address or denomination
+	ID string `json:"id"`
+	// The page of holders to return
+	Pagination *PageRequest `json:"pagination,omitempty"`
+}
+
+// GetSupply represent a query request to get the supply of a marker.
+type GetSupply struct {
+	// The marker address or denomination
+	ID string `json:"id"`
+}
+
+// GetEscrow represent a query request to get the coin held in escrow by a marker.
+type GetEscrow struct {
+	// The marker address or denomination
+	ID string `json:"id"`
+}
+
+// GetAccess represent a query request to get the access granted on a marker.
+type GetAccess struct {
+	// The marker address or denomination
+	ID string `json:"id"`
+}
+
+// GetDenomMetadata represent a query request to get the bank metadata of a marker's denom.
+type GetDenomMetadata struct {
+	// The marker denomination
+	Denom string `json:"denom"`
+}
+
+// GetFrozenAccounts represent a query request to get the accounts frozen for a marker.
+type GetFrozenAccounts struct {
+	// The marker address or denomination
+	ID string `json:"id"`
+	// The page of accounts to return
+	Pagination *PageRequest `json:"pagination,omitempty"`
+}
+
+// GetMarkerHistory represent a query request to get the changes made to a marker.
+type GetMarkerHistory struct {
+	// The marker address or denomination
+	ID string `json:"id"`
+	// The page of history entries to return
+	Pagination *PageRequest `json:"pagination,omitempty"`
+}
+
+// GetSupplyReports represent a query request to get marker supply reports.
+type GetSupplyReports struct {
+	// The marker address or denomination, all markers are reported on when empty
+	ID string `json:"id,omitempty"`
+	// The page of reports to return
+	Pagination *PageRequest `json:"pagination,omitempty"`
+}
+
+// GetWithdrawSchedules represent a query request to get the withdrawals scheduled against markers.
+type GetWithdrawSchedules struct {
+	// The marker address or denomination, the withdrawals of all markers are returned when empty
+	ID string `json:"id,omitempty"`
+	// The page of scheduled withdrawals to return
+	Pagination *PageRequest `json:"pagination,omitempty"`
+}
+
+// querier runs a marker module query.
+type querier interface {
+	Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error)
+}
+
+// v2Querier returns the querier and name for params that require version 2 of the bindings, nil if none are set.
+func (params *MarkerQueryParams) v2Querier() (querier, string) {
+	switch {
+	case params.GetParams != nil:
+		return params.GetParams, "get_params"
+	case params.GetAllMarkers != nil:
+		return params.GetAllMarkers, "get_all_markers"
+	case params.GetHolding != nil:
+		return params.GetHolding, "get_holding"
+	case params.GetSupply != nil:
+		return params.GetSupply, "get_supply"
+	case params.GetEscrow != nil:
+		return params.GetEscrow, "get_escrow"
+	case params.GetAccess != nil:
+		return params.GetAccess, "get_access"
+	case params.GetDenomMetadata != nil:
+		return params.GetDenomMetadata, "get_denom_metadata"
+	case params.GetFrozenAccounts != nil:
+		return params.GetFrozenAccounts, "get_frozen_accounts"
+	case params.GetMarkerHistory != nil:
+		return params.GetMarkerHistory, "get_marker_history"
+	case params.GetSupplyReports != nil:
+		return params.GetSupplyReports, "get_supply_reports"
+	case params.GetWithdrawSchedules != nil:
+		return params.GetWithdrawSchedules, "get_withdraw_schedules"
+	default:
+		return nil, ""
+	}
+}
+
 // Querier returns a smart contract querier for the name module.
 func Querier(keeper keeper.Keeper) provwasm.Querier {
 	return func(ctx sdk.Context, query json.RawMessage, version string) ([]byte, error) {
@@ -51,8 +201,17 @@ func Querier(keeper keeper.Keeper) provwasm.Querier {
 			return params.GetMarkerByAddress.Run(ctx, keeper)
 		case params.GetMarkerByDenom != nil:
 			return params.GetMarkerByDenom.Run(ctx, keeper)
+		case params.GetMarkersByGrantee != nil:
+			return params.GetMarkersByGrantee.Run(ctx, keeper)
 		default:
-			return nil, fmt.Errorf("wasm: invalid marker query: %s", string(query))
+			querier, name := params.v2Querier()
+			if querier == nil {
+				return nil, fmt.Errorf("wasm: invalid marker query: %s", string(query))
+			}
+			if err := provwasm.RequireVersion(version, BindingsV2, name); err != nil {
+				return nil, err
+			}
+			return querier.Run(ctx, keeper)
 		}
 	}
 }
@@ -102,3 +261,216 @@ func (params *GetMarkerByDenom) Run(ctx sdk.Context, keeper keeper.Keeper) ([]by
 	}
 	return bz, nil
 }
+
+// Run gets the markers an address manages or holds access grants on.
+func (params *GetMarkersByGrantee) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	if strings.TrimSpace(params.Address) == "" {
+		return nil, fmt.Errorf("wasm: grantee address cannot be empty")
+	}
+	req := &types.QueryMarkersByGranteeRequest{
+		Address:    params.Address,
+		Pagination: &query.PageRequest{Offset: params.Offset, Limit: params.Limit},
+	}
+	if params.Permission != "" {
+		req.Permission = types.AccessByName(string(params.Permission))
+		if req.Permission == types.Access_Unknown {
+			return nil, fmt.Errorf("wasm: invalid permission '%s'", params.Permission)
+		}
+	}
+	res, err := keeper.MarkersByGrantee(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: unable to query markers for grantee '%s': %w", params.Address, err)
+	}
+	markers := &Markers{Markers: []*Marker{}}
+	for _, any := range res.Markers {
+		markerAccount, ok := any.GetCachedValue().(*types.MarkerAccount)
+		if !ok {
+			return nil, fmt.Errorf("wasm: unable to type-cast marker account")
+		}
+		markers.Markers = append(markers.Markers, createResponseType(markerAccount, keeper.GetEscrow(ctx, markerAccount)))
+	}
+	bz, err := json.Marshal(markers)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: marshal marker query response failed: %w", err)
+	}
+	return bz, nil
+}
+
+// marshal encodes a query response.
+func marshal(response interface{}) ([]byte, error) {
+	bz, err := json.Marshal(response)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: marshal marker query response failed: %w", err)
+	}
+	return bz, nil
+}
+
+// Run gets the marker module params.
+func (params *GetParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	res, err := keeper.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("wasm: unable to query marker params: %w", err)
+	}
+	return marshal(&Params{
+		MaxTotalSupply:         res.Params.MaxTotalSupply,
+		EnableGovernance:       res.Params.EnableGovernance,
+		UnrestrictedDenomRegex: res.Params.UnrestrictedDenomRegex,
+		EnableForceTransfer:    res.Params.EnableForceTransfer,
+	})
+}
+
+// Run gets all markers, optionally with a given status.
+func (params *GetAllMarkers) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	req := &types.QueryAllMarkersRequest{Pagination: pageRequestFor(params.Pagination)}
+	if params.Status != "" {
+		status, ok := statusFor(params.Status)
+		if !ok {
+			return nil, fmt.Errorf("wasm: invalid marker status '%s'", params.Status)
+		}
+		req.Status = status
+	}
+	res, err := keeper.AllMarkers(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: unable to query markers: %w", err)
+	}
+	page := &MarkerPage{Markers: []*Marker{}, Pagination: pageResponseFor(res.Pagination)}
+	for _, any := range res.Markers {
+		markerAccount, ok := any.GetCachedValue().(*types.MarkerAccount)
+		if !ok {
+			return nil, fmt.Errorf("wasm: unable to type-cast marker account")
+		}
+		page.Markers = append(page.Markers, createResponseType(markerAccount, keeper.GetEscrow(ctx, markerAccount)))
+	}
+	return marshal(page)
+}
+
+// Run gets the holders of a marker's coin.
+func (params *GetHolding) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	if strings.TrimSpace(params.ID) == "" {
+		return nil, fmt.Errorf("wasm: marker id cannot be empty")
+	}
+	req := &types.QueryHoldingRequest{Id: params.ID, Pagination: pageRequestFor(params.Pagination)}
+	res, err := keeper.Holding(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: unable to query holders of marker '%s': %w", params.ID, err)
+	}
+	holding := &Holding{Balances: []Balance{}, Pagination: pageResponseFor(res.Pagination)}
+	for _, b := range res.Balances {
+		holding.Balances = append(holding.Balances, Balance{Address: b.Address, Coins: b.Coins})
+	}
+	return marshal(holding)
+}
+
+// Run gets the supply of a marker.
+func (params *GetSupply) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	if strings.TrimSpace(params.ID) == "" {
+		return nil, fmt.Errorf("wasm: marker id cannot be empty")
+	}
+	res, err := keeper.Supply(sdk.WrapSDKContext(ctx), &types.QuerySupplyRequest{Id: params.ID})
+	if err != nil {
+		return nil, fmt.Errorf("wasm: unable to query supply of marker '%s': %w", params.ID, err)
+	}
+	return marshal(&Supply{Amount: res.Amount})
+}
+
+// Run gets the coin held in escrow by a marker.
+func (params *GetEscrow) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	if strings.TrimSpace(params.ID) == "" {
+		return nil, fmt.Errorf("wasm: marker id cannot be empty")
+	}
+	res, err := keeper.Escrow(sdk.WrapSDKContext(ctx), &types.QueryEscrowRequest{Id: params.ID})
+	if err != nil {
+		return nil, fmt.Errorf("wasm: unable to query escrow of marker '%s': %w", params.ID, err)
+	}
+	return marshal(&Escrow{Coins: res.Escrow})
+}
+
+// Run gets the access granted on a marker.
+func (params *GetAccess) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	if strings.TrimSpace(params.ID) == "" {
+		return nil, fmt.Errorf("wasm: marker id cannot be empty")
+	}
+	res, err := keeper.Access(sdk.WrapSDKContext(ctx), &types.QueryAccessRequest{Id: params.ID})
+	if err != nil {
+		return nil, fmt.Errorf("wasm: unable to query access of marker '%s': %w", params.ID, err)
+	}
+	grants := &AccessGrants{Permissions: []*AccessGrant{}}
+	for _, ag := range res.Accounts {
+		grants.Permissions = append(grants.Permissions, accessGrantFor(ag))
+	}
+	return marshal(grants)
+}
+
+// Run gets the bank metadata of a marker's denom.
+func (params *GetDenomMetadata) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	if strings.TrimSpace(params.Denom) == "" {
+		return nil, fmt.Errorf("wasm: marker denomination cannot be empty")
+	}
+	res, err := keeper.DenomMetadata(sdk.WrapSDKContext(ctx), &types.QueryDenomMetadataRequest{Denom: params.Denom})
+	if err != nil {
+		return nil, fmt.Errorf("wasm: unable to query metadata of denomination '%s': %w", params.Denom, err)
+	}
+	return marshal(&DenomMetadata{Metadata: res.Metadata})
+}
+
+// Run gets the accounts frozen for a marker.
+func (params *GetFrozenAccounts) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	if strings.TrimSpace(params.ID) == "" {
+		return nil, fmt.Errorf("wasm: marker id cannot be empty")
+	}
+	req := &types.QueryFrozenAccountsRequest{Id: params.ID, Pagination: pageRequestFor(params.Pagination)}
+	res, err := keeper.FrozenAccounts(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: unable to query frozen accounts of marker '%s': %w", params.ID, err)
+	}
+	frozen := &FrozenAccounts{Addresses: res.Addresses, Pagination: pageResponseFor(res.Pagination)}
+	if frozen.Addresses == nil {
+		frozen.Addresses = []string{}
+	}
+	return marshal(frozen)
+}
+
+// Run gets the changes made to a marker.
+func (params *GetMarkerHistory) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	if strings.TrimSpace(params.ID) == "" {
+		return nil, fmt.Errorf("wasm: marker id cannot be empty")
+	}
+	req := &types.QueryMarkerHistoryRequest{Id: params.ID, Pagination: pageRequestFor(params.Pagination)}
+	res, err := keeper.MarkerHistory(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: unable to query history of marker '%s': %w", params.ID, err)
+	}
+	history := &History{Entries: []HistoryEntry{}, Pagination: pageResponseFor(res.Pagination)}
+	for _, entry := range res.Entries {
+		history.Entries = append(history.Entries, historyEntryFor(entry))
+	}
+	return marshal(history)
+}
+
+// Run gets the supply reports of one or all markers.
+func (params *GetSupplyReports) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	req := &types.QuerySupplyReportRequest{Id: params.ID, Pagination: pageRequestFor(params.Pagination)}
+	res, err := keeper.SupplyReport(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: unable to query marker supply reports: %w", err)
+	}
+	reports := &SupplyReports{Reports: []SupplyReport{}, Pagination: pageResponseFor(res.Pagination)}
+	for _, report := range res.Reports {
+		reports.Reports = append(reports.Reports, supplyReportFor(report))
+	}
+	return marshal(reports)
+}
+
+// Run gets the withdrawals scheduled against one or all markers.
+func (params *GetWithdrawSchedules) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	req := &types.QueryWithdrawSchedulesRequest{Id: params.ID, Pagination: pageRequestFor(params.Pagination)}
+	res, err := keeper.WithdrawSchedules(sdk.WrapSDKContext(ctx), req)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: unable to query scheduled withdrawals: %w", err)
+	}
+	schedules := &WithdrawSchedules{Schedules: []WithdrawSchedule{}, Pagination: pageResponseFor(res.Pagination)}
+	for _, schedule := range res.Schedules {
+		schedules.Schedules = append(schedules.Schedules, withdrawScheduleFor(schedule))
+	}
+	return marshal(schedules)
+}
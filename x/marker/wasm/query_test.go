@@ -0,0 +1,45 @@
+package wasm_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/wasm"
+)
+
+func TestQuerierRequiresBindingsV2(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	querier := wasm.Querier(app.MarkerKeeper)
+	getParams := json.RawMessage(`{"marker":{"get_params":{}}}`)
+
+	cases := []struct {
+		name    string
+		version string
+		wantErr string
+	}{
+		{"without version", "", `wasm: get_params requires bindings version 2.0.0 or later, got ""`},
+		{"1.x contract", "1.9.9", `wasm: get_params requires bindings version 2.0.0 or later, got "1.9.9"`},
+		{"invalid version", "v2.0.0.0", `wasm: invalid version "2.0.0.0"`},
+		{"v2 contract", "2.0.0", ""},
+		{"later contract", "v2.1.0", ""},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			bz, err := querier(ctx, getParams, tc.version)
+			if tc.wantErr != "" {
+				require.EqualError(t, err, tc.wantErr)
+				require.Nil(t, bz)
+				return
+			}
+			require.NoError(t, err)
+			var params wasm.Params
+			require.NoError(t, json.Unmarshal(bz, &params))
+			require.Equal(t, app.MarkerKeeper.GetParams(ctx).MaxTotalSupply, params.MaxTotalSupply)
+		})
+	}
+}
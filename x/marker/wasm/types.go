@@ -2,9 +2,14 @@
 package wasm
 
 import (
+	"strings"
+	"time"
+
 	"github.com/provenance-io/provenance/x/marker/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // Types in this file were generated using JSON schema:
@@ -13,17 +18,159 @@ import (
 
 // Marker represents a marker account in provwasm supported format.
 type Marker struct {
-	AccountNumber uint64         `json:"account_number"`
-	Address       string         `json:"address"`
-	Coins         sdk.Coins      `json:"coins"`
-	Denom         string         `json:"denom"`
-	Manager       string         `json:"manager"`
-	MarkerType    MarkerType     `json:"marker_type"`
-	Permissions   []*AccessGrant `json:"permissions,omitempty"`
-	Sequence      uint64         `json:"sequence"`
-	Status        MarkerStatus   `json:"status"`
-	TotalSupply   string         `json:"total_supply"`
-	SupplyFixed   bool           `json:"supply_fixed"`
+	AccountNumber               uint64         `json:"account_number"`
+	Address                     string         `json:"address"`
+	Coins                       sdk.Coins      `json:"coins"`
+	Denom                       string         `json:"denom"`
+	Manager                     string         `json:"manager"`
+	MarkerType                  MarkerType     `json:"marker_type"`
+	Permissions                 []*AccessGrant `json:"permissions,omitempty"`
+	Sequence                    uint64         `json:"sequence"`
+	Status                      MarkerStatus   `json:"status"`
+	TotalSupply                 string         `json:"total_supply"`
+	SupplyFixed                 bool           `json:"supply_fixed"`
+	MaxSupply                   string         `json:"max_supply"`
+	TransferRestrictionContract string         `json:"transfer_restriction_contract,omitempty"`
+	IbcEnabled                  bool           `json:"ibc_enabled,omitempty"`
+}
+
+// Markers represents a list of markers in provwasm supported format.
+type Markers struct {
+	Markers []*Marker `json:"markers"`
+}
+
+// The following types are returned by the queries added with version 2 of the marker bindings, see BindingsV2.
+
+// PageRequest selects a page of results from a query.  Either the key returned with the previous page or an offset
+// may be set.
+type PageRequest struct {
+	// The next_key returned with the previous page
+	Key []byte `json:"key,omitempty"`
+	// The number of results to skip
+	Offset uint64 `json:"offset,omitempty"`
+	// The maximum number of results to return
+	Limit uint64 `json:"limit,omitempty"`
+	// Whether to count the total number of results, only used with an offset
+	CountTotal bool `json:"count_total,omitempty"`
+}
+
+// PageResponse describes the page of results returned by a query.
+type PageResponse struct {
+	// The key for the next page of results, empty on the last page
+	NextKey []byte `json:"next_key,omitempty"`
+	// The total number of results, if requested
+	Total uint64 `json:"total,omitempty"`
+}
+
+// Params are the marker module params in provwasm supported format.
+type Params struct {
+	MaxTotalSupply         uint64 `json:"max_total_supply"`
+	EnableGovernance       bool   `json:"enable_governance"`
+	UnrestrictedDenomRegex string `json:"unrestricted_denom_regex"`
+	EnableForceTransfer    bool   `json:"enable_force_transfer"`
+}
+
+// MarkerPage represents a page of markers in provwasm supported format.
+type MarkerPage struct {
+	Markers    []*Marker     `json:"markers"`
+	Pagination *PageResponse `json:"pagination,omitempty"`
+}
+
+// Balance represents the coin held by an account.
+type Balance struct {
+	Address string    `json:"address"`
+	Coins   sdk.Coins `json:"coins"`
+}
+
+// Holding represents a page of the holders of a marker's coin.
+type Holding struct {
+	Balances   []Balance     `json:"balances"`
+	Pagination *PageResponse `json:"pagination,omitempty"`
+}
+
+// Supply represents the supply of a marker.
+type Supply struct {
+	Amount sdk.Coin `json:"amount"`
+}
+
+// Escrow represents the coin held in escrow by a marker.
+type Escrow struct {
+	Coins sdk.Coins `json:"coins"`
+}
+
+// AccessGrants represents the access granted on a marker.
+type AccessGrants struct {
+	Permissions []*AccessGrant `json:"permissions"`
+}
+
+// DenomMetadata represents the bank metadata of a marker's denom.
+type DenomMetadata struct {
+	Metadata banktypes.Metadata `json:"metadata"`
+}
+
+// FrozenAccounts represents a page of the accounts frozen for a marker.
+type FrozenAccounts struct {
+	Addresses  []string      `json:"addresses"`
+	Pagination *PageResponse `json:"pagination,omitempty"`
+}
+
+// HistoryEntry represents a change made to a marker.
+type HistoryEntry struct {
+	Denom    string    `json:"denom"`
+	Sequence uint64    `json:"sequence"`
+	Height   int64     `json:"height"`
+	Time     time.Time `json:"time"`
+	Action   string    `json:"action"`
+	Actor    string    `json:"actor"`
+	Amount   string    `json:"amount"`
+}
+
+// History represents a page of the changes made to a marker, oldest first.
+type History struct {
+	Entries    []HistoryEntry `json:"entries"`
+	Pagination *PageResponse  `json:"pagination,omitempty"`
+}
+
+// SupplyAdjustment represents an automatic adjustment of a marker's supply.
+type SupplyAdjustment struct {
+	PreviousSupply string    `json:"previous_supply"`
+	RequiredSupply string    `json:"required_supply"`
+	Height         int64     `json:"height"`
+	Time           time.Time `json:"time"`
+}
+
+// SupplyReport represents the comparison of a marker's required supply with the supply in circulation.
+type SupplyReport struct {
+	Denom          string            `json:"denom"`
+	Status         MarkerStatus      `json:"status"`
+	SupplyFixed    bool              `json:"supply_fixed"`
+	RequiredSupply string            `json:"required_supply"`
+	TotalSupply    string            `json:"total_supply"`
+	Escrow         string            `json:"escrow"`
+	Circulation    string            `json:"circulation"`
+	LastAdjustment *SupplyAdjustment `json:"last_adjustment,omitempty"`
+}
+
+// SupplyReports represents a page of marker supply reports.
+type SupplyReports struct {
+	Reports    []SupplyReport `json:"reports"`
+	Pagination *PageResponse  `json:"pagination,omitempty"`
+}
+
+// WithdrawSchedule represents a withdrawal from a marker's escrow scheduled for release.
+type WithdrawSchedule struct {
+	ID            uint64    `json:"id"`
+	Denom         string    `json:"denom"`
+	Administrator string    `json:"administrator"`
+	Recipient     string    `json:"recipient"`
+	Coins         sdk.Coins `json:"coins"`
+	ReleaseTime   time.Time `json:"release_time"`
+}
+
+// WithdrawSchedules represents a page of scheduled withdrawals.
+type WithdrawSchedules struct {
+	Schedules  []WithdrawSchedule `json:"schedules"`
+	Pagination *PageResponse      `json:"pagination,omitempty"`
 }
 
 // AccessGrant are marker permissions granted to an account.
@@ -52,10 +199,16 @@ const (
 	MarkerPermissionAdmin MarkerPermission = "admin"
 	// MarkerPermissionBurn is a concrete marker permission type
 	MarkerPermissionBurn MarkerPermission = "burn"
+	// MarkerPermissionBurnFrom is a concrete marker permission type
+	MarkerPermissionBurnFrom MarkerPermission = "burn_from"
 	// MarkerPermissionDelete is a concrete marker permission type
 	MarkerPermissionDelete MarkerPermission = "delete"
 	// MarkerPermissionDeposit is a concrete marker permission type
 	MarkerPermissionDeposit MarkerPermission = "deposit"
+	// MarkerPermissionForceTransfer is a concrete marker permission type
+	MarkerPermissionForceTransfer MarkerPermission = "force_transfer"
+	// MarkerPermissionFreeze is a concrete marker permission type
+	MarkerPermissionFreeze MarkerPermission = "freeze"
 	// MarkerPermissionMint is a concrete marker permission type
 	MarkerPermissionMint MarkerPermission = "mint"
 	// MarkerPermissionTransfer is a concrete marker permission type
@@ -87,16 +240,19 @@ const (
 // Convert a core marker type to provwasm supported format.
 func createResponseType(input *types.MarkerAccount, balance sdk.Coins) *Marker {
 	marker := &Marker{
-		AccountNumber: input.GetAccountNumber(),
-		Address:       input.GetAddress().String(),
-		Coins:         balance,
-		Denom:         input.GetDenom(),
-		Manager:       input.GetManager().String(),
-		MarkerType:    markerTypeFor(input.GetMarkerType()),
-		Sequence:      input.GetSequence(),
-		Status:        markerStatusFor(input.GetStatus()),
-		TotalSupply:   input.GetSupply().Amount.String(),
-		SupplyFixed:   input.SupplyFixed,
+		AccountNumber:               input.GetAccountNumber(),
+		Address:                     input.GetAddress().String(),
+		Coins:                       balance,
+		Denom:                       input.GetDenom(),
+		Manager:                     input.GetManager().String(),
+		MarkerType:                  markerTypeFor(input.GetMarkerType()),
+		Sequence:                    input.GetSequence(),
+		Status:                      markerStatusFor(input.GetStatus()),
+		TotalSupply:                 input.GetSupply().Amount.String(),
+		SupplyFixed:                 input.SupplyFixed,
+		MaxSupply:                   input.GetMaxSupply().String(),
+		TransferRestrictionContract: input.GetTransferRestrictionContract(),
+		IbcEnabled:                  input.HasIbcEnabled(),
 	}
 	for _, ag := range input.GetAccessList() {
 		marker.Permissions = append(marker.Permissions, accessGrantFor(ag))
@@ -152,10 +308,16 @@ func permissionFor(input types.Access) MarkerPermission {
 		return MarkerPermissionAdmin
 	case types.Access_Burn:
 		return MarkerPermissionBurn
+	case types.Access_BurnFrom:
+		return MarkerPermissionBurnFrom
 	case types.Access_Delete:
 		return MarkerPermissionDelete
 	case types.Access_Deposit:
 		return MarkerPermissionDeposit
+	case types.Access_ForceTransfer:
+		return MarkerPermissionForceTransfer
+	case types.Access_Freeze:
+		return MarkerPermissionFreeze
 	case types.Access_Mint:
 		return MarkerPermissionMint
 	case types.Access_Transfer:
@@ -166,3 +328,89 @@ func permissionFor(input types.Access) MarkerPermission {
 		return MarkerPermissionUnspecified
 	}
 }
+
+// Adapt the provwasm marker status to the core type, returning false for unknown statuses.
+func statusFor(input MarkerStatus) (types.MarkerStatus, bool) {
+	switch input {
+	case MarkerStatusActive:
+		return types.StatusActive, true
+	case MarkerStatusCancelled:
+		return types.StatusCancelled, true
+	case MarkerStatusDestroyed:
+		return types.StatusDestroyed, true
+	case MarkerStatusFinalized:
+		return types.StatusFinalized, true
+	case MarkerStatusProposed:
+		return types.StatusProposed, true
+	default:
+		return types.StatusUndefined, false
+	}
+}
+
+// Adapt the provwasm page request to the core type.
+func pageRequestFor(input *PageRequest) *query.PageRequest {
+	if input == nil {
+		return nil
+	}
+	return &query.PageRequest{
+		Key:        input.Key,
+		Offset:     input.Offset,
+		Limit:      input.Limit,
+		CountTotal: input.CountTotal,
+	}
+}
+
+// Adapt the core page response to provwasm format.
+func pageResponseFor(input *query.PageResponse) *PageResponse {
+	if input == nil {
+		return nil
+	}
+	return &PageResponse{NextKey: input.NextKey, Total: input.Total}
+}
+
+// Adapt the core marker history entry to provwasm format.
+func historyEntryFor(input types.MarkerHistoryEntry) HistoryEntry {
+	return HistoryEntry{
+		Denom:    input.Denom,
+		Sequence: input.Sequence,
+		Height:   input.Height,
+		Time:     input.Time,
+		Action:   strings.ToLower(strings.TrimPrefix(input.Action.String(), "MARKER_HISTORY_ACTION_")),
+		Actor:    input.Actor,
+		Amount:   input.Amount.String(),
+	}
+}
+
+// Adapt the core marker supply report to provwasm format.
+func supplyReportFor(input types.MarkerSupplyReport) SupplyReport {
+	report := SupplyReport{
+		Denom:          input.Denom,
+		Status:         markerStatusFor(input.Status),
+		SupplyFixed:    input.SupplyFixed,
+		RequiredSupply: input.RequiredSupply.String(),
+		TotalSupply:    input.TotalSupply.String(),
+		Escrow:         input.Escrow.String(),
+		Circulation:    input.Circulation.String(),
+	}
+	if a := input.LastAdjustment; a != nil {
+		report.LastAdjustment = &SupplyAdjustment{
+			PreviousSupply: a.PreviousSupply.String(),
+			RequiredSupply: a.RequiredSupply.String(),
+			Height:         a.Height,
+			Time:           a.Time,
+		}
+	}
+	return report
+}
+
+// Adapt the core scheduled withdrawal to provwasm format.
+func withdrawScheduleFor(input types.MarkerWithdrawSchedule) WithdrawSchedule {
+	return WithdrawSchedule{
+		ID:            input.Id,
+		Denom:         input.Denom,
+		Administrator: input.Administrator,
+		Recipient:     input.ToAddress,
+		Coins:         input.Amount,
+		ReleaseTime:   input.ReleaseTime,
+	}
+}
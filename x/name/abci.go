@@ -0,0 +1,20 @@
+package name
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/provenance-io/provenance/x/name/keeper"
+	"github.com/provenance-io/provenance/x/name/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker returns the end blocker for the name module.
+func EndBlocker(ctx sdk.Context, req abci.RequestEndBlock, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+	// Release the names with leases that have expired.
+	k.ReleaseExpiredNames(ctx, types.MaxExpiredNamesPerBlock)
+}
@@ -19,6 +19,7 @@ import (
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	testnet "github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 
 	"github.com/provenance-io/provenance/testutil"
 	namecli "github.com/provenance-io/provenance/x/name/client/cli"
@@ -72,6 +73,10 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	for i := 0; i < s.acc2NameCount; i++ {
 		nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord(toWritten(i), s.account2Addr, false))
 	}
+	loanOwner := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("loanowner")).PubKey().Address())
+	loan := nametypes.NewNameRecord("loan", loanOwner, false)
+	loan.Target = nametypes.NewNameTarget(nametypes.TargetTypeScope, "scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel")
+	nameData.Bindings = append(nameData.Bindings, loan)
 	nameDataBz, err := cfg.Codec.MarshalJSON(&nameData)
 	s.Require().NoError(err)
 	genesisState[nametypes.ModuleName] = nameDataBz
@@ -190,15 +195,17 @@ func (s *IntegrationTestSuite) TestGetNameParamsCmd() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			"{\"max_segment_length\":32,\"min_segment_length\":1,\"max_name_levels\":2,\"allow_unrestricted_names\":true}",
+			"{\"max_segment_length\":32,\"min_segment_length\":1,\"max_name_levels\":2,\"allow_unrestricted_names\":true,\"lease_duration\":\"0s\",\"renewal_fees\":[]}",
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
 			`allow_unrestricted_names: true
+lease_duration: 0s
 max_name_levels: 2
 max_segment_length: 32
-min_segment_length: 1`,
+min_segment_length: 1
+renewal_fees: []`,
 		},
 	}
 
@@ -225,12 +232,18 @@ func (s *IntegrationTestSuite) TestResolveNameCommand() {
 		{
 			"query name, json output",
 			[]string{"attribute", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			fmt.Sprintf("{\"address\":\"%s\"}", s.accountAddr.String()),
+			fmt.Sprintf("{\"address\":\"%[1]s\",\"target\":{\"type\":\"TARGET_TYPE_ACCOUNT\",\"value\":\"%[1]s\"}}", s.accountAddr.String()),
 		},
 		{
 			"query name, text output",
 			[]string{"attribute", fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
-			fmt.Sprintf("address: %s", s.accountAddr.String()),
+			fmt.Sprintf("address: %[1]s\ntarget:\n  type: TARGET_TYPE_ACCOUNT\n  value: %[1]s", s.accountAddr.String()),
+		},
+		{
+			"query name with a scope target, json output",
+			[]string{"loan", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			fmt.Sprintf("{\"address\":\"%s\",\"target\":{\"type\":\"TARGET_TYPE_SCOPE\",\"value\":\"scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel\"}}",
+				sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("loanowner")).PubKey().Address()).String()),
 		},
 		{
 			"query name that does not exist, text output",
@@ -297,6 +310,88 @@ func (s *IntegrationTestSuite) TestReverseLookupCommand() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestReverseLookupTargetCommand() {
+	testCases := []struct {
+		name           string
+		args           []string
+		expectedOutput string
+	}{
+		{
+			"query scope, json output",
+			[]string{"scope", "scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			"{\"names\":[\"loan\"],\"pagination\":{\"next_key\":null,\"total\":\"0\"}}",
+		},
+		{
+			"query scope, text output",
+			[]string{"scope", "scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel", fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
+			"names:\n- loan\npagination:\n  next_key: null\n  total: \"0\"",
+		},
+		{
+			"query marker without names, json output",
+			[]string{"marker", "unnamedcoin", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			"{\"names\":[],\"pagination\":{\"next_key\":null,\"total\":\"0\"}}",
+		},
+		{
+			"query invalid scope",
+			[]string{"scope", "nhash", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := namecli.ReverseLookupTargetCommand()
+			clientCtx := s.testnet.Validators[0].ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedOutput, strings.TrimSpace(out.String()))
+		})
+	}
+
+	_, err := clitestutil.ExecTestCLICmd(s.testnet.Validators[0].ClientCtx, namecli.ReverseLookupTargetCommand(), []string{"contract", "nhash"})
+	s.Require().EqualError(err, "'contract' is not a valid target type")
+}
+
+func (s *IntegrationTestSuite) TestChildrenCommand() {
+	testCases := []struct {
+		name           string
+		args           []string
+		expectedOutput string
+	}{
+		{
+			"query children, json output",
+			[]string{"attribute", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			"{\"names\":[\"example.attribute\"],\"pagination\":{\"next_key\":null,\"total\":\"0\"}}",
+		},
+		{
+			"query children, text output",
+			[]string{"attribute", fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
+			"names:\n- example.attribute\npagination:\n  next_key: null\n  total: \"0\"",
+		},
+		{
+			"query children of name without children, json output",
+			[]string{"example.attribute", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			"{\"names\":[],\"pagination\":{\"next_key\":null,\"total\":\"0\"}}",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := namecli.ChildrenCommand()
+			clientCtx := s.testnet.Validators[0].ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedOutput, strings.TrimSpace(out.String()))
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetBindNameCommand() {
 
 	testCases := []struct {
@@ -340,6 +435,42 @@ func (s *IntegrationTestSuite) TestGetBindNameCommand() {
 			},
 			false, &sdk.TxResponse{}, 18,
 		},
+		{
+			"should fail to bind name with a marker target the signer does not administer",
+			namecli.GetBindNameCmd(),
+			[]string{"bindmarker", s.testnet.Validators[0].Address.String(), "attribute",
+				"--target-type=marker", "--target=nhash",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 4,
+		},
+		{
+			"should fail to bind name with a target value but no target type",
+			namecli.GetBindNameCmd(),
+			[]string{"bindmarker", s.testnet.Validators[0].Address.String(), "attribute",
+				"--target=nhash",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"should fail to bind name with an invalid scope target",
+			namecli.GetBindNameCmd(),
+			[]string{"bindscope", s.testnet.Validators[0].Address.String(), "attribute",
+				"--target-type=scope", "--target=nhash",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -434,6 +565,294 @@ func (s *IntegrationTestSuite) TestGetDeleteNameCmd() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetTransferNameCmd() {
+	newOwner := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("newnameowner")).PubKey().Address())
+
+	testCases := []struct {
+		name         string
+		cmd          *cobra.Command
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"bind name for transfer",
+			namecli.GetBindNameCmd(),
+			[]string{"totransfer", s.testnet.Validators[0].Address.String(), "attribute",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"should fail to transfer name, invalid new owner",
+			namecli.GetTransferNameCmd(),
+			[]string{"totransfer.attribute", "invalid",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"should transfer name",
+			namecli.GetTransferNameCmd(),
+			[]string{"totransfer.attribute", newOwner.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"should fail to transfer name, not authorized",
+			namecli.GetTransferNameCmd(),
+			[]string{"totransfer.attribute", s.accountAddr.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 4,
+		},
+		{
+			"should fail to transfer name that does not exist",
+			namecli.GetTransferNameCmd(),
+			[]string{"dne", newOwner.String(),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 18,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			clientCtx := s.testnet.Validators[0].ClientCtx
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, tc.cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.JSONCodec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetModifyNameCmd() {
+	// Proposal commands get their tx flags from the gov submit-proposal command.
+	proposalCmd := func() *cobra.Command {
+		cmd := namecli.GetModifyNameProposalCmd()
+		flags.AddTxFlagsToCmd(cmd)
+		return cmd
+	}
+
+	testCases := []struct {
+		name         string
+		cmd          *cobra.Command
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"bind name for modify",
+			namecli.GetBindNameCmd(),
+			[]string{"tomodify", s.testnet.Validators[0].Address.String(), "attribute",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"should fail to modify name, invalid restricted value",
+			namecli.GetModifyNameCmd(),
+			[]string{"tomodify.attribute", "maybe",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+		{
+			"should modify name",
+			namecli.GetModifyNameCmd(),
+			[]string{"tomodify.attribute", "true",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"should fail to modify name, already restricted",
+			namecli.GetModifyNameCmd(),
+			[]string{"tomodify.attribute", "true",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 18,
+		},
+		{
+			"should fail to modify name, not authorized",
+			namecli.GetModifyNameCmd(),
+			[]string{"attribute", "false",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 4,
+		},
+		{
+			"should fail to modify name that does not exist",
+			namecli.GetModifyNameCmd(),
+			[]string{"dne", "false",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 18,
+		},
+		{
+			"should submit modify name proposal",
+			proposalCmd(),
+			[]string{"attribute", "true",
+				fmt.Sprintf("--%s=%s", govcli.FlagTitle, "Restrict attribute"),
+				fmt.Sprintf("--%s=%s", govcli.FlagDescription, "Only allow the owner to bind names under attribute"),
+				fmt.Sprintf("--%s=%s", govcli.FlagDeposit, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"should fail to submit modify name proposal, invalid restricted value",
+			proposalCmd(),
+			[]string{"attribute", "maybe",
+				fmt.Sprintf("--%s=%s", govcli.FlagTitle, "Restrict attribute"),
+				fmt.Sprintf("--%s=%s", govcli.FlagDescription, "Only allow the owner to bind names under attribute"),
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			true, &sdk.TxResponse{}, 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			clientCtx := s.testnet.Validators[0].ClientCtx
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, tc.cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.JSONCodec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code)
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetRenewNameCmd() {
+	testCases := []struct {
+		name         string
+		cmd          *cobra.Command
+		args         []string
+		expectErr    bool
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"bind name for renew",
+			namecli.GetBindNameCmd(),
+			[]string{"torenew", s.testnet.Validators[0].Address.String(), "attribute",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 0,
+		},
+		{
+			"should fail to renew name, name does not expire",
+			namecli.GetRenewNameCmd(),
+			[]string{"torenew.attribute",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 18,
+		},
+		{
+			"should fail to renew name, not authorized",
+			namecli.GetRenewNameCmd(),
+			[]string{"attribute",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 4,
+		},
+		{
+			"should fail to renew name that does not exist",
+			namecli.GetRenewNameCmd(),
+			[]string{"dne",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+			},
+			false, &sdk.TxResponse{}, 18,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			clientCtx := s.testnet.Validators[0].ClientCtx
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, tc.cmd, tc.args)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NoError(clientCtx.JSONCodec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code)
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestPaginationWithPageKey() {
 	asJson := fmt.Sprintf("--%s=json", tmcli.OutputFlag)
 
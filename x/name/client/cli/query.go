@@ -31,6 +31,8 @@ func GetQueryCmd() *cobra.Command {
 		QueryParamsCmd(),
 		ResolveNameCommand(),
 		ReverseLookupCommand(),
+		ChildrenCommand(),
+		ReverseLookupTargetCommand(),
 	)
 
 	return queryCmd
@@ -141,6 +143,92 @@ $ %[1]s query name loopup pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --page=2 --l
 	return cmd
 }
 
+// ReverseLookupTargetCommand returns the command handler for finding all names that resolve to a typed target.
+func ReverseLookupTargetCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lookup-target [account|scope|scopespec|marker] [target]",
+		Short: "Reverse lookup of all names that resolve to a given typed target",
+		Example: fmt.Sprintf(`$ %[1]s query name lookup-target scope scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
+$ %[1]s query name lookup-target marker nhash --page=2 --limit=100
+`, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			targetType, err := types.TargetTypeFromString(args[0])
+			if err != nil {
+				return err
+			}
+			target := strings.TrimSpace(args[1])
+
+			var response *types.QueryReverseLookupTargetResponse
+			if response, err = queryClient.ReverseLookupTarget(
+				context.Background(),
+				&types.QueryReverseLookupTargetRequest{Type: targetType, Value: target, Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query reverse lookup against \"%s\": %v\n", target, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "lookup-target")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ChildrenCommand returns the command handler for listing the names directly below a name.
+func ChildrenCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "children [name]",
+		Short: "List the names bound directly below a given name",
+		Example: fmt.Sprintf(`$ %[1]s query name children pb
+$ %[1]s query name children sso.pb --page=2 --limit=100
+`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(withPageKeyDecoded(cmd.Flags()))
+			if err != nil {
+				return err
+			}
+
+			name := strings.ToLower(strings.TrimSpace(args[0]))
+
+			var response *types.QueryChildrenResponse
+			if response, err = queryClient.Children(
+				context.Background(),
+				&types.QueryChildrenRequest{Name: name, Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query children of name \"%s\": %v\n", name, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "children")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // sdk ReadPageRequest expects binary but we encoded to base64 in our marshaller
 func withPageKeyDecoded(flagSet *flag.FlagSet) *flag.FlagSet {
 	encoded, err := flagSet.GetString(flags.FlagPageKey)
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/provenance-io/provenance/x/name/types"
@@ -11,6 +12,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -19,6 +22,12 @@ import (
 // The flag for created restricted names
 const flagRestricted = "restrict"
 
+// The flags for the typed target a created name resolves to
+const (
+	flagTargetType = "target-type"
+	flagTarget     = "target"
+)
+
 // NewTxCmd is the top-level command for name CLI transactions.
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -31,6 +40,9 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		GetBindNameCmd(),
 		GetDeleteNameCmd(),
+		GetTransferNameCmd(),
+		GetModifyNameCmd(),
+		GetRenewNameCmd(),
 	)
 	return txCmd
 }
@@ -38,10 +50,14 @@ func NewTxCmd() *cobra.Command {
 // GetBindNameCmd is the CLI command for binding a name to an address.
 func GetBindNameCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "bind [name] [address] [root]",
-		Short:   "Bind a name to an address under the given root name in the provenance blockchain",
-		Example: fmt.Sprintf(`$ %s tx name bind sample pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk root.example`, version.AppName),
-		Args:    cobra.ExactArgs(3),
+		Use:   "bind [name] [address] [root]",
+		Short: "Bind a name to an address under the given root name in the provenance blockchain",
+		Long: `Bind a name to an address under the given root name in the provenance blockchain.
+The name resolves to the address unless a typed target (account, scope, scopespec or marker) is given, the
+address remains the owner of the name either way.`,
+		Example: fmt.Sprintf(`$ %[1]s tx name bind sample pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk root.example
+$ %[1]s tx name bind loan123 pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk originator.pb --target-type scope --target scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel`, version.AppName),
+		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -51,12 +67,16 @@ func GetBindNameCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			record := types.NewNameRecord(
+				strings.ToLower(args[0]),
+				address,
+				viper.GetBool(flagRestricted),
+			)
+			if record.Target, err = parseTargetFlags(cmd); err != nil {
+				return err
+			}
 			msg := types.NewMsgBindNameRequest(
-				types.NewNameRecord(
-					strings.ToLower(args[0]),
-					address,
-					viper.GetBool(flagRestricted),
-				),
+				record,
 				types.NewNameRecord(
 					strings.ToLower(args[2]),
 					clientCtx.FromAddress,
@@ -67,6 +87,8 @@ func GetBindNameCmd() *cobra.Command {
 		},
 	}
 	cmd.Flags().BoolP(flagRestricted, "r", true, "Restrict creation of child names to owner only")
+	cmd.Flags().String(flagTargetType, "", "The type of the target the name resolves to: account, scope, scopespec or marker")
+	cmd.Flags().String(flagTarget, "", "The bech32 address or marker denom the name resolves to in place of the address")
 
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
@@ -97,3 +119,152 @@ func GetDeleteNameCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetTransferNameCmd is the CLI command for binding an existing name to a new address.
+func GetTransferNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer [name] [new-owner]",
+		Short:   "Transfer the ownership of a bound name to a new address in the provenance blockchain",
+		Example: fmt.Sprintf(`$ %s tx name transfer sample.root.example pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			newOwner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgTransferNameRequest(
+				strings.TrimSpace(strings.ToLower(args[0])),
+				clientCtx.FromAddress,
+				newOwner,
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetModifyNameCmd is the CLI command for changing the restriction on a bound name.
+func GetModifyNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "modify [name] [restricted]",
+		Short:   "Change whether a bound name restricts the creation of child names to its owner",
+		Example: fmt.Sprintf(`$ %s tx name modify sample.root.example false`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			restricted, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgModifyNameRequest(
+				strings.TrimSpace(strings.ToLower(args[0])),
+				clientCtx.FromAddress,
+				restricted,
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetRenewNameCmd is the CLI command for extending the lease on a bound name.
+func GetRenewNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "renew [name]",
+		Short:   "Extend the lease on a bound name in the provenance blockchain, paying the renewal fee",
+		Example: fmt.Sprintf(`$ %s tx name renew sample.root.example`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRenewNameRequest(
+				strings.TrimSpace(strings.ToLower(args[0])),
+				clientCtx.FromAddress,
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetModifyNameProposalCmd is the CLI command for submitting a governance proposal to change the restriction on a
+// bound root name, such as one created through a create root name proposal.
+func GetModifyNameProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "modify-name [name] [restricted]",
+		Short:   "Submit a proposal to change whether a bound root name restricts the creation of child names to its owner",
+		Example: fmt.Sprintf(`$ %s tx gov submit-proposal modify-name example false --title "Unrestrict example" --description "Open example for sub names" --deposit 1000nhash`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			restricted, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+			content := types.NewModifyNameProposal(title, description, strings.TrimSpace(strings.ToLower(args[0])), restricted)
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	return cmd
+}
+
+// parseTargetFlags returns the typed target set by the target flags, nil when neither flag is set.
+func parseTargetFlags(cmd *cobra.Command) (*types.NameTarget, error) {
+	targetType, err := cmd.Flags().GetString(flagTargetType)
+	if err != nil {
+		return nil, err
+	}
+	value, err := cmd.Flags().GetString(flagTarget)
+	if err != nil {
+		return nil, err
+	}
+	if targetType == "" && value == "" {
+		return nil, nil
+	}
+	if targetType == "" || value == "" {
+		return nil, fmt.Errorf("both --%s and --%s are required to set a target", flagTargetType, flagTarget)
+	}
+	t, err := types.TargetTypeFromString(targetType)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewNameTarget(t, strings.TrimSpace(value)), nil
+}
@@ -0,0 +1,11 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/provenance-io/provenance/x/name/client/cli"
+	"github.com/provenance-io/provenance/x/name/client/rest"
+)
+
+// ModifyNameProposalHandler is the gov client handler for submitting a modify name proposal.
+var ModifyNameProposalHandler = govclient.NewProposalHandler(cli.GetModifyNameProposalCmd, rest.ModifyNameProposalRESTHandler)
@@ -10,6 +10,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/provenance-io/provenance/x/name/types"
 )
@@ -29,6 +31,8 @@ type BindNameRequest struct {
 	Address    string       `json:"address"`
 	RootName   string       `json:"root"`
 	Restricted bool         `json:"restricted"`
+	// Optional typed target the name resolves to in place of the address
+	Target *types.NameTarget `json:"target,omitempty"`
 }
 
 // NewBindNameRequestHandlerFn returns an HTTP handler for binding names to addresses.
@@ -56,6 +60,7 @@ func NewBindNameRequestHandlerFn(clientCtx client.Context) http.HandlerFunc {
 				Name:       req.Name,
 				Address:    address.String(),
 				Restricted: req.Restricted,
+				Target:     req.Target,
 			},
 			types.NameRecord{
 				Name:    req.RootName,
@@ -69,3 +74,48 @@ func NewBindNameRequestHandlerFn(clientCtx client.Context) http.HandlerFunc {
 		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
 	}
 }
+
+// ModifyNameProposalRequest type for submitting a proposal to change the restriction on a name.
+type ModifyNameProposalRequest struct {
+	BaseReq     rest.BaseReq `json:"base_req"`
+	Title       string       `json:"title"`
+	Description string       `json:"description"`
+	Name        string       `json:"name"`
+	Restricted  bool         `json:"restricted"`
+	Deposit     sdk.Coins    `json:"deposit"`
+}
+
+// ModifyNameProposalRESTHandler returns the gov REST handler for submitting a modify name proposal.
+func ModifyNameProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "modify_name",
+		Handler:  NewModifyNameProposalHandlerFn(clientCtx),
+	}
+}
+
+// NewModifyNameProposalHandlerFn returns an HTTP handler for submitting a modify name proposal.
+func NewModifyNameProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ModifyNameProposalRequest
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		content := types.NewModifyNameProposal(req.Title, req.Description, req.Name, req.Restricted)
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, fromAddr)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
@@ -4,10 +4,13 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
 	"github.com/golang/protobuf/proto"
+	"github.com/google/uuid"
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
@@ -18,6 +21,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	simapp "github.com/provenance-io/provenance/app"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
 	"github.com/provenance-io/provenance/x/name"
 	"github.com/provenance-io/provenance/x/name/keeper"
 	nametypes "github.com/provenance-io/provenance/x/name/types"
@@ -52,6 +57,26 @@ func TestCreateName(t *testing.T) {
 	addr1 := sdk.AccAddress(priv1.PubKey().Address())
 	priv2, _ := secp256r1.GenPrivKey()
 	addr2 := sdk.AccAddress(priv2.PubKey().Address())
+	scopeID := metadatatypes.ScopeMetadataAddress(uuid.New())
+	otherScopeID := metadatatypes.ScopeMetadataAddress(uuid.New())
+	missingScopeID := metadatatypes.ScopeMetadataAddress(uuid.New())
+	specID := metadatatypes.ScopeSpecMetadataAddress(uuid.New())
+	scopeRecord := nametypes.NewNameRecord("scope", addr2, false)
+	scopeRecord.Target = nametypes.NewNameTarget(nametypes.TargetTypeScope, scopeID.String())
+	valueOwnerRecord := nametypes.NewNameRecord("valueowner", addr2, false)
+	valueOwnerRecord.Target = nametypes.NewNameTarget(nametypes.TargetTypeScope, otherScopeID.String())
+	otherScopeRecord := nametypes.NewNameRecord("otherscope", addr1, false)
+	otherScopeRecord.Target = nametypes.NewNameTarget(nametypes.TargetTypeScope, scopeID.String())
+	missingScopeRecord := nametypes.NewNameRecord("missingscope", addr2, false)
+	missingScopeRecord.Target = nametypes.NewNameTarget(nametypes.TargetTypeScope, missingScopeID.String())
+	specRecord := nametypes.NewNameRecord("spec", addr2, false)
+	specRecord.Target = nametypes.NewNameTarget(nametypes.TargetTypeScopeSpecification, specID.String())
+	markerRecord := nametypes.NewNameRecord("marker", addr2, false)
+	markerRecord.Target = nametypes.NewNameTarget(nametypes.TargetTypeMarker, "namecoin")
+	otherMarkerRecord := nametypes.NewNameRecord("othermarker", addr1, false)
+	otherMarkerRecord.Target = nametypes.NewNameTarget(nametypes.TargetTypeMarker, "othercoin")
+	badTargetRecord := nametypes.NewNameRecord("badtarget", addr2, false)
+	badTargetRecord.Target = nametypes.NewNameTarget(nametypes.TargetTypeScope, addr2.String())
 
 	tests := []struct {
 		name          string
@@ -65,6 +90,50 @@ func TestCreateName(t *testing.T) {
 			expectedError: nil,
 			expectedEvent: nametypes.NewEventNameBound(addr2.String(), "new.example.name"),
 		},
+		{
+			name:          "create name record with a scope target",
+			msg:           nametypes.NewMsgBindNameRequest(scopeRecord, nametypes.NewNameRecord("example.name", addr1, false)),
+			expectedError: nil,
+			expectedEvent: nametypes.NewEventNameBound(addr2.String(), "scope.example.name"),
+		},
+		{
+			name:          "create name record with a scope target of a value owner",
+			msg:           nametypes.NewMsgBindNameRequest(valueOwnerRecord, nametypes.NewNameRecord("example.name", addr1, false)),
+			expectedError: nil,
+			expectedEvent: nametypes.NewEventNameBound(addr2.String(), "valueowner.example.name"),
+		},
+		{
+			name:          "create name record with a scope target the signer does not own",
+			msg:           nametypes.NewMsgBindNameRequest(otherScopeRecord, nametypes.NewNameRecord("name", addr2, false)),
+			expectedError: sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not an owner of scope %s", addr2, scopeID),
+		},
+		{
+			name:          "create name record with a scope target that does not exist",
+			msg:           nametypes.NewMsgBindNameRequest(missingScopeRecord, nametypes.NewNameRecord("example.name", addr1, false)),
+			expectedError: sdkerrors.Wrapf(nametypes.ErrInvalidTarget, "scope %s not found", missingScopeID),
+		},
+		{
+			name:          "create name record with a scope specification target",
+			msg:           nametypes.NewMsgBindNameRequest(specRecord, nametypes.NewNameRecord("example.name", addr1, false)),
+			expectedError: nil,
+			expectedEvent: nametypes.NewEventNameBound(addr2.String(), "spec.example.name"),
+		},
+		{
+			name:          "create name record with a marker target",
+			msg:           nametypes.NewMsgBindNameRequest(markerRecord, nametypes.NewNameRecord("example.name", addr1, false)),
+			expectedError: nil,
+			expectedEvent: nametypes.NewEventNameBound(addr2.String(), "marker.example.name"),
+		},
+		{
+			name:          "create name record with a marker target the signer does not administer",
+			msg:           nametypes.NewMsgBindNameRequest(otherMarkerRecord, nametypes.NewNameRecord("example.name", addr1, false)),
+			expectedError: sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s does not have admin access on marker %s", addr1, "othercoin"),
+		},
+		{
+			name:          "create name record with an invalid target",
+			msg:           nametypes.NewMsgBindNameRequest(badTargetRecord, nametypes.NewNameRecord("example.name", addr1, false)),
+			expectedError: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid scope address %q: invalid name target", addr2.String())),
+		},
 		{
 			name:          "create bad name record",
 			msg:           nametypes.NewMsgBindNameRequest(nametypes.NewNameRecord("new", addr2, false), nametypes.NewNameRecord("foo.name", addr1, false)),
@@ -92,7 +161,16 @@ func TestCreateName(t *testing.T) {
 
 	app.NameKeeper.InitGenesis(ctx, nameData)
 
-	app.NameKeeper = keeper.NewKeeper(app.AppCodec(), app.GetKey(nametypes.ModuleName), app.GetSubspace(nametypes.ModuleName))
+	app.MetadataKeeper.SetScope(ctx, *metadatatypes.NewScope(scopeID, nil, []metadatatypes.Party{{Address: addr1.String(), Role: metadatatypes.PartyType_PARTY_TYPE_OWNER}}, nil, addr1.String()))
+	app.MetadataKeeper.SetScope(ctx, *metadatatypes.NewScope(otherScopeID, nil, []metadatatypes.Party{{Address: addr2.String(), Role: metadatatypes.PartyType_PARTY_TYPE_OWNER}}, nil, addr1.String()))
+	app.MetadataKeeper.SetScopeSpecification(ctx, *metadatatypes.NewScopeSpecification(specID, nil, []string{addr1.String()}, []metadatatypes.PartyType{metadatatypes.PartyType_PARTY_TYPE_OWNER}, nil))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, markertypes.NewEmptyMarkerAccount("namecoin", addr1.String(),
+		[]markertypes.AccessGrant{*markertypes.NewAccessGrant(addr1, []markertypes.Access{markertypes.Access_Admin})})))
+	require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, markertypes.NewEmptyMarkerAccount("othercoin", addr2.String(),
+		[]markertypes.AccessGrant{*markertypes.NewAccessGrant(addr2, []markertypes.Access{markertypes.Access_Admin})})))
+
+	app.NameKeeper = keeper.NewKeeper(app.AppCodec(), app.GetKey(nametypes.ModuleName), app.GetSubspace(nametypes.ModuleName), app.BankKeeper, app.MetadataKeeper)
+	app.NameKeeper.SetMarkerKeeper(app.MarkerKeeper)
 	handler := name.NewHandler(app.NameKeeper)
 
 	for _, tc := range tests {
@@ -109,6 +187,10 @@ func TestCreateName(t *testing.T) {
 			}
 		})
 	}
+
+	record, err := app.NameKeeper.GetRecordByName(ctx, "scope.example.name")
+	require.NoError(t, err)
+	require.Equal(t, scopeRecord.Target, record.Target, "the bound name resolves to its target")
 }
 
 //  delete name record
@@ -152,7 +234,161 @@ func TestDeleteName(t *testing.T) {
 
 	app.NameKeeper.InitGenesis(ctx, nameData)
 
-	app.NameKeeper = keeper.NewKeeper(app.AppCodec(), app.GetKey(nametypes.ModuleName), app.GetSubspace(nametypes.ModuleName))
+	app.NameKeeper = keeper.NewKeeper(app.AppCodec(), app.GetKey(nametypes.ModuleName), app.GetSubspace(nametypes.ModuleName), app.BankKeeper, app.MetadataKeeper)
+	handler := name.NewHandler(app.NameKeeper)
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			response, err := handler(ctx, tc.msg)
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			if tc.expectedEvent != nil {
+				result := containsMessage(response, tc.expectedEvent)
+				require.True(t, result, fmt.Sprintf("Expected typed event was not found: %v", tc.expectedEvent))
+			}
+		})
+	}
+}
+
+//  transfer name record
+func TestTransferName(t *testing.T) {
+	priv1 := secp256k1.GenPrivKey()
+	addr1 := sdk.AccAddress(priv1.PubKey().Address())
+	priv2, _ := secp256r1.GenPrivKey()
+	addr2 := sdk.AccAddress(priv2.PubKey().Address())
+
+	tests := []struct {
+		name          string
+		expectedError error
+		msg           *nametypes.MsgTransferNameRequest
+		expectedEvent proto.Message
+	}{
+		{
+			name:          "transfer name record",
+			msg:           nametypes.NewMsgTransferNameRequest("example.name", addr1, addr2),
+			expectedError: nil,
+			expectedEvent: nametypes.NewEventNameTransferred("example.name", addr1.String(), addr2.String()),
+		},
+		{
+			name:          "transfer name record not owned by signer",
+			msg:           nametypes.NewMsgTransferNameRequest("example.name", addr1, addr2),
+			expectedError: sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "msg sender cannot transfer name"),
+		},
+		{
+			name:          "transfer bad name record",
+			msg:           nametypes.NewMsgTransferNameRequest("foo.name", addr1, addr2),
+			expectedError: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "name does not exist"),
+		},
+		{
+			name:          "transfer name record to current owner",
+			msg:           nametypes.NewMsgTransferNameRequest("name", addr1, addr1),
+			expectedError: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "new owner must be different from the current owner"),
+		},
+	}
+
+	acc1 := &authtypes.BaseAccount{
+		Address: addr1.String(),
+	}
+	accs := authtypes.GenesisAccounts{acc1}
+	app := simapp.SetupWithGenesisAccounts(accs)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	var nameData nametypes.GenesisState
+	nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord("name", addr1, false))
+	nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord("example.name", addr1, true))
+	nameData.Params.AllowUnrestrictedNames = false
+	nameData.Params.MaxNameLevels = 16
+	nameData.Params.MinSegmentLength = 2
+	nameData.Params.MaxSegmentLength = 16
+
+	app.NameKeeper.InitGenesis(ctx, nameData)
+
+	app.NameKeeper = keeper.NewKeeper(app.AppCodec(), app.GetKey(nametypes.ModuleName), app.GetSubspace(nametypes.ModuleName), app.BankKeeper, app.MetadataKeeper)
+	handler := name.NewHandler(app.NameKeeper)
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			response, err := handler(ctx, tc.msg)
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			if tc.expectedEvent != nil {
+				result := containsMessage(response, tc.expectedEvent)
+				require.True(t, result, fmt.Sprintf("Expected typed event was not found: %v", tc.expectedEvent))
+			}
+		})
+	}
+
+	record, err := app.NameKeeper.GetRecordByName(ctx, "example.name")
+	require.NoError(t, err)
+	require.Equal(t, addr2.String(), record.Address)
+	require.True(t, record.Restricted, "restriction is kept")
+	records, err := app.NameKeeper.GetRecordsByAddress(ctx, addr1)
+	require.NoError(t, err)
+	require.Equal(t, nametypes.NameRecords{nametypes.NewNameRecord("name", addr1, false)}, records)
+	records, err = app.NameKeeper.GetRecordsByAddress(ctx, addr2)
+	require.NoError(t, err)
+	require.Equal(t, nametypes.NameRecords{*record}, records)
+}
+
+func TestModifyName(t *testing.T) {
+	priv1 := secp256k1.GenPrivKey()
+	addr1 := sdk.AccAddress(priv1.PubKey().Address())
+	priv2, _ := secp256r1.GenPrivKey()
+	addr2 := sdk.AccAddress(priv2.PubKey().Address())
+
+	tests := []struct {
+		name          string
+		expectedError error
+		msg           *nametypes.MsgModifyNameRequest
+		expectedEvent proto.Message
+	}{
+		{
+			name:          "modify name record",
+			msg:           nametypes.NewMsgModifyNameRequest("example.name", addr1, false),
+			expectedError: nil,
+			expectedEvent: nametypes.NewEventNameModified(addr1.String(), "example.name", false),
+		},
+		{
+			name:          "modify name record without change",
+			msg:           nametypes.NewMsgModifyNameRequest("example.name", addr1, false),
+			expectedError: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "name example.name restricted is already false"),
+		},
+		{
+			name:          "modify name record not owned by signer",
+			msg:           nametypes.NewMsgModifyNameRequest("example.name", addr2, true),
+			expectedError: sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "msg sender cannot modify name"),
+		},
+		{
+			name:          "modify bad name record",
+			msg:           nametypes.NewMsgModifyNameRequest("foo.name", addr1, true),
+			expectedError: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "name does not exist"),
+		},
+	}
+
+	acc1 := &authtypes.BaseAccount{
+		Address: addr1.String(),
+	}
+	accs := authtypes.GenesisAccounts{acc1}
+	app := simapp.SetupWithGenesisAccounts(accs)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	var nameData nametypes.GenesisState
+	nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord("name", addr1, false))
+	nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord("example.name", addr1, true))
+	nameData.Params.AllowUnrestrictedNames = false
+	nameData.Params.MaxNameLevels = 16
+	nameData.Params.MinSegmentLength = 2
+	nameData.Params.MaxSegmentLength = 16
+
+	app.NameKeeper.InitGenesis(ctx, nameData)
+
+	app.NameKeeper = keeper.NewKeeper(app.AppCodec(), app.GetKey(nametypes.ModuleName), app.GetSubspace(nametypes.ModuleName), app.BankKeeper, app.MetadataKeeper)
 	handler := name.NewHandler(app.NameKeeper)
 
 	for _, tc := range tests {
@@ -169,4 +405,102 @@ func TestDeleteName(t *testing.T) {
 			}
 		})
 	}
+
+	record, err := app.NameKeeper.GetRecordByName(ctx, "example.name")
+	require.NoError(t, err)
+	require.Equal(t, nametypes.NewNameRecord("example.name", addr1, false), *record)
+	records, err := app.NameKeeper.GetRecordsByAddress(ctx, addr1)
+	require.NoError(t, err)
+	require.Contains(t, records, *record)
+}
+
+func TestRenewName(t *testing.T) {
+	priv1 := secp256k1.GenPrivKey()
+	addr1 := sdk.AccAddress(priv1.PubKey().Address())
+	priv2, _ := secp256r1.GenPrivKey()
+	addr2 := sdk.AccAddress(priv2.PubKey().Address())
+	blockTime := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		expectedError error
+		msg           sdk.Msg
+		expectedEvent proto.Message
+	}{
+		{
+			name:          "bind leased name record",
+			msg:           nametypes.NewMsgBindNameRequest(nametypes.NewNameRecord("leased", addr1, false), nametypes.NewNameRecord("name", addr1, false)),
+			expectedError: nil,
+			expectedEvent: nametypes.NewEventNameBound(addr1.String(), "leased.name"),
+		},
+		{
+			name:          "renew name record",
+			msg:           nametypes.NewMsgRenewNameRequest("leased.name", addr1),
+			expectedError: nil,
+			expectedEvent: nametypes.NewEventNameRenewed(addr1.String(), "leased.name", blockTime.Add(2*time.Hour)),
+		},
+		{
+			name:          "renew name record that does not expire",
+			msg:           nametypes.NewMsgRenewNameRequest("name", addr1),
+			expectedError: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "name name does not expire"),
+		},
+		{
+			name:          "renew name record not owned by signer",
+			msg:           nametypes.NewMsgRenewNameRequest("leased.name", addr2),
+			expectedError: sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "msg sender cannot renew name"),
+		},
+		{
+			name:          "renew bad name record",
+			msg:           nametypes.NewMsgRenewNameRequest("foo.name", addr1),
+			expectedError: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "name does not exist"),
+		},
+	}
+
+	acc1 := &authtypes.BaseAccount{
+		Address: addr1.String(),
+	}
+	accs := authtypes.GenesisAccounts{acc1}
+	app := simapp.SetupWithGenesisAccounts(accs)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(blockTime)
+
+	var nameData nametypes.GenesisState
+	nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord("name", addr1, true))
+	nameData.Params.AllowUnrestrictedNames = true
+	nameData.Params.MaxNameLevels = 16
+	nameData.Params.MinSegmentLength = 2
+	nameData.Params.MaxSegmentLength = 16
+	nameData.Params.LeaseDuration = time.Hour
+
+	app.NameKeeper.InitGenesis(ctx, nameData)
+
+	app.NameKeeper = keeper.NewKeeper(app.AppCodec(), app.GetKey(nametypes.ModuleName), app.GetSubspace(nametypes.ModuleName), app.BankKeeper, app.MetadataKeeper)
+	handler := name.NewHandler(app.NameKeeper)
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			response, err := handler(ctx, tc.msg)
+			if tc.expectedError != nil {
+				require.EqualError(t, err, tc.expectedError.Error())
+			} else {
+				require.NoError(t, err)
+			}
+			if tc.expectedEvent != nil {
+				result := containsMessage(response, tc.expectedEvent)
+				require.True(t, result, fmt.Sprintf("Expected typed event was not found: %v", tc.expectedEvent))
+			}
+		})
+	}
+
+	name.EndBlocker(ctx.WithBlockTime(blockTime.Add(time.Hour)), abci.RequestEndBlock{}, app.NameKeeper)
+	require.True(t, app.NameKeeper.NameExists(ctx, "leased.name"), "renewed name is kept")
+	name.EndBlocker(ctx.WithBlockTime(blockTime.Add(2*time.Hour)), abci.RequestEndBlock{}, app.NameKeeper)
+	require.False(t, app.NameKeeper.NameExists(ctx, "leased.name"), "expired name is released")
+	require.True(t, app.NameKeeper.NameExists(ctx, "name"), "genesis name does not expire")
+
+	// names can not be bound below a name whose lease has ended before it is released
+	_, err := handler(ctx, nametypes.NewMsgBindNameRequest(nametypes.NewNameRecord("ending", addr1, false), nametypes.NewNameRecord("name", addr1, false)))
+	require.NoError(t, err)
+	_, err = handler(ctx.WithBlockTime(blockTime.Add(time.Hour)), nametypes.NewMsgBindNameRequest(
+		nametypes.NewNameRecord("sub", addr1, false), nametypes.NewNameRecord("ending.name", addr1, false)))
+	require.EqualError(t, err, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "parent name lease has ended").Error())
 }
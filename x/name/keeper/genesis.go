@@ -17,6 +17,16 @@ func (keeper Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		if err := keeper.SetNameRecord(ctx, record.Name, addr, record.Restricted); err != nil {
 			panic(err)
 		}
+		if record.Expiration != nil {
+			if err := keeper.SetNameRecordExpiration(ctx, record.Name, record.Expiration); err != nil {
+				panic(err)
+			}
+		}
+		if record.Target != nil {
+			if err := keeper.SetNameRecordTarget(ctx, record.Name, record.Target); err != nil {
+				panic(err)
+			}
+		}
 	}
 }
 
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"bytes"
+	"fmt"
 	"strings"
 	"unicode"
 
@@ -30,6 +31,15 @@ type Keeper struct {
 
 	// The codec codec for binary encoding/decoding.
 	cdc codec.BinaryCodec
+
+	// To collect the fees for renewing the lease on a name.
+	bankKeeper types.BankKeeper
+
+	// To check the signer owns the scope or scope specification target of a name.
+	metadataKeeper types.MetadataKeeper
+
+	// To check the signer administers the marker target of a name, set after the marker keeper is created.
+	markerKeeper types.MarkerKeeper
 }
 
 // NewKeeper returns a name keeper. It handles:
@@ -41,6 +51,8 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	key sdk.StoreKey,
 	paramSpace paramtypes.Subspace,
+	bankKeeper types.BankKeeper,
+	metadataKeeper types.MetadataKeeper,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
@@ -48,10 +60,22 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		storeKey:   key,
-		paramSpace: paramSpace,
-		cdc:        cdc,
+		storeKey:       key,
+		paramSpace:     paramSpace,
+		cdc:            cdc,
+		bankKeeper:     bankKeeper,
+		metadataKeeper: metadataKeeper,
+	}
+}
+
+// SetMarkerKeeper sets the marker keeper used to check marker targets.  The marker keeper depends on this keeper
+// through the attribute keeper, so it is set once both exist and before the keeper is copied into the name module.
+func (keeper *Keeper) SetMarkerKeeper(markerKeeper types.MarkerKeeper) *Keeper {
+	if keeper.markerKeeper != nil {
+		panic("cannot set name marker keeper twice")
 	}
+	keeper.markerKeeper = markerKeeper
+	return keeper
 }
 
 // Logger returns a module-specific logger.
@@ -101,6 +125,14 @@ func (keeper Keeper) SetNameRecord(ctx sdk.Context, name string, addr sdk.AccAdd
 	}
 	indexKey := append(addrPrefix, key...) // [0x04] :: [addr-bytes] :: [name-key-bytes]
 	store.Set(indexKey, bz)
+	// And index by parent name so the children of a name can be listed
+	childKey, err := types.GetChildKey(name)
+	if err != nil {
+		return err
+	}
+	if childKey != nil {
+		store.Set(childKey, []byte{})
+	}
 
 	nameBoundEvent := types.NewEventNameBound(record.Address, name)
 
@@ -200,6 +232,26 @@ func (keeper Keeper) DeleteRecord(ctx sdk.Context, name string) error {
 	if store.Has(indexKey) {
 		store.Delete(indexKey)
 	}
+	// Delete the parent index record
+	childKey, err := types.GetChildKey(record.Name)
+	if err != nil {
+		return err
+	}
+	if childKey != nil {
+		store.Delete(childKey)
+	}
+	// Delete the expiration index record
+	if record.Expiration != nil {
+		store.Delete(types.GetExpirationKey(*record.Expiration, key))
+	}
+	// Delete the target index record
+	if record.Target != nil {
+		targetKey, err := types.GetTargetKey(*record.Target, key)
+		if err != nil {
+			return err
+		}
+		store.Delete(targetKey)
+	}
 
 	nameUnboundEvent := types.NewEventNameUnbound(record.Address, name)
 
@@ -210,6 +262,112 @@ func (keeper Keeper) DeleteRecord(ctx sdk.Context, name string) error {
 	return nil
 }
 
+// updateNameRecord stores changes to an existing name record that keep its owner, updating the address index entry.
+func (keeper Keeper) updateNameRecord(ctx sdk.Context, key []byte, record *types.NameRecord) error {
+	owner, err := sdk.AccAddressFromBech32(record.Address)
+	if err != nil {
+		return err
+	}
+	bz, err := keeper.cdc.Marshal(record)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(key, bz)
+	addrPrefix, err := types.GetAddressKeyPrefix(owner)
+	if err != nil {
+		return err
+	}
+	indexKey := append(addrPrefix, key...) // [0x05] :: [addr-bytes] :: [name-key-bytes]
+	store.Set(indexKey, bz)
+	return nil
+}
+
+// TransferNameRecord binds an existing name to a new address, moving the address index entry to the new address.
+// The record keeps its restriction and lease, and the names below it are not changed.
+func (keeper Keeper) TransferNameRecord(ctx sdk.Context, name string, newOwner sdk.AccAddress) error {
+	if err := types.ValidateAddress(newOwner); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidAddress, err.Error())
+	}
+	record, err := keeper.GetRecordByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	previousOwner, err := sdk.AccAddressFromBech32(record.Address)
+	if err != nil {
+		return err
+	}
+	if previousOwner.Equals(newOwner) {
+		return fmt.Errorf("name %s is already bound to %s", record.Name, newOwner)
+	}
+	key, err := types.GetNameKeyPrefix(name)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	// Delete the previous owner's address index record
+	oldAddrPrefix, err := types.GetAddressKeyPrefix(previousOwner)
+	if err != nil {
+		return err
+	}
+	oldIndexKey := append(oldAddrPrefix, key...) // [0x05] :: [addr-bytes] :: [name-key-bytes]
+	store.Delete(oldIndexKey)
+	// Store the updated record and index it by the new owner's address
+	updated := *record
+	updated.Address = newOwner.String()
+	bz, err := keeper.cdc.Marshal(&updated)
+	if err != nil {
+		return err
+	}
+	store.Set(key, bz)
+	newAddrPrefix, err := types.GetAddressKeyPrefix(newOwner)
+	if err != nil {
+		return err
+	}
+	newIndexKey := append(newAddrPrefix, key...) // [0x05] :: [addr-bytes] :: [name-key-bytes]
+	store.Set(newIndexKey, bz)
+
+	nameTransferredEvent := types.NewEventNameTransferred(record.Name, record.Address, updated.Address)
+
+	return ctx.EventManager().EmitTypedEvent(nameTransferredEvent)
+}
+
+// ModifyNameRecord changes the restriction on an existing name record, updating the owner's address index entry.
+func (keeper Keeper) ModifyNameRecord(ctx sdk.Context, name string, restricted bool) error {
+	record, err := keeper.GetRecordByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	if record.Restricted == restricted {
+		return fmt.Errorf("name %s restricted is already %t", record.Name, restricted)
+	}
+	owner, err := sdk.AccAddressFromBech32(record.Address)
+	if err != nil {
+		return err
+	}
+	key, err := types.GetNameKeyPrefix(name)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	record.Restricted = restricted
+	bz, err := keeper.cdc.Marshal(record)
+	if err != nil {
+		return err
+	}
+	store.Set(key, bz)
+	addrPrefix, err := types.GetAddressKeyPrefix(owner)
+	if err != nil {
+		return err
+	}
+	indexKey := append(addrPrefix, key...) // [0x05] :: [addr-bytes] :: [name-key-bytes]
+	store.Set(indexKey, bz)
+
+	nameModifiedEvent := types.NewEventNameModified(record.Address, record.Name, record.Restricted)
+
+	return ctx.EventManager().EmitTypedEvent(nameModifiedEvent)
+}
+
 // IterateRecords iterates over all the stored name records and passes them to a callback function.
 func (keeper Keeper) IterateRecords(ctx sdk.Context, prefix []byte, handle Handler) error {
 	// Init a name record iterator
@@ -224,6 +382,8 @@ func (keeper Keeper) IterateRecords(ctx sdk.Context, prefix []byte, handle Handl
 			if err := types.ModuleCdc.Unmarshal(iterator.Value(), &record); err != nil {
 				return err
 			}
+			// amino records predate name leases, the missing expiration is decoded as a zero time.
+			record.Expiration = nil
 		} else {
 			if err := keeper.cdc.Unmarshal(iterator.Value(), &record); err != nil {
 				return err
@@ -236,6 +396,21 @@ func (keeper Keeper) IterateRecords(ctx sdk.Context, prefix []byte, handle Handl
 	return nil
 }
 
+// RebuildChildIndex creates the parent index entries for every name record.
+func (keeper Keeper) RebuildChildIndex(ctx sdk.Context) error {
+	store := ctx.KVStore(keeper.storeKey)
+	return keeper.IterateRecords(ctx, types.NameKeyPrefix, func(record types.NameRecord) error {
+		childKey, err := types.GetChildKey(record.Name)
+		if err != nil {
+			return err
+		}
+		if childKey != nil {
+			store.Set(childKey, []byte{})
+		}
+		return nil
+	})
+}
+
 // Normalize returns a name is storage format.
 func (keeper Keeper) Normalize(ctx sdk.Context, name string) (string, error) {
 	comps := make([]string, 0)
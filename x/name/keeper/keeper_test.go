@@ -2,7 +2,9 @@ package keeper_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 	"gopkg.in/yaml.v2"
@@ -12,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/suite"
 
 	nametypes "github.com/provenance-io/provenance/x/name/types"
@@ -86,6 +89,8 @@ func (s *KeeperTestSuite) TestSetup() {
   minsegmentlength: 2
   maxnamelevels: 16
   allowunrestrictednames: false
+  leaseduration: 0s
+  renewalfees: []
 bindings:
 - name: name
   address: %[1]s
@@ -261,6 +266,285 @@ func (s *KeeperTestSuite) TestDeleteRecord() {
 
 }
 
+func (s *KeeperTestSuite) TestChildren() {
+	children := func(name string, pageReq *query.PageRequest) *nametypes.QueryChildrenResponse {
+		res, err := s.app.NameKeeper.Children(sdk.WrapSDKContext(s.ctx),
+			&nametypes.QueryChildrenRequest{Name: name, Pagination: pageReq})
+		s.Require().NoError(err)
+		return res
+	}
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "beta.name", s.user2Addr, false))
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "alpha.name", s.user1Addr, false))
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "sub.alpha.name", s.user1Addr, false))
+
+	s.Run("list children", func() {
+		s.Require().Equal([]string{"alpha.name", "beta.name", "example.name"}, children("name", nil).Names,
+			"only the names directly below the parent are returned")
+		s.Require().Equal([]string{"sub.alpha.name"}, children("ALPHA.name", nil).Names, "name is normalized")
+		s.Require().Empty(children("example.name", nil).Names)
+	})
+	s.Run("page through children", func() {
+		res := children("name", &query.PageRequest{Limit: 2})
+		s.Require().Equal([]string{"alpha.name", "beta.name"}, res.Names)
+		res = children("name", &query.PageRequest{Key: res.Pagination.NextKey})
+		s.Require().Equal([]string{"example.name"}, res.Names)
+	})
+	s.Run("deleted names are removed", func() {
+		s.Require().NoError(s.app.NameKeeper.DeleteRecord(s.ctx, "beta.name"))
+		s.Require().Equal([]string{"alpha.name", "example.name"}, children("name", nil).Names)
+	})
+	s.Run("rebuild the index", func() {
+		store := s.ctx.KVStore(s.app.GetKey(nametypes.StoreKey))
+		iterator := sdk.KVStorePrefixIterator(store, nametypes.ChildKeyPrefix)
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+		s.Require().Empty(children("name", nil).Names)
+
+		s.Require().NoError(s.app.NameKeeper.RebuildChildIndex(s.ctx))
+		s.Require().Equal([]string{"alpha.name", "example.name"}, children("name", nil).Names)
+		s.Require().Equal([]string{"sub.alpha.name"}, children("alpha.name", nil).Names)
+	})
+}
+
+func (s *KeeperTestSuite) TestNameLeases() {
+	start := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+	s.ctx = s.ctx.WithBlockTime(start)
+	params := s.app.NameKeeper.GetParams(s.ctx)
+	params.LeaseDuration = time.Hour
+	params.RenewalFees = []nametypes.RenewalFee{{MaxSegmentLength: 4, Fee: sdk.NewCoins(sdk.NewInt64Coin("nhash", 100))}}
+	s.app.NameKeeper.SetParams(s.ctx, params)
+	s.Require().NoError(app.FundAccount(s.app, s.ctx, s.user1Addr, sdk.NewCoins(sdk.NewInt64Coin("nhash", 150))))
+	expiration := start.Add(time.Hour)
+	expiring := func() (names []string) {
+		store := s.ctx.KVStore(s.app.GetKey(nametypes.StoreKey))
+		iterator := sdk.KVStorePrefixIterator(store, nametypes.ExpirationKeyPrefix)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			record := nametypes.NameRecord{}
+			s.Require().NoError(s.app.AppCodec().Unmarshal(store.Get(nametypes.SplitExpirationKey(iterator.Key())), &record))
+			names = append(names, record.Name)
+		}
+		return
+	}
+
+	s.Run("names are leased while leases are enabled", func() {
+		for _, name := range []string{"abc.name", "longname.name"} {
+			s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, name, s.user1Addr, false))
+			s.Require().NoError(s.app.NameKeeper.StartNameLease(s.ctx, name))
+			record, err := s.app.NameKeeper.GetRecordByName(s.ctx, name)
+			s.Require().NoError(err)
+			s.Require().Equal(expiration, *record.Expiration)
+		}
+		records, err := s.app.NameKeeper.GetRecordsByAddress(s.ctx, s.user1Addr)
+		s.Require().NoError(err)
+		for _, record := range records {
+			if record.Name == "abc.name" {
+				s.Require().Equal(expiration, *record.Expiration, "address index record is updated")
+			}
+		}
+		s.Require().Equal([]string{"abc.name", "longname.name"}, expiring())
+	})
+	s.Run("genesis names do not expire", func() {
+		record, err := s.app.NameKeeper.GetRecordByName(s.ctx, "example.name")
+		s.Require().NoError(err)
+		s.Require().Nil(record.Expiration)
+		err = s.app.NameKeeper.RenewNameRecord(s.ctx, "example.name")
+		s.Require().EqualError(err, "name example.name does not expire")
+	})
+	s.Run("renewal fee is charged by the length of the first segment", func() {
+		s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("nhash", 100)), s.app.NameKeeper.GetRenewalFee(s.ctx, "abc.name"))
+		s.Require().True(s.app.NameKeeper.GetRenewalFee(s.ctx, "longname.name").IsZero())
+
+		s.Require().NoError(s.app.NameKeeper.RenewNameRecord(s.ctx, "abc.name"))
+		record, err := s.app.NameKeeper.GetRecordByName(s.ctx, "abc.name")
+		s.Require().NoError(err)
+		s.Require().Equal(expiration.Add(time.Hour), *record.Expiration, "lease is extended from the current expiration")
+		s.Require().Equal(sdk.NewInt64Coin("nhash", 50), s.app.BankKeeper.GetBalance(s.ctx, s.user1Addr, "nhash"))
+
+		err = s.app.NameKeeper.RenewNameRecord(s.ctx, "abc.name")
+		s.Require().Error(err)
+		s.Require().Contains(err.Error(), "insufficient funds")
+	})
+	s.Run("expired names are released", func() {
+		s.ctx = s.ctx.WithBlockTime(expiration)
+		s.app.NameKeeper.ReleaseExpiredNames(s.ctx, nametypes.MaxExpiredNamesPerBlock)
+		s.Require().False(s.app.NameKeeper.NameExists(s.ctx, "longname.name"))
+		s.Require().True(s.app.NameKeeper.NameExists(s.ctx, "abc.name"), "renewed name is kept")
+		s.Require().True(s.app.NameKeeper.NameExists(s.ctx, "example.name"), "permanent name is kept")
+		s.Require().Equal([]string{"abc.name"}, expiring())
+	})
+	s.Run("transfer keeps the lease", func() {
+		s.Require().NoError(s.app.NameKeeper.TransferNameRecord(s.ctx, "abc.name", s.user2Addr))
+		record, err := s.app.NameKeeper.GetRecordByName(s.ctx, "abc.name")
+		s.Require().NoError(err)
+		s.Require().Equal(expiration.Add(time.Hour), *record.Expiration)
+	})
+	s.Run("deleted names are removed from the expiration index", func() {
+		s.Require().NoError(s.app.NameKeeper.DeleteRecord(s.ctx, "abc.name"))
+		s.Require().Empty(expiring())
+	})
+	s.Run("names below a leased name expire with it", func() {
+		parentExpiration := s.ctx.BlockTime().Add(time.Hour)
+		s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "parent.name", s.user1Addr, false))
+		s.Require().NoError(s.app.NameKeeper.StartNameLease(s.ctx, "parent.name"))
+
+		s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(30 * time.Minute))
+		for _, name := range []string{"child.parent.name", "sub.child.parent.name", "other.parent.name"} {
+			s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, name, s.user2Addr, false))
+			s.Require().NoError(s.app.NameKeeper.StartNameLease(s.ctx, name))
+			record, err := s.app.NameKeeper.GetRecordByName(s.ctx, name)
+			s.Require().NoError(err)
+			s.Require().Equal(parentExpiration, *record.Expiration, "%s lease ends with its parent", name)
+		}
+		s.Require().EqualError(s.app.NameKeeper.RenewNameRecord(s.ctx, "child.parent.name"),
+			"name child.parent.name can not be renewed past the expiration of its parent name")
+
+		// a name below with a later expiration than its parent, such as one leased before the parent was rebound.
+		s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "late.parent.name", s.user2Addr, false))
+		later := parentExpiration.Add(2 * time.Hour)
+		s.Require().NoError(s.app.NameKeeper.SetNameRecordExpiration(s.ctx, "late.parent.name", &later))
+	})
+	s.Run("expired names below a name are released first and count against the limit", func() {
+		s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(30 * time.Minute))
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		expired := func() (count int) {
+			for _, event := range s.ctx.EventManager().Events() {
+				if event.Type == "provenance.name.v1.EventNameExpired" {
+					count++
+				}
+			}
+			return
+		}
+
+		s.app.NameKeeper.ReleaseExpiredNames(s.ctx, 2)
+		s.Require().Equal(2, expired())
+		s.Require().False(s.app.NameKeeper.NameExists(s.ctx, "sub.child.parent.name"))
+		s.Require().False(s.app.NameKeeper.NameExists(s.ctx, "child.parent.name"))
+		s.Require().True(s.app.NameKeeper.NameExists(s.ctx, "other.parent.name"))
+		s.Require().True(s.app.NameKeeper.NameExists(s.ctx, "parent.name"), "released in a later block")
+
+		s.app.NameKeeper.ReleaseExpiredNames(s.ctx, nametypes.MaxExpiredNamesPerBlock)
+		s.Require().Equal(4, expired())
+		s.Require().False(s.app.NameKeeper.NameExists(s.ctx, "other.parent.name"))
+		s.Require().False(s.app.NameKeeper.NameExists(s.ctx, "parent.name"))
+		s.Require().True(s.app.NameKeeper.NameExists(s.ctx, "late.parent.name"), "unexpired lease is kept")
+		res, err := s.app.NameKeeper.Children(sdk.WrapSDKContext(s.ctx), &nametypes.QueryChildrenRequest{Name: "parent.name"})
+		s.Require().NoError(err)
+		s.Require().Equal([]string{"late.parent.name"}, res.Names)
+		s.Require().NoError(s.app.NameKeeper.DeleteRecord(s.ctx, "late.parent.name"))
+		s.Require().Empty(expiring())
+	})
+	s.Run("renew fails while leases are disabled", func() {
+		s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "abc.name", s.user1Addr, false))
+		s.Require().NoError(s.app.NameKeeper.StartNameLease(s.ctx, "abc.name"))
+		params.LeaseDuration = 0
+		s.app.NameKeeper.SetParams(s.ctx, params)
+		s.Require().EqualError(s.app.NameKeeper.RenewNameRecord(s.ctx, "abc.name"), "name leases are disabled")
+	})
+	s.Run("expired names are not released while leases are disabled", func() {
+		s.ctx = s.ctx.WithBlockTime(s.ctx.BlockTime().Add(2 * time.Hour))
+		s.app.NameKeeper.ReleaseExpiredNames(s.ctx, nametypes.MaxExpiredNamesPerBlock)
+		s.Require().True(s.app.NameKeeper.NameExists(s.ctx, "abc.name"))
+		s.Require().Equal([]string{"abc.name"}, expiring())
+
+		params.LeaseDuration = time.Hour
+		s.app.NameKeeper.SetParams(s.ctx, params)
+		s.app.NameKeeper.ReleaseExpiredNames(s.ctx, nametypes.MaxExpiredNamesPerBlock)
+		s.Require().False(s.app.NameKeeper.NameExists(s.ctx, "abc.name"), "released once leases are enabled again")
+	})
+}
+
+func (s *KeeperTestSuite) TestNameTargets() {
+	scope := nametypes.NewNameTarget(nametypes.TargetTypeScope, "scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel")
+	lookup := func(target nametypes.NameTarget, pageReq *query.PageRequest) *nametypes.QueryReverseLookupTargetResponse {
+		res, err := s.app.NameKeeper.ReverseLookupTarget(sdk.WrapSDKContext(s.ctx),
+			&nametypes.QueryReverseLookupTargetRequest{Type: target.Type, Value: target.Value, Pagination: pageReq})
+		s.Require().NoError(err)
+		return res
+	}
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "loan.name", s.user1Addr, false))
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "alias.name", s.user2Addr, false))
+
+	s.Run("set a target", func() {
+		s.Require().NoError(s.app.NameKeeper.SetNameRecordTarget(s.ctx, "loan.name", scope))
+		s.Require().NoError(s.app.NameKeeper.SetNameRecordTarget(s.ctx, "alias.name", scope))
+		record, err := s.app.NameKeeper.GetRecordByName(s.ctx, "loan.name")
+		s.Require().NoError(err)
+		s.Require().Equal(scope, record.Target)
+		s.Require().Equal(s.user1, record.Address, "the owner is not changed")
+		records, err := s.app.NameKeeper.GetRecordsByAddress(s.ctx, s.user1Addr)
+		s.Require().NoError(err)
+		s.Require().Contains(records, *record, "address index record is updated")
+	})
+	s.Run("resolve the target", func() {
+		res, err := s.app.NameKeeper.Resolve(sdk.WrapSDKContext(s.ctx), &nametypes.QueryResolveRequest{Name: "loan.name"})
+		s.Require().NoError(err)
+		s.Require().Equal(s.user1, res.Address)
+		s.Require().Equal(scope, res.Target)
+		res, err = s.app.NameKeeper.Resolve(sdk.WrapSDKContext(s.ctx), &nametypes.QueryResolveRequest{Name: "example.name"})
+		s.Require().NoError(err)
+		s.Require().Equal(nametypes.NewNameTarget(nametypes.TargetTypeAccount, s.user1), res.Target,
+			"names without a target resolve to the owner")
+	})
+	s.Run("reverse lookup the target", func() {
+		s.Require().ElementsMatch([]string{"alias.name", "loan.name"}, lookup(*scope, nil).Names)
+		upper := nametypes.NameTarget{Type: scope.Type, Value: strings.ToUpper(scope.Value)}
+		s.Require().ElementsMatch([]string{"alias.name", "loan.name"}, lookup(upper, nil).Names,
+			"targets are indexed by address bytes")
+		res := lookup(*scope, &query.PageRequest{Limit: 1})
+		s.Require().Len(res.Names, 1)
+		s.Require().Len(lookup(*scope, &query.PageRequest{Key: res.Pagination.NextKey}).Names, 1)
+		marker := nametypes.NewNameTarget(nametypes.TargetTypeMarker, "nhash")
+		s.Require().Empty(lookup(*marker, nil).Names)
+		records, err := s.app.NameKeeper.GetRecordsByTarget(s.ctx, *scope)
+		s.Require().NoError(err)
+		s.Require().Len(records, 2)
+	})
+	s.Run("invalid targets are rejected", func() {
+		err := s.app.NameKeeper.SetNameRecordTarget(s.ctx, "loan.name", nametypes.NewNameTarget(nametypes.TargetTypeScope, s.user1))
+		s.Require().Error(err)
+		s.Require().ErrorIs(err, nametypes.ErrInvalidTarget)
+		_, err = s.app.NameKeeper.ReverseLookupTarget(sdk.WrapSDKContext(s.ctx),
+			&nametypes.QueryReverseLookupTargetRequest{Type: nametypes.TargetTypeUnspecified, Value: s.user1})
+		s.Require().ErrorIs(err, nametypes.ErrInvalidTarget)
+	})
+	s.Run("transfer keeps the target", func() {
+		s.Require().NoError(s.app.NameKeeper.TransferNameRecord(s.ctx, "alias.name", s.user1Addr))
+		record, err := s.app.NameKeeper.GetRecordByName(s.ctx, "alias.name")
+		s.Require().NoError(err)
+		s.Require().Equal(scope, record.Target)
+	})
+	s.Run("change and clear the target", func() {
+		marker := nametypes.NewNameTarget(nametypes.TargetTypeMarker, "nhash")
+		s.Require().NoError(s.app.NameKeeper.SetNameRecordTarget(s.ctx, "alias.name", marker))
+		s.Require().Equal([]string{"loan.name"}, lookup(*scope, nil).Names)
+		s.Require().Equal([]string{"alias.name"}, lookup(*marker, nil).Names)
+		s.Require().NoError(s.app.NameKeeper.SetNameRecordTarget(s.ctx, "alias.name", nil))
+		s.Require().Empty(lookup(*marker, nil).Names)
+	})
+	s.Run("deleted names are removed from the target index", func() {
+		s.Require().NoError(s.app.NameKeeper.DeleteRecord(s.ctx, "loan.name"))
+		s.Require().Empty(lookup(*scope, nil).Names)
+	})
+	s.Run("targets are exported and imported with genesis", func() {
+		s.Require().NoError(s.app.NameKeeper.SetNameRecordTarget(s.ctx, "example.name", scope))
+		genesis := s.app.NameKeeper.ExportGenesis(s.ctx)
+		s.Require().NoError(genesis.Validate())
+		for _, record := range genesis.Bindings {
+			s.Require().NoError(s.app.NameKeeper.DeleteRecord(s.ctx, record.Name))
+		}
+		s.Require().Empty(lookup(*scope, nil).Names)
+		s.app.NameKeeper.InitGenesis(s.ctx, *genesis)
+		s.Require().Equal([]string{"example.name"}, lookup(*scope, nil).Names)
+	})
+}
+
 func (s *KeeperTestSuite) TestIterateRecord() {
 	s.Run("iterate name's", func() {
 		records := nametypes.NameRecords{}
@@ -0,0 +1,216 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+// LeaseExpiration returns the time a lease started in the current block ends, nil while name leases are disabled.
+func (keeper Keeper) LeaseExpiration(ctx sdk.Context) *time.Time {
+	duration := keeper.GetLeaseDuration(ctx)
+	if duration == 0 {
+		return nil
+	}
+	expiration := ctx.BlockTime().Add(duration)
+	return &expiration
+}
+
+// StartNameLease leases a bound name for the lease duration, the name is left unchanged while name leases are disabled.
+// The lease ends no later than the lease on the parent name.
+func (keeper Keeper) StartNameLease(ctx sdk.Context, name string) error {
+	return keeper.setNewNameLease(ctx, name, keeper.LeaseExpiration(ctx))
+}
+
+// setNewNameLease sets the expiration of a newly bound name.  A name can not outlive its parent, so a name bound below
+// a leased name expires no later than its parent, even when it would otherwise not expire.
+func (keeper Keeper) setNewNameLease(ctx sdk.Context, name string, expiration *time.Time) error {
+	if parent := keeper.parentExpiration(ctx, name); parent != nil && (expiration == nil || parent.Before(*expiration)) {
+		expiration = parent
+	}
+	if expiration == nil {
+		return nil
+	}
+	return keeper.SetNameRecordExpiration(ctx, name, expiration)
+}
+
+// parentExpiration returns the time the lease on the parent of a name ends, nil for a root name or a parent that does
+// not expire.
+func (keeper Keeper) parentExpiration(ctx sdk.Context, name string) *time.Time {
+	comps := strings.SplitN(name, ".", 2)
+	if len(comps) < 2 {
+		return nil
+	}
+	parent, err := keeper.GetRecordByName(ctx, comps[1])
+	if err != nil {
+		return nil
+	}
+	return parent.Expiration
+}
+
+// SetNameRecordExpiration sets the time the lease on a name ends, updating the address and expiration index entries.
+// A nil expiration makes the name permanent.
+func (keeper Keeper) SetNameRecordExpiration(ctx sdk.Context, name string, expiration *time.Time) error {
+	record, err := keeper.GetRecordByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	key, err := types.GetNameKeyPrefix(name)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	if record.Expiration != nil {
+		store.Delete(types.GetExpirationKey(*record.Expiration, key))
+	}
+	record.Expiration = expiration
+	if err = keeper.updateNameRecord(ctx, key, record); err != nil {
+		return err
+	}
+	if expiration != nil {
+		store.Set(types.GetExpirationKey(*expiration, key), []byte{})
+	}
+	return nil
+}
+
+// GetRenewalFee returns the fee charged to renew the lease on a name, based on the length of its first segment.
+func (keeper Keeper) GetRenewalFee(ctx sdk.Context, name string) sdk.Coins {
+	segment := strings.SplitN(name, ".", 2)[0]
+	return types.RenewalFeeFor(keeper.GetRenewalFees(ctx), uint32(len(segment)))
+}
+
+// RenewNameRecord extends the lease on a name by the lease duration, collecting the renewal fee from the owner.
+func (keeper Keeper) RenewNameRecord(ctx sdk.Context, name string) error {
+	record, err := keeper.GetRecordByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	if record.Expiration == nil {
+		return fmt.Errorf("name %s does not expire", record.Name)
+	}
+	duration := keeper.GetLeaseDuration(ctx)
+	if duration == 0 {
+		return fmt.Errorf("name leases are disabled")
+	}
+	expiration := record.Expiration.Add(duration)
+	if parent := keeper.parentExpiration(ctx, record.Name); parent != nil && expiration.After(*parent) {
+		return fmt.Errorf("name %s can not be renewed past the expiration of its parent name", record.Name)
+	}
+	owner, err := sdk.AccAddressFromBech32(record.Address)
+	if err != nil {
+		return err
+	}
+	fee := keeper.GetRenewalFee(ctx, record.Name)
+	if !fee.IsZero() {
+		if err = keeper.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, authtypes.FeeCollectorName, fee); err != nil {
+			return err
+		}
+	}
+	if err = keeper.SetNameRecordExpiration(ctx, record.Name, &expiration); err != nil {
+		return err
+	}
+
+	nameRenewedEvent := types.NewEventNameRenewed(record.Address, record.Name, expiration)
+
+	return ctx.EventManager().EmitTypedEvent(nameRenewedEvent)
+}
+
+// ReleaseExpiredNames unbinds up to limit names with leases that ended at or before the current block time.  The
+// expired names below a name are released before it and count against the limit, when the limit is reached part way
+// through the names below an expired name it is released in a later block.  Names below an expired name that hold a
+// lease that has not ended are left in place.  Nothing is released while name leases are disabled.
+func (keeper Keeper) ReleaseExpiredNames(ctx sdk.Context, limit int) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "release_expired_names")
+
+	// names can not be renewed while leases are disabled so their leases are not enforced either.
+	if keeper.GetLeaseDuration(ctx) == 0 {
+		return
+	}
+
+	// collect the keys that are due before making changes as releasing a name removes it from the index.
+	store := ctx.KVStore(keeper.storeKey)
+	end := sdk.PrefixEndBytes(types.GetExpirationKeyPrefixForTime(ctx.BlockTime()))
+	iterator := store.Iterator(types.ExpirationKeyPrefix, end)
+	var due [][]byte
+	for ; iterator.Valid() && len(due) < limit; iterator.Next() {
+		due = append(due, iterator.Key())
+	}
+	iterator.Close()
+
+	released := 0
+	for _, key := range due {
+		if released >= limit {
+			return
+		}
+		record, err := getNameRecord(ctx, keeper, types.SplitExpirationKey(key))
+		// the name may have been deleted or renewed since this entry was added.
+		if err != nil || record.Expiration == nil || record.Expiration.After(ctx.BlockTime()) {
+			store.Delete(key)
+			continue
+		}
+		cacheCtx, write := ctx.CacheContext()
+		count, done, err := keeper.releaseName(cacheCtx, record.Name, limit-released)
+		if err != nil {
+			keeper.Logger(ctx).Error("unable to release expired name", "name", record.Name, "err", err)
+			store.Delete(key)
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		released += count
+		if !done {
+			return
+		}
+	}
+}
+
+// releaseName unbinds up to limit names, the expired names below a name first and then the name itself, emitting a
+// name expired event for each.  It returns the number of names released and whether the name itself was released.
+func (keeper Keeper) releaseName(ctx sdk.Context, name string, limit int) (int, bool, error) {
+	childPrefix, err := types.GetChildKeyPrefix(name)
+	if err != nil {
+		return 0, false, err
+	}
+	var children []string
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.storeKey), childPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		children = append(children, string(iterator.Key()[len(childPrefix):])+"."+name)
+	}
+	iterator.Close()
+
+	released := 0
+	for _, child := range children {
+		record, err := keeper.GetRecordByName(ctx, child)
+		if err != nil {
+			return released, false, err
+		}
+		// a name below with a lease that has not ended keeps it.
+		if record.Expiration == nil || record.Expiration.After(ctx.BlockTime()) {
+			continue
+		}
+		count, done, err := keeper.releaseName(ctx, child, limit-released)
+		released += count
+		if err != nil || !done {
+			return released, false, err
+		}
+	}
+	if released >= limit {
+		return released, false, nil
+	}
+
+	record, err := keeper.GetRecordByName(ctx, name)
+	if err != nil {
+		return released, false, err
+	}
+	if err = keeper.DeleteRecord(ctx, name); err != nil {
+		return released, false, err
+	}
+	nameExpiredEvent := types.NewEventNameExpired(record.Address, record.Name)
+	return released + 1, true, ctx.EventManager().EmitTypedEvent(nameExpiredEvent)
+}
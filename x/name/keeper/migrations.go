@@ -23,3 +23,11 @@ func (m *Migrator) Migrate1to2(ctx sdk.Context) error {
 	ctx.Logger().Info("Finished Migrating Name Module from Version 1 to 2")
 	return err
 }
+
+// Migrate2to3 migrates from version 2 to 3 by building the parent name index.
+func (m *Migrator) Migrate2to3(ctx sdk.Context) error {
+	ctx.Logger().Info("Migrating Name Module from Version 2 to 3")
+	err := m.keeper.RebuildChildIndex(ctx)
+	ctx.Logger().Info("Finished Migrating Name Module from Version 2 to 3")
+	return err
+}
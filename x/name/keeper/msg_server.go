@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -38,6 +40,12 @@ func (s msgServer) BindName(goCtx context.Context, msg *types.MsgBindNameRequest
 		ctx.Logger().Error("unable to find parent name record", "err", err)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
+	// Names can not be bound below a name whose lease has ended.
+	if record.Expiration != nil && !record.Expiration.After(ctx.BlockTime()) {
+		errm := "parent name lease has ended"
+		ctx.Logger().Error(errm, "name", record.Name)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, errm)
+	}
 	// Ensure that if the parent name is restricted, it resolves to the given parent address (message signer).
 	if record.Restricted {
 		parentAddress, addrErr := sdk.AccAddressFromBech32(msg.Parent.Address)
@@ -68,10 +76,38 @@ func (s msgServer) BindName(goCtx context.Context, msg *types.MsgBindNameRequest
 		ctx.Logger().Error("invalid address", "err", err)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
+	// Only the owners of a scope or scope specification, or the admins of a marker, may name it.
+	if msg.Record.Target != nil {
+		if err := msg.Record.Target.ValidateBasic(); err != nil {
+			ctx.Logger().Error("invalid name target", "err", err)
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		signer, err := sdk.AccAddressFromBech32(msg.Parent.Address)
+		if err != nil {
+			ctx.Logger().Error("unable to parse parent address", "err", err)
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if err := s.Keeper.authorizeTarget(ctx, *msg.Record.Target, signer); err != nil {
+			ctx.Logger().Error("signer cannot set name target", "err", err)
+			return nil, err
+		}
+	}
 	if err := s.Keeper.SetNameRecord(ctx, name, address, msg.Record.Restricted); err != nil {
 		ctx.Logger().Error("unable to bind name", "err", err)
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
+	// Lease the name while name leases are enabled
+	if err := s.Keeper.StartNameLease(ctx, name); err != nil {
+		ctx.Logger().Error("unable to lease name", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Resolve the name to its typed target, the address stays the owner of the name
+	if msg.Record.Target != nil {
+		if err := s.Keeper.SetNameRecordTarget(ctx, name, msg.Record.Target); err != nil {
+			ctx.Logger().Error("unable to set name target", "err", err)
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
 
 	// key: modulename+name+bind
 	defer func() {
@@ -148,3 +184,184 @@ func (s msgServer) DeleteName(goCtx context.Context, msg *types.MsgDeleteNameReq
 
 	return &types.MsgDeleteNameResponse{}, nil
 }
+
+// TransferName binds a name to a new address
+func (s msgServer) TransferName(goCtx context.Context, msg *types.MsgTransferNameRequest) (*types.MsgTransferNameResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Validate
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error("unable to validate message", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Normalize
+	name, err := s.Keeper.Normalize(ctx, msg.Name)
+	if err != nil {
+		ctx.Logger().Error("invalid name", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Parse addresses
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		ctx.Logger().Error("invalid address", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+	if err != nil {
+		ctx.Logger().Error("invalid address", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Ensure the name exists
+	if !s.Keeper.NameExists(ctx, name) {
+		ctx.Logger().Error("invalid name", "name", name)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "name does not exist")
+	}
+	// Ensure permission
+	if !s.Keeper.ResolvesTo(ctx, name, owner) {
+		ctx.Logger().Error("msg sender cannot transfer name", "name", name)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "msg sender cannot transfer name")
+	}
+	// Transfer
+	if err := s.Keeper.TransferNameRecord(ctx, name, newOwner); err != nil {
+		ctx.Logger().Error("error transferring name", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// key: modulename+name+transfer
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, "name", "transfer"},
+			1,
+			[]metrics.Label{telemetry.NewLabel("name", name), telemetry.NewLabel("address", msg.NewOwner)},
+		)
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeNameTransferred,
+			sdk.NewAttribute(types.KeyAttributeAddress, msg.Owner),
+			sdk.NewAttribute(types.KeyAttributeNewAddress, msg.NewOwner),
+			sdk.NewAttribute(types.KeyAttributeName, name),
+		),
+	)
+
+	return &types.MsgTransferNameResponse{}, nil
+}
+
+// ModifyName changes the restriction on a name
+func (s msgServer) ModifyName(goCtx context.Context, msg *types.MsgModifyNameRequest) (*types.MsgModifyNameResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Validate
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error("unable to validate message", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Normalize
+	name, err := s.Keeper.Normalize(ctx, msg.Name)
+	if err != nil {
+		ctx.Logger().Error("invalid name", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Parse address
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		ctx.Logger().Error("invalid address", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Ensure the name exists
+	if !s.Keeper.NameExists(ctx, name) {
+		ctx.Logger().Error("invalid name", "name", name)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "name does not exist")
+	}
+	// Ensure permission
+	if !s.Keeper.ResolvesTo(ctx, name, owner) {
+		ctx.Logger().Error("msg sender cannot modify name", "name", name)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "msg sender cannot modify name")
+	}
+	// Modify
+	if err := s.Keeper.ModifyNameRecord(ctx, name, msg.Restricted); err != nil {
+		ctx.Logger().Error("error modifying name", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// key: modulename+name+modify
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, "name", "modify"},
+			1,
+			[]metrics.Label{telemetry.NewLabel("name", name), telemetry.NewLabel("address", msg.Owner)},
+		)
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeNameModified,
+			sdk.NewAttribute(types.KeyAttributeAddress, msg.Owner),
+			sdk.NewAttribute(types.KeyAttributeName, name),
+			sdk.NewAttribute(types.KeyAttributeRestricted, strconv.FormatBool(msg.Restricted)),
+		),
+	)
+
+	return &types.MsgModifyNameResponse{}, nil
+}
+
+// RenewName extends the lease on a name
+func (s msgServer) RenewName(goCtx context.Context, msg *types.MsgRenewNameRequest) (*types.MsgRenewNameResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Validate
+	if err := msg.ValidateBasic(); err != nil {
+		ctx.Logger().Error("unable to validate message", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Normalize
+	name, err := s.Keeper.Normalize(ctx, msg.Name)
+	if err != nil {
+		ctx.Logger().Error("invalid name", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Parse address
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		ctx.Logger().Error("invalid address", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	// Ensure the name exists
+	if !s.Keeper.NameExists(ctx, name) {
+		ctx.Logger().Error("invalid name", "name", name)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "name does not exist")
+	}
+	// Ensure permission
+	if !s.Keeper.ResolvesTo(ctx, name, owner) {
+		ctx.Logger().Error("msg sender cannot renew name", "name", name)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "msg sender cannot renew name")
+	}
+	// Renew
+	if err := s.Keeper.RenewNameRecord(ctx, name); err != nil {
+		ctx.Logger().Error("error renewing name", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	record, err := s.Keeper.GetRecordByName(ctx, name)
+	if err != nil {
+		ctx.Logger().Error("unable to find renewed name record", "err", err)
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// key: modulename+name+renew
+	defer func() {
+		telemetry.IncrCounterWithLabels(
+			[]string{types.ModuleName, "name", "renew"},
+			1,
+			[]metrics.Label{telemetry.NewLabel("name", name), telemetry.NewLabel("address", msg.Owner)},
+		)
+	}()
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeNameRenewed,
+			sdk.NewAttribute(types.KeyAttributeAddress, msg.Owner),
+			sdk.NewAttribute(types.KeyAttributeName, name),
+			sdk.NewAttribute(types.KeyAttributeExpiration, record.Expiration.UTC().Format(time.RFC3339)),
+		),
+	)
+
+	return &types.MsgRenewNameResponse{}, nil
+}
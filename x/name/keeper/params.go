@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/name/types"
@@ -13,6 +15,8 @@ func (keeper Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 		MinSegmentLength:       keeper.GetMinSegmentLength(ctx),
 		MaxNameLevels:          keeper.GetMaxNameLevels(ctx),
 		AllowUnrestrictedNames: keeper.GetAllowUnrestrictedNames(ctx),
+		LeaseDuration:          keeper.GetLeaseDuration(ctx),
+		RenewalFees:            keeper.GetRenewalFees(ctx),
 	}
 }
 
@@ -56,3 +60,21 @@ func (keeper Keeper) GetAllowUnrestrictedNames(ctx sdk.Context) (enabled bool) {
 	}
 	return
 }
+
+// GetLeaseDuration returns the current length of the lease on newly bound names (or default if unset)
+func (keeper Keeper) GetLeaseDuration(ctx sdk.Context) (duration time.Duration) {
+	duration = types.DefaultLeaseDuration
+	if keeper.paramSpace.Has(ctx, types.ParamStoreKeyLeaseDuration) {
+		keeper.paramSpace.Get(ctx, types.ParamStoreKeyLeaseDuration, &duration)
+	}
+	return
+}
+
+// GetRenewalFees returns the current fees charged to renew the lease on a name (or default if unset)
+func (keeper Keeper) GetRenewalFees(ctx sdk.Context) (fees []types.RenewalFee) {
+	fees = types.DefaultRenewalFees
+	if keeper.paramSpace.Has(ctx, types.ParamStoreKeyRenewalFees) {
+		keeper.paramSpace.Get(ctx, types.ParamStoreKeyRenewalFees, &fees)
+	}
+	return
+}
@@ -3,8 +3,10 @@ package keeper
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/provenance-io/provenance/x/name/types"
 )
@@ -35,6 +37,14 @@ func HandleCreateRootNameProposal(ctx sdk.Context, k Keeper, p *types.CreateRoot
 			if err = k.SetNameRecord(ctx, name, addr, p.Restricted); err != nil {
 				return err
 			}
+			// names created below an existing leased name expire with it, even when the proposal is permanent.
+			var expiration *time.Time
+			if !p.Permanent {
+				expiration = k.LeaseExpiration(ctx)
+			}
+			if err = k.setNewNameLease(ctx, name, expiration); err != nil {
+				return err
+			}
 			logger.Info(fmt.Sprintf("create root name proposal: created %s and set the owner as %s", name, p.Owner))
 		} else {
 			logger.Info(fmt.Sprintf("create root name proposal: intermediate domain %s exists, skipping", name))
@@ -43,3 +53,21 @@ func HandleCreateRootNameProposal(ctx sdk.Context, k Keeper, p *types.CreateRoot
 
 	return nil
 }
+
+// HandleModifyNameProposal is a handler for executing a passed modify name proposal.  Only root names, such as those
+// created by a create root name proposal, may be changed; names below a root are changed by their owner.
+func HandleModifyNameProposal(ctx sdk.Context, k Keeper, p *types.ModifyNameProposal) error {
+	name, err := k.Normalize(ctx, p.Name)
+	if err != nil {
+		return err
+	}
+	if strings.Contains(name, ".") {
+		return sdkerrors.Wrapf(types.ErrNameContainsSegments, "modify name proposal requires a root name, got %s", name)
+	}
+	if err := k.ModifyNameRecord(ctx, name, p.Restricted); err != nil {
+		return err
+	}
+	k.Logger(ctx).Info(fmt.Sprintf("modify name proposal: set restricted on %s to %t", name, p.Restricted))
+
+	return nil
+}
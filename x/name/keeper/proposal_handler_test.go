@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -31,7 +32,7 @@ type IntegrationTestSuite struct {
 func (s *IntegrationTestSuite) SetupSuite() {
 	s.app = provenance.Setup(false)
 	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{})
-	s.k = namekeeper.NewKeeper(s.app.AppCodec(), s.app.GetKey(nametypes.ModuleName), s.app.GetSubspace(nametypes.ModuleName))
+	s.k = namekeeper.NewKeeper(s.app.AppCodec(), s.app.GetKey(nametypes.ModuleName), s.app.GetSubspace(nametypes.ModuleName), s.app.BankKeeper, s.app.MetadataKeeper)
 	s.accountAddr = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
 }
 
@@ -102,6 +103,49 @@ func (s *IntegrationTestSuite) TestNameProposals() {
 			true,
 			fmt.Errorf("segment of name is too short"),
 		},
+		// MODIFY NAME PROPOSALS
+		{
+			"modify name - valid",
+			nametypes.NewModifyNameProposal("title", "description", "root", true),
+			false,
+			nil,
+		},
+		{
+			"modify name - fails unchanged",
+			nametypes.NewModifyNameProposal("title", "description", "root", true),
+			true,
+			fmt.Errorf("name root restricted is already true"),
+		},
+		{
+			"modify name - valid name is normalized",
+			nametypes.NewModifyNameProposal("title", "description", " ROOT ", false),
+			false,
+			nil,
+		},
+		{
+			"modify name - fails sub domain",
+			nametypes.NewModifyNameProposal("title", "description", "example.provenance.io", true),
+			true,
+			fmt.Errorf("modify name proposal requires a root name, got example.provenance.io: invalid name: \".\" is reserved"),
+		},
+		{
+			"modify name - fails sub domain not normalized",
+			nametypes.NewModifyNameProposal("title", "description", "Example . Provenance.io", true),
+			true,
+			fmt.Errorf("modify name proposal requires a root name, got example.provenance.io: invalid name: \".\" is reserved"),
+		},
+		{
+			"modify name - fails invalid name",
+			nametypes.NewModifyNameProposal("title", "description", "..", true),
+			true,
+			fmt.Errorf("segment of name is too short"),
+		},
+		{
+			"modify name - fails not bound",
+			nametypes.NewModifyNameProposal("title", "description", "unknown", true),
+			true,
+			fmt.Errorf("no address bound to name"),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -113,6 +157,8 @@ func (s *IntegrationTestSuite) TestNameProposals() {
 			switch c := tc.prop.(type) {
 			case *nametypes.CreateRootNameProposal:
 				err = namekeeper.HandleCreateRootNameProposal(s.ctx, s.k, c)
+			case *nametypes.ModifyNameProposal:
+				err = namekeeper.HandleModifyNameProposal(s.ctx, s.k, c)
 			default:
 				panic("invalid proposal type")
 			}
@@ -127,6 +173,29 @@ func (s *IntegrationTestSuite) TestNameProposals() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestRootNameProposalLeases() {
+	ctx, _ := s.ctx.CacheContext()
+	params := s.k.GetParams(ctx)
+	params.LeaseDuration = time.Hour
+	s.k.SetParams(ctx, params)
+
+	permanent := nametypes.NewCreateRootNameProposal("title", "description", "sub.permanent", s.accountAddr, false)
+	permanent.Permanent = true
+	s.Require().NoError(namekeeper.HandleCreateRootNameProposal(ctx, s.k, permanent))
+	s.Require().NoError(namekeeper.HandleCreateRootNameProposal(ctx, s.k,
+		nametypes.NewCreateRootNameProposal("title", "description", "sub.leased", s.accountAddr, false)))
+
+	record, err := s.k.GetRecordByName(ctx, "sub.permanent")
+	s.Require().NoError(err)
+	s.Require().Nil(record.Expiration, "permanent root name does not expire")
+	record, err = s.k.GetRecordByName(ctx, "permanent")
+	s.Require().NoError(err)
+	s.Require().Nil(record.Expiration, "intermediate names created by a permanent proposal do not expire")
+	record, err = s.k.GetRecordByName(ctx, "sub.leased")
+	s.Require().NoError(err)
+	s.Require().Equal(ctx.BlockTime().Add(time.Hour), *record.Expiration, "root name is leased")
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationTestSuite))
 }
@@ -35,7 +35,8 @@ func (keeper Keeper) Resolve(c context.Context, request *types.QueryResolveReque
 	if record == nil {
 		return nil, types.ErrNameNotBound
 	}
-	return &types.QueryResolveResponse{Address: record.Address}, nil
+	target := record.ResolvedTarget()
+	return &types.QueryResolveResponse{Address: record.Address, Target: &target}, nil
 }
 
 // ReverseLookup gets all names bound to an address.
@@ -73,3 +74,51 @@ func (keeper Keeper) ReverseLookup(c context.Context, request *types.QueryRevers
 
 	return &types.QueryReverseLookupResponse{Name: names, Pagination: pageRes}, nil
 }
+
+// Children gets the names directly below a name.
+func (keeper Keeper) Children(c context.Context, request *types.QueryChildrenRequest) (*types.QueryChildrenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	name, err := keeper.Normalize(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	key, err := types.GetChildKeyPrefix(name)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0)
+	childStore := prefix.NewStore(ctx.KVStore(keeper.storeKey), key)
+	pageRes, err := query.Paginate(childStore, request.Pagination, func(key []byte, value []byte) error {
+		names = append(names, string(key)+"."+name)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryChildrenResponse{Names: names, Pagination: pageRes}, nil
+}
+
+// ReverseLookupTarget gets all names that resolve to a typed target.
+func (keeper Keeper) ReverseLookupTarget(c context.Context, request *types.QueryReverseLookupTargetRequest) (*types.QueryReverseLookupTargetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	key, err := types.GetTargetKeyPrefix(types.NameTarget{Type: request.Type, Value: request.Value})
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0)
+	targetStore := prefix.NewStore(ctx.KVStore(keeper.storeKey), key)
+	pageRes, err := query.Paginate(targetStore, request.Pagination, func(key []byte, value []byte) error {
+		record, err := getNameRecord(ctx, keeper, key)
+		if err != nil {
+			return err
+		}
+		names = append(names, record.Name)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryReverseLookupTargetResponse{Names: names, Pagination: pageRes}, nil
+}
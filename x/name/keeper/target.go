@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+// SetNameRecordTarget sets the typed value a bound name resolves to, updating the address and target index entries.
+// A nil target makes the name resolve to its owner.  The owner keeps control of the name and the names below it.
+func (keeper Keeper) SetNameRecordTarget(ctx sdk.Context, name string, target *types.NameTarget) error {
+	if target != nil {
+		if err := target.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	record, err := keeper.GetRecordByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	key, err := types.GetNameKeyPrefix(name)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	if record.Target != nil {
+		oldKey, err := types.GetTargetKey(*record.Target, key)
+		if err != nil {
+			return err
+		}
+		store.Delete(oldKey)
+	}
+	record.Target = target
+	if err = keeper.updateNameRecord(ctx, key, record); err != nil {
+		return err
+	}
+	if target != nil {
+		targetKey, err := types.GetTargetKey(*target, key)
+		if err != nil {
+			return err
+		}
+		store.Set(targetKey, []byte{})
+	}
+	return nil
+}
+
+// authorizeTarget checks that the signer controls the scope, scope specification or marker a name is set to resolve to.
+// Scopes require an owner or the value owner, scope specifications an owner, and markers an address with admin access.
+func (keeper Keeper) authorizeTarget(ctx sdk.Context, target types.NameTarget, signer sdk.AccAddress) error {
+	switch target.Type {
+	case types.TargetTypeScope:
+		scopeID, err := metadatatypes.MetadataAddressFromBech32(target.Value)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidTarget, "invalid scope address %q", target.Value)
+		}
+		scope, found := keeper.metadataKeeper.GetScope(ctx, scopeID)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrInvalidTarget, "scope %s not found", target.Value)
+		}
+		if scope.ValueOwnerAddress == signer.String() {
+			return nil
+		}
+		for _, owner := range scope.Owners {
+			if owner.Address == signer.String() {
+				return nil
+			}
+		}
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not an owner of scope %s", signer, target.Value)
+	case types.TargetTypeScopeSpecification:
+		specID, err := metadatatypes.MetadataAddressFromBech32(target.Value)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidTarget, "invalid scope specification address %q", target.Value)
+		}
+		spec, found := keeper.metadataKeeper.GetScopeSpecification(ctx, specID)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrInvalidTarget, "scope specification %s not found", target.Value)
+		}
+		for _, owner := range spec.OwnerAddresses {
+			if owner == signer.String() {
+				return nil
+			}
+		}
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not an owner of scope specification %s", signer, target.Value)
+	case types.TargetTypeMarker:
+		if keeper.markerKeeper == nil || !keeper.markerKeeper.IsMarkerAdmin(ctx, target.Value, signer) {
+			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s does not have admin access on marker %s", signer, target.Value)
+		}
+		return nil
+	default:
+		return nil
+	}
+}
+
+// GetRecordsByTarget looks up all names that resolve to a typed target.
+func (keeper Keeper) GetRecordsByTarget(ctx sdk.Context, target types.NameTarget) (types.NameRecords, error) {
+	prefix, err := types.GetTargetKeyPrefix(target)
+	if err != nil {
+		return nil, err
+	}
+	records := types.NameRecords{}
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.storeKey), prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		record, err := getNameRecord(ctx, keeper, types.SplitTargetKey(iterator.Key()))
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, *record)
+	}
+	return records, nil
+}
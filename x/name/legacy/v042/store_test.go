@@ -124,6 +124,8 @@ func (s *MigrateTestSuite) TestMigrateTestSuite() {
 		var resultRecord types.NameRecord
 		err = types.ModuleCdc.Unmarshal(result, &resultRecord)
 		s.Assert().NoError(err)
+		// amino decodes the unset lease expiration as a zero time.
+		resultRecord.Expiration = nil
 		s.Assert().Equal(name, resultRecord, "address key record should equal new record")
 	}
 }
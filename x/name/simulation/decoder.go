@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -29,6 +30,16 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &nameB)
 
 			return fmt.Sprintf("%v\n%v", nameA, nameB)
+		case bytes.Equal(kvA.Key[:1], types.ChildKeyPrefix):
+			// the child segment follows the parent name hash, the value is empty
+			segment := len(types.ChildKeyPrefix) + sha256.Size
+			return fmt.Sprintf("%s\n%s", kvA.Key[segment:], kvB.Key[segment:])
+		case bytes.Equal(kvA.Key[:1], types.ExpirationKeyPrefix):
+			// the name record key follows the expiration time, the value is empty
+			return fmt.Sprintf("%X\n%X", types.SplitExpirationKey(kvA.Key), types.SplitExpirationKey(kvB.Key))
+		case bytes.Equal(kvA.Key[:1], types.TargetKeyPrefix):
+			// the name record key follows the typed target, the value is empty
+			return fmt.Sprintf("%X\n%X", types.SplitTargetKey(kvA.Key), types.SplitTargetKey(kvB.Key))
 		default:
 			panic(fmt.Sprintf("unexpected %s key %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
 		}
@@ -3,6 +3,7 @@ package simulation_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -20,10 +21,22 @@ func TestDecodeStore(t *testing.T) {
 
 	testNameRecord := types.NewNameRecord("test", sdk.AccAddress{}, true)
 
+	childKey, err := types.GetChildKey("child.test")
+	require.NoError(t, err)
+
+	nameKey, err := types.GetNameKeyPrefix("test")
+	require.NoError(t, err)
+	expirationKey := types.GetExpirationKey(time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC), nameKey)
+	targetKey, err := types.GetTargetKey(types.NameTarget{Type: types.TargetTypeMarker, Value: "nhash"}, nameKey)
+	require.NoError(t, err)
+
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.NameKeyPrefix, Value: cdc.MustMarshal(&testNameRecord)},
 			{Key: types.AddressKeyPrefix, Value: cdc.MustMarshal(&testNameRecord)},
+			{Key: childKey, Value: []byte{}},
+			{Key: expirationKey, Value: []byte{}},
+			{Key: targetKey, Value: []byte{}},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -34,6 +47,9 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"Name Record", fmt.Sprintf("%v\n%v", testNameRecord, testNameRecord)},
 		{"Address Cache", fmt.Sprintf("%v\n%v", testNameRecord, testNameRecord)},
+		{"Child Index", "child\nchild"},
+		{"Expiration Index", fmt.Sprintf("%X\n%X", nameKey, nameKey)},
+		{"Target Index", fmt.Sprintf("%X\n%X", nameKey, nameKey)},
 		{"other", ""},
 	}
 
@@ -14,7 +14,11 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(MsgBindNameRequest{}, "provenance/MsgBindNameRequest", nil)
 	cdc.RegisterConcrete(MsgDeleteNameRequest{}, "provenance/MsgDeleteNameRequest", nil)
+	cdc.RegisterConcrete(MsgTransferNameRequest{}, "provenance/MsgTransferNameRequest", nil)
+	cdc.RegisterConcrete(MsgModifyNameRequest{}, "provenance/MsgModifyNameRequest", nil)
+	cdc.RegisterConcrete(MsgRenewNameRequest{}, "provenance/MsgRenewNameRequest", nil)
 	cdc.RegisterConcrete(CreateRootNameProposal{}, "provenance/CreateRootNameProposal", nil)
+	cdc.RegisterConcrete(ModifyNameProposal{}, "provenance/ModifyNameProposal", nil)
 }
 
 // RegisterInterfaces registers concrete implentations for the given type names
@@ -23,11 +27,15 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgBindNameRequest{},
 		&MsgDeleteNameRequest{},
+		&MsgTransferNameRequest{},
+		&MsgModifyNameRequest{},
+		&MsgRenewNameRequest{},
 	)
 
 	registry.RegisterImplementations(
 		(*govtypes.Content)(nil),
 		&CreateRootNameProposal{},
+		&ModifyNameProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
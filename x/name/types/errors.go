@@ -22,4 +22,6 @@ var (
 	ErrInvalidAddress = sdkerrors.Register(ModuleName, 8, "invalid account address")
 	// ErrNameContainsSegments indicates a multi-segment name in a single segment context.
 	ErrNameContainsSegments = sdkerrors.Register(ModuleName, 9, "invalid name: \".\" is reserved")
+	// ErrInvalidTarget indicates the typed target of a name is not a valid address or denom for its type.
+	ErrInvalidTarget = sdkerrors.Register(ModuleName, 10, "invalid name target")
 )
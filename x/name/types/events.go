@@ -1,15 +1,29 @@
 package types
 
+import "time"
+
 const (
 	// EventTypeNameBound is the type of event generated when a name is bound to an address.
 	EventTypeNameBound string = "name_bound"
 	// EventTypeNameUnbound is the type of event generated when a name is unbound from an address (deleted).
 	EventTypeNameUnbound string = "name_unbound"
+	// EventTypeNameTransferred is the type of event generated when a name is bound to a new address.
+	EventTypeNameTransferred string = "name_transferred"
+	// EventTypeNameModified is the type of event generated when the restriction on a name is changed.
+	EventTypeNameModified string = "name_modified"
+	// EventTypeNameRenewed is the type of event generated when the lease on a name is extended.
+	EventTypeNameRenewed string = "name_renewed"
 
 	// KeyAttributeName is the key for a name.
 	KeyAttributeName string = "name"
 	// KeyAttributeAddress is the key for an address.
 	KeyAttributeAddress string = "address"
+	// KeyAttributeNewAddress is the key for the address a name is transferred to.
+	KeyAttributeNewAddress string = "new_address"
+	// KeyAttributeRestricted is the key for whether a name is restricted.
+	KeyAttributeRestricted string = "restricted"
+	// KeyAttributeExpiration is the key for the time the lease on a name ends.
+	KeyAttributeExpiration string = "expiration"
 )
 
 func NewEventNameBound(address string, name string) *EventNameBound {
@@ -25,3 +39,34 @@ func NewEventNameUnbound(address string, name string) *EventNameUnbound {
 		Name:    name,
 	}
 }
+
+func NewEventNameTransferred(name string, previousOwner string, newOwner string) *EventNameTransferred {
+	return &EventNameTransferred{
+		Name:          name,
+		PreviousOwner: previousOwner,
+		NewOwner:      newOwner,
+	}
+}
+
+func NewEventNameModified(address string, name string, restricted bool) *EventNameModified {
+	return &EventNameModified{
+		Address:    address,
+		Name:       name,
+		Restricted: restricted,
+	}
+}
+
+func NewEventNameRenewed(address string, name string, expiration time.Time) *EventNameRenewed {
+	return &EventNameRenewed{
+		Address:    address,
+		Name:       name,
+		Expiration: expiration,
+	}
+}
+
+func NewEventNameExpired(address string, name string) *EventNameExpired {
+	return &EventNameExpired{
+		Address: address,
+		Name:    name,
+	}
+}
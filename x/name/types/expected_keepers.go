@@ -2,6 +2,8 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
 )
 
 // ParamSubspace defines the expected Subspace interface for parameters (noalias)
@@ -9,3 +11,19 @@ type ParamSubspace interface {
 	Get(ctx sdk.Context, key []byte, ptr interface{})
 	Set(ctx sdk.Context, key []byte, param interface{})
 }
+
+// BankKeeper defines the expected bank keeper used to collect name renewal fees (noalias)
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+}
+
+// MetadataKeeper defines the expected metadata keeper used to check the owners of scope and scope specification targets (noalias)
+type MetadataKeeper interface {
+	GetScope(ctx sdk.Context, id metadatatypes.MetadataAddress) (metadatatypes.Scope, bool)
+	GetScopeSpecification(ctx sdk.Context, id metadatatypes.MetadataAddress) (metadatatypes.ScopeSpecification, bool)
+}
+
+// MarkerKeeper defines the expected marker keeper used to check the administrators of marker targets (noalias)
+type MarkerKeeper interface {
+	IsMarkerAdmin(ctx sdk.Context, denom string, addr sdk.AccAddress) bool
+}
@@ -49,6 +49,11 @@ func (state GenesisState) Validate() error {
 		if strings.TrimSpace(record.Address) == "" {
 			return fmt.Errorf("address cannot be empty")
 		}
+		if record.Target != nil {
+			if err := record.Target.ValidateBasic(); err != nil {
+				return fmt.Errorf("invalid target for name %s: %w", record.Name, err)
+			}
+		}
 	}
 	return nil
 }
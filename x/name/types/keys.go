@@ -4,6 +4,7 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -34,6 +35,12 @@ var (
 	NameKeyPrefix = []byte{0x03}
 	// AddressKeyPrefix is a prefix added to keys for indexing name records by address.
 	AddressKeyPrefix = []byte{0x05}
+	// ChildKeyPrefix is a prefix added to keys for indexing name records by their parent name.
+	ChildKeyPrefix = []byte{0x06}
+	// ExpirationKeyPrefix is a prefix added to keys for indexing leased name records by the time they expire.
+	ExpirationKeyPrefix = []byte{0x07}
+	// TargetKeyPrefix is a prefix added to keys for indexing name records by their typed target.
+	TargetKeyPrefix = []byte{0x08}
 )
 
 // GetNameKeyPrefix converts a name into key format.
@@ -72,6 +79,71 @@ func getNamePrefixByType(name string, key []byte) ([]byte, error) {
 	return key, nil
 }
 
+// GetChildKeyPrefix returns the store key prefix for the index of the names directly below a parent name.
+func GetChildKeyPrefix(parent string) (key []byte, err error) {
+	key = ChildKeyPrefix
+	return getNamePrefixByType(parent, key)
+}
+
+// GetChildKey returns the parent index store key for a name, nil for a root name as it has no parent.
+func GetChildKey(name string) (key []byte, err error) {
+	comps := strings.SplitN(name, ".", 2)
+	if len(comps) < 2 {
+		return nil, nil
+	}
+	if key, err = GetChildKeyPrefix(comps[1]); err != nil {
+		return nil, err
+	}
+	key = append(key, []byte(strings.TrimSpace(comps[0]))...) // [0x06] :: [parent-name-hash] :: [child-segment]
+	return key, nil
+}
+
+// GetExpirationKeyPrefixForTime returns the store key prefix for the index of the names that expire at the given time.
+func GetExpirationKeyPrefixForTime(expiration time.Time) []byte {
+	return append(ExpirationKeyPrefix, sdk.FormatTimeBytes(expiration)...)
+}
+
+// GetExpirationKey returns the expiration index store key for a name record key that expires at the given time.
+func GetExpirationKey(expiration time.Time, nameKey []byte) []byte {
+	return append(GetExpirationKeyPrefixForTime(expiration), nameKey...) // [0x07] :: [time-bytes] :: [name-key-bytes]
+}
+
+// SplitExpirationKey returns the name record key from an expiration index store key.
+func SplitExpirationKey(key []byte) (nameKey []byte) {
+	// skip the prefix and the fixed length formatted time
+	return key[len(ExpirationKeyPrefix)+len(sdk.FormatTimeBytes(time.Time{})):]
+}
+
+// GetTargetKeyPrefix returns the store key prefix for the index of the names that resolve to a typed target.
+func GetTargetKeyPrefix(target NameTarget) (key []byte, err error) {
+	bz, err := target.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	bz, err = address.LengthPrefix(bz)
+	if err != nil {
+		return nil, err
+	}
+	key = append(TargetKeyPrefix, byte(target.Type))
+	return append(key, bz...), nil // [0x08] :: [target-type] :: [length-prefixed target-bytes]
+}
+
+// GetTargetKey returns the target index store key for a name record key that resolves to a typed target.
+func GetTargetKey(target NameTarget, nameKey []byte) (key []byte, err error) {
+	key, err = GetTargetKeyPrefix(target)
+	if err != nil {
+		return nil, err
+	}
+	return append(key, nameKey...), nil
+}
+
+// SplitTargetKey returns the name record key from a target index store key.
+func SplitTargetKey(key []byte) (nameKey []byte) {
+	// skip the prefix, the target type and the length prefixed target bytes
+	start := len(TargetKeyPrefix) + 1
+	return key[start+1+int(key[start]):]
+}
+
 // GetAddressKeyPrefix returns a store key for a name record address
 func GetAddressKeyPrefix(addr sdk.AccAddress) (key []byte, err error) {
 	err = sdk.VerifyAddressFormat(addr.Bytes())
@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
@@ -109,6 +110,54 @@ func (s *NameKeyTestSuite) TestAddressKeyPrefix() {
 	s.Assert().Equal(AddressKeyPrefix, key[0:1])
 }
 
+func (s *NameKeyTestSuite) TestChildKey() {
+	prefix, err := GetChildKeyPrefix("domain")
+	s.Assert().NoError(err)
+	s.Assert().Equal(mustHexDecode("06f2ff83860a4dc203988ed1a22ba1f21237f04abdbd0c4c951103cfbed121de78"), prefix)
+
+	key, err := GetChildKey("name.domain")
+	s.Assert().NoError(err)
+	s.Assert().Equal(append(prefix, []byte("name")...), key, "should be the parent prefix followed by the child segment")
+
+	key, err = GetChildKey("domain")
+	s.Assert().NoError(err)
+	s.Assert().Nil(key, "root names have no parent")
+
+	_, err = GetChildKey("name..domain")
+	s.Assert().Error(err)
+}
+
+func (s *NameKeyTestSuite) TestExpirationKey() {
+	expiration := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+	nameKey, err := GetNameKeyPrefix("name.domain")
+	s.Assert().NoError(err)
+
+	prefix := GetExpirationKeyPrefixForTime(expiration)
+	s.Assert().Equal(append([]byte{0x07}, []byte("2021-12-01T00:00:00.000000000")...), prefix)
+
+	key := GetExpirationKey(expiration, nameKey)
+	s.Assert().Equal(append(prefix, nameKey...), key, "should be the time prefix followed by the name key")
+	s.Assert().Equal(nameKey, SplitExpirationKey(key))
+}
+
+func (s *NameKeyTestSuite) TestTargetKey() {
+	nameKey, err := GetNameKeyPrefix("name.domain")
+	s.Assert().NoError(err)
+
+	target := NameTarget{Type: TargetTypeMarker, Value: "nhash"}
+	prefix, err := GetTargetKeyPrefix(target)
+	s.Assert().NoError(err)
+	s.Assert().Equal(append([]byte{0x08, byte(TargetTypeMarker), 5}, []byte("nhash")...), prefix)
+
+	key, err := GetTargetKey(target, nameKey)
+	s.Assert().NoError(err)
+	s.Assert().Equal(append(prefix, nameKey...), key, "should be the target prefix followed by the name key")
+	s.Assert().Equal(nameKey, SplitTargetKey(key))
+
+	_, err = GetTargetKeyPrefix(NameTarget{Type: TargetTypeScope, Value: "nhash"})
+	s.Assert().Error(err)
+}
+
 func mustHexDecode(h string) []byte {
 	var err error
 	var result []byte
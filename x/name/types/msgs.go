@@ -9,12 +9,15 @@ import (
 
 // name message types
 const (
-	TypeMsgBindNameRequest   = "bind_name"
-	TypeMsgDeleteNameRequest = "delete_name"
+	TypeMsgBindNameRequest     = "bind_name"
+	TypeMsgDeleteNameRequest   = "delete_name"
+	TypeMsgTransferNameRequest = "transfer_name"
+	TypeMsgModifyNameRequest   = "modify_name"
+	TypeMsgRenewNameRequest    = "renew_name"
 )
 
 // Compile time interface checks.
-var _, _ sdk.Msg = &MsgBindNameRequest{}, &MsgDeleteNameRequest{}
+var _, _, _, _, _ sdk.Msg = &MsgBindNameRequest{}, &MsgDeleteNameRequest{}, &MsgTransferNameRequest{}, &MsgModifyNameRequest{}, &MsgRenewNameRequest{}
 
 // NewMsgBindNameRequest creates a new bind name request
 func NewMsgBindNameRequest(record, parent NameRecord) *MsgBindNameRequest {
@@ -47,6 +50,9 @@ func (msg MsgBindNameRequest) ValidateBasic() error {
 	if strings.TrimSpace(msg.Record.Address) == "" {
 		return fmt.Errorf("address cannot be empty")
 	}
+	if msg.Record.Target != nil {
+		return msg.Record.Target.ValidateBasic()
+	}
 	return nil
 }
 
@@ -101,3 +107,128 @@ func (msg MsgDeleteNameRequest) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{addr}
 }
+
+// NewMsgTransferNameRequest creates a new Transfer Name Request
+func NewMsgTransferNameRequest(name string, owner, newOwner sdk.AccAddress) *MsgTransferNameRequest {
+	return &MsgTransferNameRequest{
+		Name:     name,
+		Owner:    owner.String(),
+		NewOwner: newOwner.String(),
+	}
+}
+
+// Route implements Msg
+func (msg MsgTransferNameRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgTransferNameRequest) Type() string { return TypeMsgTransferNameRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgTransferNameRequest) ValidateBasic() error {
+	if strings.TrimSpace(msg.Name) == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return fmt.Errorf("invalid owner address: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.NewOwner); err != nil {
+		return fmt.Errorf("invalid new owner address: %w", err)
+	}
+	if msg.Owner == msg.NewOwner {
+		return fmt.Errorf("new owner must be different from the current owner")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgTransferNameRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the current record owner.
+func (msg MsgTransferNameRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgModifyNameRequest creates a new Modify Name Request
+func NewMsgModifyNameRequest(name string, owner sdk.AccAddress, restricted bool) *MsgModifyNameRequest {
+	return &MsgModifyNameRequest{
+		Name:       name,
+		Owner:      owner.String(),
+		Restricted: restricted,
+	}
+}
+
+// Route implements Msg
+func (msg MsgModifyNameRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgModifyNameRequest) Type() string { return TypeMsgModifyNameRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgModifyNameRequest) ValidateBasic() error {
+	if strings.TrimSpace(msg.Name) == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return fmt.Errorf("invalid owner address: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgModifyNameRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the current record owner.
+func (msg MsgModifyNameRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgRenewNameRequest creates a new Renew Name Request
+func NewMsgRenewNameRequest(name string, owner sdk.AccAddress) *MsgRenewNameRequest {
+	return &MsgRenewNameRequest{
+		Name:  name,
+		Owner: owner.String(),
+	}
+}
+
+// Route implements Msg
+func (msg MsgRenewNameRequest) Route() string { return ModuleName }
+
+// Type implements Msg
+func (msg MsgRenewNameRequest) Type() string { return TypeMsgRenewNameRequest }
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgRenewNameRequest) ValidateBasic() error {
+	if strings.TrimSpace(msg.Name) == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return fmt.Errorf("invalid owner address: %w", err)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRenewNameRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners indicates that the message must have been signed by the current record owner.
+func (msg MsgRenewNameRequest) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
@@ -5,8 +5,15 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
 )
 
+// MaxExpiredNamesPerBlock is the maximum number of names released in one end block when their leases expire, any
+// others that have expired are released in following blocks.
+const MaxExpiredNamesPerBlock = 100
+
 // NewNameRecord creates a name record binding that is restricted for child updates to the owner.
 func NewNameRecord(name string, address sdk.AccAddress, restricted bool) NameRecord { //nolint:interfacer
 	return NameRecord{
@@ -32,5 +39,81 @@ func (nr NameRecord) ValidateBasic() error {
 	if strings.TrimSpace(nr.Name) == "" {
 		return ErrNameSegmentTooShort
 	}
+	if nr.Target != nil {
+		return nr.Target.ValidateBasic()
+	}
 	return nil
 }
+
+// ResolvedTarget returns the typed value the name resolves to, the owning account when the record has no target.
+func (nr NameRecord) ResolvedTarget() NameTarget {
+	if nr.Target != nil {
+		return *nr.Target
+	}
+	return NameTarget{Type: TargetTypeAccount, Value: nr.Address}
+}
+
+// NewNameTarget creates a typed target for a name to resolve to in place of its owner.
+func NewNameTarget(targetType TargetType, value string) *NameTarget {
+	return &NameTarget{
+		Type:  targetType,
+		Value: value,
+	}
+}
+
+// ValidateBasic checks that the value of the target is a valid address or denom for its type.
+func (nt NameTarget) ValidateBasic() error {
+	_, err := nt.Bytes()
+	return err
+}
+
+// Bytes returns the address bytes or marker denom the target refers to, as used in the target index.
+func (nt NameTarget) Bytes() ([]byte, error) {
+	switch nt.Type {
+	case TargetTypeAccount:
+		addr, err := sdk.AccAddressFromBech32(nt.Value)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(ErrInvalidTarget, "invalid account address %q: %s", nt.Value, err)
+		}
+		return addr, nil
+	case TargetTypeScope:
+		addr, err := metadatatypes.MetadataAddressFromBech32(nt.Value)
+		if err != nil || !addr.IsScopeAddress() {
+			return nil, sdkerrors.Wrapf(ErrInvalidTarget, "invalid scope address %q", nt.Value)
+		}
+		return addr, nil
+	case TargetTypeScopeSpecification:
+		addr, err := metadatatypes.MetadataAddressFromBech32(nt.Value)
+		if err != nil || !addr.IsScopeSpecificationAddress() {
+			return nil, sdkerrors.Wrapf(ErrInvalidTarget, "invalid scope specification address %q", nt.Value)
+		}
+		return addr, nil
+	case TargetTypeMarker:
+		if err := sdk.ValidateDenom(nt.Value); err != nil {
+			return nil, sdkerrors.Wrapf(ErrInvalidTarget, "invalid marker denom %q: %s", nt.Value, err)
+		}
+		return []byte(nt.Value), nil
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalidTarget, "unknown target type %s", nt.Type)
+	}
+}
+
+// TargetTypeFromString returns a TargetType from a string.  It returns an error if the string is invalid.
+func TargetTypeFromString(str string) (TargetType, error) {
+	switch strings.ToLower(str) {
+	case "account":
+		return TargetTypeAccount, nil
+	case "scope":
+		return TargetTypeScope, nil
+	case "scopespec", "scope_specification":
+		return TargetTypeScopeSpecification, nil
+	case "marker":
+		return TargetTypeMarker, nil
+	default:
+		if val, ok := TargetType_value[str]; ok && TargetType(val) != TargetTypeUnspecified {
+			return TargetType(val), nil
+		}
+	}
+
+	return TargetTypeUnspecified, fmt.Errorf("'%s' is not a valid target type", str)
+}
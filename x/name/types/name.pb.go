@@ -5,17 +5,24 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -23,6 +30,46 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TargetType defines the kinds of value a name can resolve to
+type TargetType int32
+
+const (
+	// TARGET_TYPE_UNSPECIFIED - Unknown/Invalid target type
+	TargetTypeUnspecified TargetType = 0
+	// TARGET_TYPE_ACCOUNT - A bech32 account address
+	TargetTypeAccount TargetType = 1
+	// TARGET_TYPE_SCOPE - A bech32 metadata scope address
+	TargetTypeScope TargetType = 2
+	// TARGET_TYPE_SCOPE_SPECIFICATION - A bech32 metadata scope specification address
+	TargetTypeScopeSpecification TargetType = 3
+	// TARGET_TYPE_MARKER - A marker denom
+	TargetTypeMarker TargetType = 4
+)
+
+var TargetType_name = map[int32]string{
+	0: "TARGET_TYPE_UNSPECIFIED",
+	1: "TARGET_TYPE_ACCOUNT",
+	2: "TARGET_TYPE_SCOPE",
+	3: "TARGET_TYPE_SCOPE_SPECIFICATION",
+	4: "TARGET_TYPE_MARKER",
+}
+
+var TargetType_value = map[string]int32{
+	"TARGET_TYPE_UNSPECIFIED":         0,
+	"TARGET_TYPE_ACCOUNT":             1,
+	"TARGET_TYPE_SCOPE":               2,
+	"TARGET_TYPE_SCOPE_SPECIFICATION": 3,
+	"TARGET_TYPE_MARKER":              4,
+}
+
+func (x TargetType) String() string {
+	return proto.EnumName(TargetType_name, int32(x))
+}
+
+func (TargetType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{0}
+}
+
 // Params defines the set of params for the name module.
 type Params struct {
 	// maximum length of name segment to allow
@@ -33,6 +80,10 @@ type Params struct {
 	MaxNameLevels uint32 `protobuf:"varint,3,opt,name=max_name_levels,json=maxNameLevels,proto3" json:"max_name_levels,omitempty"`
 	// determines if unrestricted name keys are allowed or not
 	AllowUnrestrictedNames bool `protobuf:"varint,4,opt,name=allow_unrestricted_names,json=allowUnrestrictedNames,proto3" json:"allow_unrestricted_names,omitempty"`
+	// length of the lease on newly bound names, a zero duration disables name leases
+	LeaseDuration time.Duration `protobuf:"bytes,5,opt,name=lease_duration,json=leaseDuration,proto3,stdduration" json:"lease_duration"`
+	// fees charged to renew the lease on a name based on the length of its first segment
+	RenewalFees []RenewalFee `protobuf:"bytes,6,rep,name=renewal_fees,json=renewalFees,proto3" json:"renewal_fees"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -96,6 +147,76 @@ func (m *Params) GetAllowUnrestrictedNames() bool {
 	return false
 }
 
+func (m *Params) GetLeaseDuration() time.Duration {
+	if m != nil {
+		return m.LeaseDuration
+	}
+	return 0
+}
+
+func (m *Params) GetRenewalFees() []RenewalFee {
+	if m != nil {
+		return m.RenewalFees
+	}
+	return nil
+}
+
+// RenewalFee is the fee charged to renew the lease on a name whose first segment is no longer than max_segment_length.
+// The fee with the smallest max_segment_length that covers the segment applies, longer segments renew without a fee.
+type RenewalFee struct {
+	// maximum length of the first segment of a name this fee applies to
+	MaxSegmentLength uint32 `protobuf:"varint,1,opt,name=max_segment_length,json=maxSegmentLength,proto3" json:"max_segment_length,omitempty"`
+	// the fee charged for each renewal
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+}
+
+func (m *RenewalFee) Reset()         { *m = RenewalFee{} }
+func (m *RenewalFee) String() string { return proto.CompactTextString(m) }
+func (*RenewalFee) ProtoMessage()    {}
+func (*RenewalFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{1}
+}
+func (m *RenewalFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RenewalFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RenewalFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RenewalFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenewalFee.Merge(m, src)
+}
+func (m *RenewalFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *RenewalFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenewalFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenewalFee proto.InternalMessageInfo
+
+func (m *RenewalFee) GetMaxSegmentLength() uint32 {
+	if m != nil {
+		return m.MaxSegmentLength
+	}
+	return 0
+}
+
+func (m *RenewalFee) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
 // NameRecord is a structure used to bind ownership of a name hierarchy to a collection of addresses
 type NameRecord struct {
 	// The bound name
@@ -104,12 +225,16 @@ type NameRecord struct {
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 	// Whether owner signature is required to add sub-names.
 	Restricted bool `protobuf:"varint,3,opt,name=restricted,proto3" json:"restricted,omitempty"`
+	// The time the lease on the name ends and it is released, not set for names that do not expire.
+	Expiration *time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty" yaml:"expiration,omitempty"`
+	// The typed value the name resolves to, the owning account when not set.
+	Target *NameTarget `protobuf:"bytes,5,opt,name=target,proto3" json:"target,omitempty" yaml:"target,omitempty"`
 }
 
 func (m *NameRecord) Reset()      { *m = NameRecord{} }
 func (*NameRecord) ProtoMessage() {}
 func (*NameRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a314256905bb00ec, []int{1}
+	return fileDescriptor_a314256905bb00ec, []int{2}
 }
 func (m *NameRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -159,6 +284,76 @@ func (m *NameRecord) GetRestricted() bool {
 	return false
 }
 
+func (m *NameRecord) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (m *NameRecord) GetTarget() *NameTarget {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+// NameTarget is a typed value a name resolves to in place of the owning account, such as a metadata scope or marker.
+// The owning account keeps control of the name and the names below it.
+type NameTarget struct {
+	// the kind of value the name resolves to
+	Type TargetType `protobuf:"varint,1,opt,name=type,proto3,enum=provenance.name.v1.TargetType" json:"type,omitempty"`
+	// the bech32 account, scope or scope specification address, or the marker denom
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *NameTarget) Reset()         { *m = NameTarget{} }
+func (m *NameTarget) String() string { return proto.CompactTextString(m) }
+func (*NameTarget) ProtoMessage()    {}
+func (*NameTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{3}
+}
+func (m *NameTarget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NameTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NameTarget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NameTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NameTarget.Merge(m, src)
+}
+func (m *NameTarget) XXX_Size() int {
+	return m.Size()
+}
+func (m *NameTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_NameTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NameTarget proto.InternalMessageInfo
+
+func (m *NameTarget) GetType() TargetType {
+	if m != nil {
+		return m.Type
+	}
+	return TargetTypeUnspecified
+}
+
+func (m *NameTarget) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 // CreateRootNameProposal details a proposal to create a new root name
 // that is controlled by a given owner and optionally restricted to the owner
 // for the sole creation of sub names.
@@ -168,12 +363,14 @@ type CreateRootNameProposal struct {
 	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 	Owner       string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
 	Restricted  bool   `protobuf:"varint,5,opt,name=restricted,proto3" json:"restricted,omitempty"`
+	// when set the names created by the proposal never expire, otherwise they are leased while name leases are enabled
+	Permanent bool `protobuf:"varint,6,opt,name=permanent,proto3" json:"permanent,omitempty"`
 }
 
 func (m *CreateRootNameProposal) Reset()      { *m = CreateRootNameProposal{} }
 func (*CreateRootNameProposal) ProtoMessage() {}
 func (*CreateRootNameProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a314256905bb00ec, []int{2}
+	return fileDescriptor_a314256905bb00ec, []int{4}
 }
 func (m *CreateRootNameProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -202,6 +399,47 @@ func (m *CreateRootNameProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CreateRootNameProposal proto.InternalMessageInfo
 
+// ModifyNameProposal details a proposal to change the restriction on an existing root name, such as one created
+// through a CreateRootNameProposal.
+type ModifyNameProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Name        string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Restricted  bool   `protobuf:"varint,4,opt,name=restricted,proto3" json:"restricted,omitempty"`
+}
+
+func (m *ModifyNameProposal) Reset()      { *m = ModifyNameProposal{} }
+func (*ModifyNameProposal) ProtoMessage() {}
+func (*ModifyNameProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{5}
+}
+func (m *ModifyNameProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModifyNameProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModifyNameProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModifyNameProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyNameProposal.Merge(m, src)
+}
+func (m *ModifyNameProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModifyNameProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyNameProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyNameProposal proto.InternalMessageInfo
+
 // Event emitted when name is bound.
 type EventNameBound struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -212,7 +450,7 @@ func (m *EventNameBound) Reset()         { *m = EventNameBound{} }
 func (m *EventNameBound) String() string { return proto.CompactTextString(m) }
 func (*EventNameBound) ProtoMessage()    {}
 func (*EventNameBound) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a314256905bb00ec, []int{3}
+	return fileDescriptor_a314256905bb00ec, []int{6}
 }
 func (m *EventNameBound) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -265,7 +503,7 @@ func (m *EventNameUnbound) Reset()         { *m = EventNameUnbound{} }
 func (m *EventNameUnbound) String() string { return proto.CompactTextString(m) }
 func (*EventNameUnbound) ProtoMessage()    {}
 func (*EventNameUnbound) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a314256905bb00ec, []int{4}
+	return fileDescriptor_a314256905bb00ec, []int{7}
 }
 func (m *EventNameUnbound) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -308,144 +546,331 @@ func (m *EventNameUnbound) GetName() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "provenance.name.v1.Params")
-	proto.RegisterType((*NameRecord)(nil), "provenance.name.v1.NameRecord")
-	proto.RegisterType((*CreateRootNameProposal)(nil), "provenance.name.v1.CreateRootNameProposal")
-	proto.RegisterType((*EventNameBound)(nil), "provenance.name.v1.EventNameBound")
-	proto.RegisterType((*EventNameUnbound)(nil), "provenance.name.v1.EventNameUnbound")
+// Event emitted when a name is transferred to a new owner.
+type EventNameTransferred struct {
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PreviousOwner string `protobuf:"bytes,2,opt,name=previous_owner,json=previousOwner,proto3" json:"previous_owner,omitempty"`
+	NewOwner      string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
 }
 
-func init() { proto.RegisterFile("provenance/name/v1/name.proto", fileDescriptor_a314256905bb00ec) }
+func (m *EventNameTransferred) Reset()         { *m = EventNameTransferred{} }
+func (m *EventNameTransferred) String() string { return proto.CompactTextString(m) }
+func (*EventNameTransferred) ProtoMessage()    {}
+func (*EventNameTransferred) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{8}
+}
+func (m *EventNameTransferred) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameTransferred) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameTransferred.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventNameTransferred) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameTransferred.Merge(m, src)
+}
+func (m *EventNameTransferred) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameTransferred) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameTransferred.DiscardUnknown(m)
+}
 
-var fileDescriptor_a314256905bb00ec = []byte{
-	// 446 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xb1, 0x6e, 0x13, 0x41,
-	0x10, 0x86, 0x6f, 0x13, 0x3b, 0xc4, 0x03, 0x81, 0x68, 0x65, 0xa2, 0x13, 0x12, 0x17, 0xcb, 0x05,
-	0x4a, 0x01, 0x3e, 0x22, 0x1a, 0x44, 0x81, 0x50, 0x10, 0x5d, 0x84, 0xac, 0x43, 0x69, 0x68, 0xcc,
-	0xfa, 0x6e, 0x74, 0x59, 0xe9, 0x6e, 0xf7, 0xb4, 0xbb, 0xbe, 0x98, 0x37, 0xa0, 0xa4, 0xa4, 0x4c,
-	0xc9, 0x93, 0x20, 0xca, 0x94, 0x94, 0xc8, 0x6e, 0x78, 0x0c, 0xb4, 0x73, 0x71, 0x7c, 0x31, 0x15,
-	0xd5, 0xee, 0xcc, 0xff, 0xcf, 0xcc, 0x37, 0xd2, 0xc0, 0xe3, 0xca, 0xe8, 0x1a, 0x95, 0x50, 0x29,
-	0xc6, 0x4a, 0x94, 0x18, 0xd7, 0xc7, 0xf4, 0x8e, 0x2a, 0xa3, 0x9d, 0xe6, 0x7c, 0x2d, 0x8f, 0x28,
-	0x5d, 0x1f, 0x3f, 0xea, 0xe7, 0x3a, 0xd7, 0x24, 0xc7, 0xfe, 0xd7, 0x38, 0x87, 0x3f, 0x18, 0xec,
-	0x8c, 0x85, 0x11, 0xa5, 0xe5, 0x4f, 0x81, 0x97, 0x62, 0x3e, 0xb1, 0x98, 0x97, 0xa8, 0xdc, 0xa4,
-	0x40, 0x95, 0xbb, 0xf3, 0x90, 0x0d, 0xd8, 0xd1, 0x5e, 0xb2, 0x5f, 0x8a, 0xf9, 0x87, 0x46, 0x38,
-	0xa5, 0x3c, 0xb9, 0xa5, 0xda, 0x74, 0x6f, 0x5d, 0xbb, 0xa5, 0xba, 0xed, 0x7e, 0x02, 0x0f, 0x7c,
-	0x6f, 0xcf, 0x32, 0x29, 0xb0, 0xc6, 0xc2, 0x86, 0xdb, 0x64, 0xdd, 0x2b, 0xc5, 0xfc, 0xbd, 0x28,
-	0xf1, 0x94, 0x92, 0xfc, 0x25, 0x84, 0xa2, 0x28, 0xf4, 0xc5, 0x64, 0xa6, 0x0c, 0x5a, 0x67, 0x64,
-	0xea, 0x30, 0xa3, 0x32, 0x1b, 0x76, 0x06, 0xec, 0x68, 0x37, 0x39, 0x20, 0xfd, 0xac, 0x25, 0xfb,
-	0x72, 0x3b, 0xfc, 0x04, 0xe0, 0x3f, 0x09, 0xa6, 0xda, 0x64, 0x9c, 0x43, 0xc7, 0x17, 0x11, 0x7d,
-	0x2f, 0xa1, 0x3f, 0x0f, 0xe1, 0x8e, 0xc8, 0x32, 0x83, 0xd6, 0x12, 0x66, 0x2f, 0x59, 0x85, 0x3c,
-	0x02, 0x58, 0xb7, 0x23, 0xb0, 0xdd, 0xa4, 0x95, 0x79, 0xd5, 0xf9, 0x76, 0x79, 0x18, 0x0c, 0xbf,
-	0x33, 0x38, 0x78, 0x6b, 0x50, 0x38, 0x4c, 0xb4, 0x76, 0x7e, 0xd8, 0xd8, 0xe8, 0x4a, 0x5b, 0x51,
-	0xf0, 0x3e, 0x74, 0x9d, 0x74, 0xc5, 0x6a, 0x5e, 0x13, 0xf0, 0x01, 0xdc, 0xcd, 0xd0, 0xa6, 0x46,
-	0x56, 0x4e, 0x6a, 0x75, 0x3d, 0xb4, 0x9d, 0xba, 0xc1, 0xdc, 0x6e, 0x61, 0xf6, 0xa1, 0xab, 0x2f,
-	0x14, 0x1a, 0xda, 0xb7, 0x97, 0x34, 0xc1, 0x06, 0x62, 0xf7, 0x1f, 0xc4, 0x7b, 0x5f, 0x2e, 0x0f,
-	0x03, 0x8f, 0xf9, 0xc7, 0xa3, 0xbe, 0x86, 0xfb, 0xef, 0x6a, 0x54, 0x04, 0x79, 0xa2, 0x67, 0x2a,
-	0x6b, 0x2f, 0xcf, 0x6e, 0x2f, 0xbf, 0x62, 0xd8, 0x5a, 0x33, 0x0c, 0xdf, 0xc0, 0xfe, 0x4d, 0xfd,
-	0x99, 0x9a, 0xfe, 0x7f, 0x87, 0x93, 0xf4, 0xe7, 0x22, 0x62, 0x57, 0x8b, 0x88, 0xfd, 0x5e, 0x44,
-	0xec, 0xeb, 0x32, 0x0a, 0xae, 0x96, 0x51, 0xf0, 0x6b, 0x19, 0x05, 0xf0, 0x50, 0xd2, 0xed, 0x6d,
-	0x9c, 0xe7, 0x98, 0x7d, 0x7c, 0x9e, 0x4b, 0x77, 0x3e, 0x9b, 0x8e, 0x52, 0x5d, 0xc6, 0x6b, 0xc3,
-	0x33, 0xa9, 0x5b, 0x51, 0x3c, 0x6f, 0xce, 0xdd, 0x7d, 0xae, 0xd0, 0x4e, 0x77, 0xe8, 0x86, 0x5f,
-	0xfc, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x10, 0x3c, 0x3a, 0x83, 0x0e, 0x03, 0x00, 0x00,
+var xxx_messageInfo_EventNameTransferred proto.InternalMessageInfo
+
+func (m *EventNameTransferred) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventNameTransferred) GetPreviousOwner() string {
+	if m != nil {
+		return m.PreviousOwner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventNameTransferred) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AllowUnrestrictedNames {
-		i--
-		if m.AllowUnrestrictedNames {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+// Event emitted when the lease on a name is renewed.
+type EventNameRenewed struct {
+	Address    string    `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name       string    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Expiration time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration"`
+}
+
+func (m *EventNameRenewed) Reset()         { *m = EventNameRenewed{} }
+func (m *EventNameRenewed) String() string { return proto.CompactTextString(m) }
+func (*EventNameRenewed) ProtoMessage()    {}
+func (*EventNameRenewed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{9}
+}
+func (m *EventNameRenewed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameRenewed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameRenewed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.MaxNameLevels != 0 {
-		i = encodeVarintName(dAtA, i, uint64(m.MaxNameLevels))
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if m.MinSegmentLength != 0 {
-		i = encodeVarintName(dAtA, i, uint64(m.MinSegmentLength))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *EventNameRenewed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameRenewed.Merge(m, src)
+}
+func (m *EventNameRenewed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameRenewed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameRenewed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNameRenewed proto.InternalMessageInfo
+
+func (m *EventNameRenewed) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if m.MaxSegmentLength != 0 {
-		i = encodeVarintName(dAtA, i, uint64(m.MaxSegmentLength))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *EventNameRenewed) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *NameRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventNameRenewed) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *NameRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Event emitted when a name is released because its lease expired.
+type EventNameExpired struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *NameRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Restricted {
-		i--
-		if m.Restricted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventNameExpired) Reset()         { *m = EventNameExpired{} }
+func (m *EventNameExpired) String() string { return proto.CompactTextString(m) }
+func (*EventNameExpired) ProtoMessage()    {}
+func (*EventNameExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{10}
+}
+func (m *EventNameExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventNameExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameExpired.Merge(m, src)
+}
+func (m *EventNameExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNameExpired proto.InternalMessageInfo
+
+func (m *EventNameExpired) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *CreateRootNameProposal) Marshal() (dAtA []byte, err error) {
+func (m *EventNameExpired) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// Event emitted when the restriction on a name is changed.
+type EventNameModified struct {
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Restricted bool   `protobuf:"varint,3,opt,name=restricted,proto3" json:"restricted,omitempty"`
+}
+
+func (m *EventNameModified) Reset()         { *m = EventNameModified{} }
+func (m *EventNameModified) String() string { return proto.CompactTextString(m) }
+func (*EventNameModified) ProtoMessage()    {}
+func (*EventNameModified) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{11}
+}
+func (m *EventNameModified) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameModified) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameModified.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventNameModified) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameModified.Merge(m, src)
+}
+func (m *EventNameModified) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameModified) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameModified.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNameModified proto.InternalMessageInfo
+
+func (m *EventNameModified) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventNameModified) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventNameModified) GetRestricted() bool {
+	if m != nil {
+		return m.Restricted
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("provenance.name.v1.TargetType", TargetType_name, TargetType_value)
+	proto.RegisterType((*Params)(nil), "provenance.name.v1.Params")
+	proto.RegisterType((*RenewalFee)(nil), "provenance.name.v1.RenewalFee")
+	proto.RegisterType((*NameRecord)(nil), "provenance.name.v1.NameRecord")
+	proto.RegisterType((*NameTarget)(nil), "provenance.name.v1.NameTarget")
+	proto.RegisterType((*CreateRootNameProposal)(nil), "provenance.name.v1.CreateRootNameProposal")
+	proto.RegisterType((*ModifyNameProposal)(nil), "provenance.name.v1.ModifyNameProposal")
+	proto.RegisterType((*EventNameBound)(nil), "provenance.name.v1.EventNameBound")
+	proto.RegisterType((*EventNameUnbound)(nil), "provenance.name.v1.EventNameUnbound")
+	proto.RegisterType((*EventNameTransferred)(nil), "provenance.name.v1.EventNameTransferred")
+	proto.RegisterType((*EventNameRenewed)(nil), "provenance.name.v1.EventNameRenewed")
+	proto.RegisterType((*EventNameExpired)(nil), "provenance.name.v1.EventNameExpired")
+	proto.RegisterType((*EventNameModified)(nil), "provenance.name.v1.EventNameModified")
+}
+
+func init() { proto.RegisterFile("provenance/name/v1/name.proto", fileDescriptor_a314256905bb00ec) }
+
+var fileDescriptor_a314256905bb00ec = []byte{
+	// 1042 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xc1, 0x6b, 0x1b, 0xc7,
+	0x17, 0xd6, 0x4a, 0xb2, 0x7f, 0xf6, 0x73, 0xec, 0x28, 0x13, 0x27, 0x91, 0xe5, 0xfc, 0xa4, 0x45,
+	0xa5, 0xc5, 0x04, 0x67, 0x15, 0xbb, 0x50, 0x8a, 0x0f, 0xa5, 0x96, 0xac, 0x04, 0xb7, 0xb1, 0x2d,
+	0x56, 0x72, 0xa1, 0x85, 0xb2, 0x8c, 0x56, 0xcf, 0xca, 0x92, 0xdd, 0x99, 0x65, 0x66, 0x25, 0xdb,
+	0xff, 0x40, 0x09, 0x3e, 0x94, 0x9c, 0x4a, 0x4a, 0x31, 0x04, 0x7a, 0xeb, 0x1f, 0x52, 0x72, 0xcc,
+	0xb1, 0x50, 0x70, 0x8a, 0x7d, 0xe9, 0x39, 0x7f, 0x41, 0xd9, 0xd9, 0x95, 0x77, 0x2d, 0x1b, 0x82,
+	0x0b, 0x3d, 0x49, 0xf3, 0xde, 0xf7, 0xbe, 0xf7, 0xe6, 0xfb, 0x66, 0x46, 0x82, 0xff, 0xfb, 0x82,
+	0x0f, 0x91, 0x51, 0x66, 0x63, 0x8d, 0x51, 0x0f, 0x6b, 0xc3, 0x15, 0xf5, 0x69, 0xf8, 0x82, 0x07,
+	0x9c, 0x90, 0x24, 0x6d, 0xa8, 0xf0, 0x70, 0xa5, 0x34, 0xdf, 0xe7, 0x7d, 0xae, 0xd2, 0xb5, 0xf0,
+	0x5b, 0x84, 0x2c, 0x95, 0xfb, 0x9c, 0xf7, 0x5d, 0xac, 0xa9, 0x55, 0x77, 0xb0, 0x57, 0xeb, 0x0d,
+	0x04, 0x0d, 0x1c, 0xce, 0xe2, 0x7c, 0x65, 0x3c, 0x1f, 0x38, 0x1e, 0xca, 0x80, 0x7a, 0xfe, 0x88,
+	0xc0, 0xe6, 0xd2, 0xe3, 0xb2, 0xd6, 0xa5, 0x32, 0x9c, 0xa2, 0x8b, 0x01, 0x5d, 0xa9, 0xd9, 0xdc,
+	0x89, 0x09, 0xaa, 0x7f, 0x66, 0x61, 0xb2, 0x45, 0x05, 0xf5, 0x24, 0x59, 0x06, 0xe2, 0xd1, 0x03,
+	0x4b, 0x62, 0xdf, 0x43, 0x16, 0x58, 0x2e, 0xb2, 0x7e, 0xf0, 0xac, 0xa8, 0xe9, 0xda, 0xd2, 0xac,
+	0x59, 0xf0, 0xe8, 0x41, 0x3b, 0x4a, 0x3c, 0x55, 0x71, 0x85, 0x76, 0xd8, 0x38, 0x3a, 0x1b, 0xa3,
+	0x1d, 0x76, 0x11, 0xfd, 0x09, 0xdc, 0x0c, 0xb9, 0xc3, 0xcd, 0x5a, 0x2e, 0x0e, 0xd1, 0x95, 0xc5,
+	0x9c, 0x82, 0xce, 0x7a, 0xf4, 0x60, 0x9b, 0x7a, 0xf8, 0x54, 0x05, 0xc9, 0xe7, 0x50, 0xa4, 0xae,
+	0xcb, 0xf7, 0xad, 0x01, 0x13, 0x28, 0x03, 0xe1, 0xd8, 0x01, 0xf6, 0x54, 0x99, 0x2c, 0xe6, 0x75,
+	0x6d, 0x69, 0xca, 0xbc, 0xab, 0xf2, 0xbb, 0xa9, 0x74, 0x58, 0x2e, 0xc9, 0x57, 0x30, 0xe7, 0x22,
+	0x95, 0x68, 0x8d, 0x14, 0x2a, 0x4e, 0xe8, 0xda, 0xd2, 0xcc, 0xea, 0x82, 0x11, 0x49, 0x64, 0x8c,
+	0x24, 0x32, 0x36, 0x62, 0x40, 0x7d, 0xea, 0xcd, 0x49, 0x25, 0xf3, 0xea, 0x5d, 0x45, 0x33, 0x67,
+	0x55, 0xe9, 0x28, 0x41, 0x9e, 0xc0, 0x0d, 0x81, 0x0c, 0xf7, 0xa9, 0x6b, 0xed, 0x21, 0xca, 0xe2,
+	0xa4, 0x9e, 0x5b, 0x9a, 0x59, 0x2d, 0x1b, 0x97, 0x6d, 0x33, 0xcc, 0x08, 0xf7, 0x18, 0xb1, 0x9e,
+	0x0f, 0xe9, 0xcc, 0x19, 0x71, 0x1e, 0x91, 0xd5, 0x9f, 0x35, 0x80, 0x04, 0x71, 0x4d, 0x85, 0xbf,
+	0x87, 0xdc, 0x1e, 0x62, 0x31, 0xab, 0x9a, 0x2f, 0x18, 0x91, 0x91, 0x46, 0x68, 0xa4, 0x11, 0x1b,
+	0x69, 0x34, 0xb8, 0xc3, 0xea, 0x8f, 0xc2, 0xbe, 0xbf, 0xbd, 0xab, 0x2c, 0xf5, 0x9d, 0xe0, 0xd9,
+	0xa0, 0x6b, 0xd8, 0xdc, 0xab, 0xc5, 0xae, 0x47, 0x1f, 0x0f, 0x65, 0xef, 0x79, 0x2d, 0x38, 0xf4,
+	0x51, 0xaa, 0x02, 0x69, 0x86, 0xbc, 0xd5, 0x9f, 0xb2, 0x00, 0xa1, 0x74, 0x26, 0xda, 0x5c, 0xf4,
+	0x08, 0x81, 0x7c, 0xb8, 0x27, 0x35, 0xcd, 0xb4, 0xa9, 0xbe, 0x93, 0x22, 0xfc, 0x8f, 0xf6, 0x7a,
+	0x02, 0xa5, 0x54, 0xc6, 0x4e, 0x9b, 0xa3, 0x25, 0x29, 0x03, 0x24, 0x06, 0x28, 0x2b, 0xa7, 0xcc,
+	0x54, 0x84, 0x58, 0x00, 0x78, 0xe0, 0x3b, 0xb1, 0x13, 0x79, 0xe5, 0x44, 0xe9, 0x92, 0x13, 0x9d,
+	0xd1, 0x61, 0xad, 0x7f, 0xf4, 0xfe, 0xa4, 0xb2, 0x78, 0x48, 0x3d, 0x77, 0xad, 0x9a, 0xd4, 0x2d,
+	0x73, 0xcf, 0x09, 0xd0, 0xf3, 0x83, 0xc3, 0xea, 0xcb, 0xd0, 0xa5, 0x14, 0x25, 0x69, 0xc3, 0x64,
+	0x40, 0x45, 0x1f, 0x83, 0xd8, 0xe6, 0x2b, 0xcd, 0x09, 0xb7, 0xd7, 0x51, 0xa8, 0xfa, 0xe2, 0xfb,
+	0x93, 0xca, 0xbd, 0xa8, 0x41, 0x54, 0x97, 0x22, 0x37, 0x63, 0xaa, 0xb5, 0xfc, 0xab, 0xd7, 0x95,
+	0x4c, 0xf5, 0x1b, 0x80, 0xa4, 0x90, 0xac, 0x42, 0x3e, 0x94, 0x4e, 0xe9, 0x32, 0x77, 0x75, 0x9b,
+	0x08, 0xd9, 0x39, 0xf4, 0xd1, 0x54, 0x58, 0x32, 0x0f, 0x13, 0x43, 0xea, 0x0e, 0x30, 0x56, 0x2d,
+	0x5a, 0x54, 0x7f, 0xd7, 0xe0, 0x6e, 0x43, 0x20, 0x0d, 0xd0, 0xe4, 0x3c, 0x08, 0x5b, 0xb4, 0x04,
+	0xf7, 0xb9, 0xa4, 0x6e, 0x58, 0x10, 0x38, 0x81, 0x3b, 0x52, 0x3f, 0x5a, 0x10, 0x1d, 0x66, 0x7a,
+	0x28, 0x6d, 0xe1, 0xf8, 0x4a, 0xc5, 0x88, 0x2c, 0x1d, 0x3a, 0x37, 0x2d, 0x97, 0x32, 0x6d, 0x1e,
+	0x26, 0xf8, 0x3e, 0x43, 0xa1, 0x54, 0x9f, 0x36, 0xa3, 0xc5, 0x98, 0x61, 0x13, 0x97, 0x0c, 0xbb,
+	0x0f, 0xd3, 0x3e, 0x0a, 0x8f, 0x32, 0x64, 0x41, 0x71, 0x52, 0xa5, 0x93, 0xc0, 0xda, 0x8d, 0x17,
+	0xaf, 0x2b, 0x99, 0x50, 0x9c, 0xbf, 0x43, 0x81, 0x7e, 0xd4, 0x80, 0x6c, 0xf1, 0x9e, 0xb3, 0x77,
+	0xf8, 0x9f, 0x6d, 0xe2, 0xe2, 0xb8, 0xf9, 0xf1, 0x71, 0xc7, 0x06, 0xfa, 0x02, 0xe6, 0x9a, 0x43,
+	0x64, 0x4a, 0xd3, 0x3a, 0x1f, 0xb0, 0x5e, 0xfa, 0xe4, 0x6a, 0x17, 0x4f, 0xee, 0xa8, 0x5b, 0x36,
+	0xe9, 0x56, 0xfd, 0x12, 0x0a, 0xe7, 0xf5, 0xbb, 0xac, 0xfb, 0x2f, 0x18, 0x18, 0xcc, 0x9f, 0x33,
+	0x74, 0x04, 0x65, 0x72, 0x0f, 0x85, 0xc0, 0xab, 0x6f, 0xd5, 0xc7, 0x30, 0xe7, 0x0b, 0x1c, 0x3a,
+	0x7c, 0x20, 0xad, 0xc8, 0xa9, 0x88, 0x69, 0x76, 0x14, 0xdd, 0x51, 0x8e, 0x2d, 0xc2, 0x34, 0xc3,
+	0xfd, 0x18, 0x11, 0x69, 0x33, 0xc5, 0x70, 0x5f, 0x25, 0xab, 0x3f, 0x68, 0xa9, 0x91, 0xd5, 0x0b,
+	0x83, 0xd7, 0x1c, 0x99, 0x6c, 0x5c, 0xb8, 0xa2, 0xb9, 0x0f, 0x5e, 0x51, 0xf5, 0x5a, 0x8e, 0xdf,
+	0xc3, 0x0b, 0xd2, 0x35, 0xc3, 0xf0, 0x75, 0xe7, 0xa8, 0x52, 0xb8, 0x75, 0xce, 0xa0, 0x4e, 0x95,
+	0x73, 0xed, 0xad, 0x7c, 0xe0, 0x35, 0x7a, 0xf0, 0x4b, 0x16, 0x20, 0xb9, 0xa4, 0xe4, 0x33, 0xb8,
+	0xd7, 0x59, 0x37, 0x9f, 0x34, 0x3b, 0x56, 0xe7, 0xdb, 0x56, 0xd3, 0xda, 0xdd, 0x6e, 0xb7, 0x9a,
+	0x8d, 0xcd, 0xc7, 0x9b, 0xcd, 0x8d, 0x42, 0xa6, 0xb4, 0x70, 0x74, 0xac, 0xdf, 0x49, 0xc0, 0xbb,
+	0x4c, 0xfa, 0x68, 0x47, 0x43, 0x19, 0x70, 0x3b, 0x5d, 0xb7, 0xde, 0x68, 0xec, 0xec, 0x6e, 0x77,
+	0x0a, 0x5a, 0xe9, 0xce, 0xd1, 0xb1, 0x7e, 0x2b, 0xa9, 0x59, 0xb7, 0x6d, 0x3e, 0x60, 0x01, 0x79,
+	0x00, 0xb7, 0xd2, 0xf8, 0x76, 0x63, 0xa7, 0xd5, 0x2c, 0x64, 0x4b, 0xb7, 0x8f, 0x8e, 0xf5, 0x9b,
+	0x09, 0xba, 0x6d, 0x73, 0x1f, 0x49, 0x13, 0x2a, 0x97, 0xb0, 0x56, 0x3c, 0x57, 0x63, 0xbd, 0xb3,
+	0xb9, 0xb3, 0x5d, 0xc8, 0x95, 0xf4, 0xa3, 0x63, 0xfd, 0xfe, 0x58, 0x65, 0x3b, 0x1a, 0xcf, 0x8e,
+	0x9e, 0xc5, 0x65, 0x20, 0x69, 0x9a, 0xad, 0x75, 0xf3, 0xeb, 0xa6, 0x59, 0xc8, 0x97, 0xe6, 0x8f,
+	0x8e, 0xf5, 0x42, 0x52, 0xb9, 0x45, 0xc5, 0x73, 0x14, 0xa5, 0xfc, 0x8b, 0x5f, 0xcb, 0x99, 0xba,
+	0xfd, 0xe6, 0xb4, 0xac, 0xbd, 0x3d, 0x2d, 0x6b, 0x7f, 0x9d, 0x96, 0xb5, 0x97, 0x67, 0xe5, 0xcc,
+	0xdb, 0xb3, 0x72, 0xe6, 0x8f, 0xb3, 0x72, 0x06, 0xee, 0x38, 0xfc, 0x8a, 0xf7, 0xae, 0xa5, 0x7d,
+	0xf7, 0x28, 0xf5, 0x53, 0x93, 0x00, 0x1e, 0x3a, 0x3c, 0xb5, 0xaa, 0x1d, 0x44, 0x7f, 0x7d, 0xd4,
+	0x0f, 0x4f, 0x77, 0x52, 0x9d, 0xa8, 0x4f, 0xff, 0x19, 0x00, 0x49, 0x35, 0x5c, 0xb7, 0x1a, 0x09,
+	0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -455,58 +880,67 @@ func (m *CreateRootNameProposal) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CreateRootNameProposal) MarshalTo(dAtA []byte) (int, error) {
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CreateRootNameProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Restricted {
+	if len(m.RenewalFees) > 0 {
+		for iNdEx := len(m.RenewalFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RenewalFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintName(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.LeaseDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.LeaseDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintName(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.AllowUnrestrictedNames {
 		i--
-		if m.Restricted {
+		if m.AllowUnrestrictedNames {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x20
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+	if m.MaxNameLevels != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.MaxNameLevels))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Description)))
+	if m.MinSegmentLength != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.MinSegmentLength))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Title)))
+	if m.MaxSegmentLength != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.MaxSegmentLength))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventNameBound) Marshal() (dAtA []byte, err error) {
+func (m *RenewalFee) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -516,34 +950,39 @@ func (m *EventNameBound) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventNameBound) MarshalTo(dAtA []byte) (int, error) {
+func (m *RenewalFee) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventNameBound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RenewalFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintName(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+	if m.MaxSegmentLength != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.MaxSegmentLength))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventNameUnbound) Marshal() (dAtA []byte, err error) {
+func (m *NameRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -553,154 +992,1735 @@ func (m *EventNameUnbound) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventNameUnbound) MarshalTo(dAtA []byte) (int, error) {
+func (m *NameRecord) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventNameUnbound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NameRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+	if m.Target != nil {
+		{
+			size, err := m.Target.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintName(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x2a
+	}
+	if m.Expiration != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintName(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Restricted {
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
 		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintName(dAtA []byte, offset int, v uint64) int {
-	offset -= sovName(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *NameTarget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *NameTarget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NameTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MaxSegmentLength != 0 {
-		n += 1 + sovName(uint64(m.MaxSegmentLength))
-	}
-	if m.MinSegmentLength != 0 {
-		n += 1 + sovName(uint64(m.MinSegmentLength))
-	}
-	if m.MaxNameLevels != 0 {
-		n += 1 + sovName(uint64(m.MaxNameLevels))
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.AllowUnrestrictedNames {
-		n += 2
+	if m.Type != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *NameRecord) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreateRootNameProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateRootNameProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateRootNameProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	if m.Permanent {
+		i--
+		if m.Permanent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
 	if m.Restricted {
-		n += 2
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *CreateRootNameProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ModifyNameProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ModifyNameProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModifyNameProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	if m.Restricted {
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Restricted {
-		n += 2
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameBound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventNameBound) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventNameBound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameBound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameUnbound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameUnbound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameUnbound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventNameUnbound) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventNameTransferred) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameTransferred) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameTransferred) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintName(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	if len(m.PreviousOwner) > 0 {
+		i -= len(m.PreviousOwner)
+		copy(dAtA[i:], m.PreviousOwner)
+		i = encodeVarintName(dAtA, i, uint64(len(m.PreviousOwner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovName(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *EventNameRenewed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozName(x uint64) (n int) {
-	return sovName(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *EventNameRenewed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameRenewed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintName(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameModified) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameModified) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameModified) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Restricted {
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintName(dAtA []byte, offset int, v uint64) int {
+	offset -= sovName(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxSegmentLength != 0 {
+		n += 1 + sovName(uint64(m.MaxSegmentLength))
+	}
+	if m.MinSegmentLength != 0 {
+		n += 1 + sovName(uint64(m.MinSegmentLength))
+	}
+	if m.MaxNameLevels != 0 {
+		n += 1 + sovName(uint64(m.MaxNameLevels))
+	}
+	if m.AllowUnrestrictedNames {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.LeaseDuration)
+	n += 1 + l + sovName(uint64(l))
+	if len(m.RenewalFees) > 0 {
+		for _, e := range m.RenewalFees {
+			l = e.Size()
+			n += 1 + l + sovName(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RenewalFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxSegmentLength != 0 {
+		n += 1 + sovName(uint64(m.MaxSegmentLength))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovName(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NameRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if m.Restricted {
+		n += 2
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovName(uint64(l))
+	}
+	if m.Target != nil {
+		l = m.Target.Size()
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *NameTarget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovName(uint64(m.Type))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateRootNameProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if m.Restricted {
+		n += 2
+	}
+	if m.Permanent {
+		n += 2
+	}
+	return n
+}
+
+func (m *ModifyNameProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if m.Restricted {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventNameBound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNameUnbound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNameTransferred) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.PreviousOwner)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNameRenewed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovName(uint64(l))
+	return n
+}
+
+func (m *EventNameExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNameModified) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if m.Restricted {
+		n += 2
+	}
+	return n
+}
+
+func sovName(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozName(x uint64) (n int) {
+	return sovName(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSegmentLength", wireType)
+			}
+			m.MaxSegmentLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSegmentLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSegmentLength", wireType)
+			}
+			m.MinSegmentLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSegmentLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNameLevels", wireType)
+			}
+			m.MaxNameLevels = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNameLevels |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnrestrictedNames", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowUnrestrictedNames = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.LeaseDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenewalFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RenewalFees = append(m.RenewalFees, RenewalFee{})
+			if err := m.RenewalFees[len(m.RenewalFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RenewalFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RenewalFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RenewalFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSegmentLength", wireType)
+			}
+			m.MaxSegmentLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSegmentLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NameRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NameRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NameRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Target == nil {
+				m.Target = &NameTarget{}
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NameTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NameTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NameTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= TargetType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateRootNameProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateRootNameProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permanent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Permanent = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModifyNameProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModifyNameProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModifyNameProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *EventNameBound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -723,17 +2743,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNameBound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNameBound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSegmentLength", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.MaxSegmentLength = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowName
@@ -743,35 +2763,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxSegmentLength |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSegmentLength", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
 			}
-			m.MinSegmentLength = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowName
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinSegmentLength |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxNameLevels", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.MaxNameLevels = 0
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowName
@@ -781,31 +2795,24 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxNameLevels |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnrestrictedNames", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowName
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
 			}
-			m.AllowUnrestrictedNames = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
@@ -827,7 +2834,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NameRecord) Unmarshal(dAtA []byte) error {
+func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -850,15 +2857,15 @@ func (m *NameRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NameRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNameUnbound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NameRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNameUnbound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -886,11 +2893,11 @@ func (m *NameRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -918,28 +2925,8 @@ func (m *NameRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowName
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Restricted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
@@ -961,7 +2948,7 @@ func (m *NameRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
+func (m *EventNameTransferred) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -984,15 +2971,15 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateRootNameProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNameTransferred: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateRootNameProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNameTransferred: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1020,11 +3007,11 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1052,11 +3039,11 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.PreviousOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1084,11 +3071,61 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNameRenewed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNameRenewed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNameRenewed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1116,13 +3153,13 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowName
@@ -1132,12 +3169,57 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Restricted = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
@@ -1159,7 +3241,7 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNameBound) Unmarshal(dAtA []byte) error {
+func (m *EventNameExpired) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1182,10 +3264,10 @@ func (m *EventNameBound) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNameBound: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNameExpired: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNameBound: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNameExpired: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1273,7 +3355,7 @@ func (m *EventNameBound) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
+func (m *EventNameModified) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1296,10 +3378,10 @@ func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNameUnbound: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNameModified: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNameUnbound: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNameModified: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1366,6 +3448,26 @@ func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
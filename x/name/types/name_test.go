@@ -65,3 +65,75 @@ func (s *NameRecordTestSuite) TestNameRecordValidateBasic() {
 		})
 	}
 }
+
+func (s *NameRecordTestSuite) TestNameTargetValidateBasic() {
+	cases := map[string]struct {
+		target   *NameTarget
+		errValue string
+	}{
+		"account": {NewNameTarget(TargetTypeAccount, s.addr.String()), ""},
+		"scope":   {NewNameTarget(TargetTypeScope, "scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel"), ""},
+		"scope specification": {
+			NewNameTarget(TargetTypeScopeSpecification, "scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m"), "",
+		},
+		"marker": {NewNameTarget(TargetTypeMarker, "nhash"), ""},
+		"should fail for an unspecified type": {
+			NewNameTarget(TargetTypeUnspecified, s.addr.String()),
+			"unknown target type TARGET_TYPE_UNSPECIFIED: invalid name target",
+		},
+		"should fail for a scope specification as a scope": {
+			NewNameTarget(TargetTypeScope, "scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m"),
+			"invalid scope address \"scopespec1qnwg86nsatx5pl56muw0v9ytlz3qu3jx6m\": invalid name target",
+		},
+		"should fail for a scope as a scope specification": {
+			NewNameTarget(TargetTypeScopeSpecification, "scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel"),
+			"invalid scope specification address \"scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel\": invalid name target",
+		},
+		"should fail for an invalid marker denom": {
+			NewNameTarget(TargetTypeMarker, "1"),
+			"invalid marker denom \"1\": invalid denom: 1: invalid name target",
+		},
+	}
+	for n, tc := range cases {
+		tc := tc
+
+		s.Run(n, func() {
+			err := tc.target.ValidateBasic()
+			if tc.errValue != "" {
+				s.Require().EqualError(err, tc.errValue)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	record := NewNameRecord("example", s.addr, true)
+	record.Target = NewNameTarget(TargetTypeMarker, "")
+	s.Require().Error(record.ValidateBasic(), "the target is validated with the record")
+}
+
+func (s *NameRecordTestSuite) TestResolvedTarget() {
+	record := NewNameRecord("example", s.addr, true)
+	s.Require().Equal(NameTarget{Type: TargetTypeAccount, Value: s.addr.String()}, record.ResolvedTarget(),
+		"a record without a target resolves to its owner")
+	record.Target = NewNameTarget(TargetTypeMarker, "nhash")
+	s.Require().Equal(NameTarget{Type: TargetTypeMarker, Value: "nhash"}, record.ResolvedTarget())
+}
+
+func (s *NameRecordTestSuite) TestTargetTypeFromString() {
+	for str, expected := range map[string]TargetType{
+		"account":           TargetTypeAccount,
+		"Scope":             TargetTypeScope,
+		"scopespec":         TargetTypeScopeSpecification,
+		"MARKER":            TargetTypeMarker,
+		"TARGET_TYPE_SCOPE": TargetTypeScope,
+	} {
+		targetType, err := TargetTypeFromString(str)
+		s.Require().NoError(err, str)
+		s.Require().Equal(expected, targetType, str)
+	}
+	_, err := TargetTypeFromString("TARGET_TYPE_UNSPECIFIED")
+	s.Require().EqualError(err, "'TARGET_TYPE_UNSPECIFIED' is not a valid target type")
+	_, err = TargetTypeFromString("contract")
+	s.Require().EqualError(err, "'contract' is not a valid target type")
+}
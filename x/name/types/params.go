@@ -2,7 +2,9 @@ package types
 
 import (
 	"fmt"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -12,8 +14,13 @@ const (
 	DefaultMaxSegmentLength       = uint32(32)
 	DefaultMaxSegments            = uint32(16)
 	DefaultAllowUnrestrictedNames = true
+	// DefaultLeaseDuration of zero disables name leases
+	DefaultLeaseDuration = time.Duration(0)
 )
 
+// DefaultRenewalFees are empty so leases renew without a fee
+var DefaultRenewalFees = []RenewalFee{}
+
 // Parameter store keys
 var (
 	// maximum length of name segment to allow
@@ -24,6 +31,10 @@ var (
 	ParamStoreKeyMaxNameLevels = []byte("MaxNameLevels")
 	// determines if unrestricted name keys are allowed or not
 	ParamStoreKeyAllowUnrestrictedNames = []byte("AllowUnrestrictedNames")
+	// length of the lease on newly bound names
+	ParamStoreKeyLeaseDuration = []byte("LeaseDuration")
+	// fees charged to renew the lease on a name
+	ParamStoreKeyRenewalFees = []byte("RenewalFees")
 )
 
 // ParamKeyTable for slashing module
@@ -37,12 +48,16 @@ func NewParams(
 	minSegmentLength uint32,
 	maxNameLevels uint32,
 	allowUnrestrictedNames bool,
+	leaseDuration time.Duration,
+	renewalFees []RenewalFee,
 ) Params {
 	return Params{
 		MaxSegmentLength:       maxSegmentLength,
 		MinSegmentLength:       minSegmentLength,
 		MaxNameLevels:          maxNameLevels,
 		AllowUnrestrictedNames: allowUnrestrictedNames,
+		LeaseDuration:          leaseDuration,
+		RenewalFees:            renewalFees,
 	}
 }
 
@@ -53,6 +68,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyMinSegmentLength, &p.MinSegmentLength, validateIntParam),
 		paramtypes.NewParamSetPair(ParamStoreKeyMaxNameLevels, &p.MaxNameLevels, validateIntParam),
 		paramtypes.NewParamSetPair(ParamStoreKeyAllowUnrestrictedNames, &p.AllowUnrestrictedNames, validateAllowUnrestrictedNames),
+		paramtypes.NewParamSetPair(ParamStoreKeyLeaseDuration, &p.LeaseDuration, validateLeaseDuration),
+		paramtypes.NewParamSetPair(ParamStoreKeyRenewalFees, &p.RenewalFees, validateRenewalFees),
 	}
 }
 
@@ -63,6 +80,8 @@ func DefaultParams() Params {
 		DefaultMinSegmentLength,
 		DefaultMaxSegments,
 		DefaultAllowUnrestrictedNames,
+		DefaultLeaseDuration,
+		DefaultRenewalFees,
 	)
 }
 
@@ -98,6 +117,20 @@ func (p *Params) Equal(that interface{}) bool {
 	if p.MinSegmentLength != that1.MinSegmentLength {
 		return false
 	}
+	if p.LeaseDuration != that1.LeaseDuration {
+		return false
+	}
+	if len(p.RenewalFees) != len(that1.RenewalFees) {
+		return false
+	}
+	for i := range p.RenewalFees {
+		if p.RenewalFees[i].MaxSegmentLength != that1.RenewalFees[i].MaxSegmentLength {
+			return false
+		}
+		if !p.RenewalFees[i].Fee.IsEqual(that1.RenewalFees[i].Fee) {
+			return false
+		}
+	}
 
 	return true
 }
@@ -118,3 +151,50 @@ func validateAllowUnrestrictedNames(i interface{}) error {
 	}
 	return nil
 }
+
+func validateLeaseDuration(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("lease duration cannot be negative: %s", v)
+	}
+	return nil
+}
+
+func validateRenewalFees(i interface{}) error {
+	fees, ok := i.([]RenewalFee)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[uint32]bool)
+	for _, f := range fees {
+		if f.MaxSegmentLength == 0 {
+			return fmt.Errorf("renewal fee max segment length must be greater than zero")
+		}
+		if seen[f.MaxSegmentLength] {
+			return fmt.Errorf("duplicate renewal fee for max segment length %d", f.MaxSegmentLength)
+		}
+		seen[f.MaxSegmentLength] = true
+		if err := f.Fee.Validate(); err != nil {
+			return fmt.Errorf("invalid renewal fee for max segment length %d: %w", f.MaxSegmentLength, err)
+		}
+	}
+	return nil
+}
+
+// RenewalFeeFor returns the fee to renew a name with a first segment of the given length.  The fee with the smallest
+// max segment length covering the segment applies, an empty fee is returned when none do.
+func RenewalFeeFor(fees []RenewalFee, segmentLength uint32) sdk.Coins {
+	var match *RenewalFee
+	for i := range fees {
+		if fees[i].MaxSegmentLength >= segmentLength && (match == nil || fees[i].MaxSegmentLength < match.MaxSegmentLength) {
+			match = &fees[i]
+		}
+	}
+	if match == nil {
+		return sdk.NewCoins()
+	}
+	return match.Fee
+}
@@ -2,6 +2,9 @@ package types
 
 import (
 	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/stretchr/testify/require"
 )
@@ -16,12 +19,17 @@ func TestDefaultParams(t *testing.T) {
 	require.Equal(t, DefaultMaxSegmentLength, p.MaxSegmentLength)
 	require.Equal(t, DefaultMaxSegments, p.MaxNameLevels)
 	require.Equal(t, DefaultAllowUnrestrictedNames, p.AllowUnrestrictedNames)
+	require.Equal(t, DefaultLeaseDuration, p.LeaseDuration)
+	require.Equal(t, DefaultRenewalFees, p.RenewalFees)
 
-	require.True(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames)))
-	require.False(t, p.Equal(NewParams(1, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames)))
-	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, 1, DefaultMaxSegments, DefaultAllowUnrestrictedNames)))
-	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, 1, DefaultAllowUnrestrictedNames)))
-	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, false)))
+	require.True(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames, DefaultLeaseDuration, DefaultRenewalFees)))
+	require.False(t, p.Equal(NewParams(1, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames, DefaultLeaseDuration, DefaultRenewalFees)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, 1, DefaultMaxSegments, DefaultAllowUnrestrictedNames, DefaultLeaseDuration, DefaultRenewalFees)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, 1, DefaultAllowUnrestrictedNames, DefaultLeaseDuration, DefaultRenewalFees)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, false, DefaultLeaseDuration, DefaultRenewalFees)))
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames, time.Hour, DefaultRenewalFees)))
+	fees := []RenewalFee{{MaxSegmentLength: 3, Fee: sdk.NewCoins(sdk.NewInt64Coin("nhash", 100))}}
+	require.False(t, p.Equal(NewParams(DefaultMaxSegmentLength, DefaultMinSegmentLength, DefaultMaxSegments, DefaultAllowUnrestrictedNames, DefaultLeaseDuration, fees)))
 
 	var p2 *Params
 	require.True(t, p2.Equal(nil))
@@ -36,7 +44,7 @@ func TestParamString(t *testing.T) {
 func TestParamSetPairs(t *testing.T) {
 	p := DefaultParams()
 	pairs := p.ParamSetPairs()
-	require.Equal(t, 4, len(pairs))
+	require.Equal(t, 6, len(pairs))
 
 	for i := range pairs {
 		switch string(pairs[i].Key) {
@@ -55,8 +63,34 @@ func TestParamSetPairs(t *testing.T) {
 			require.Error(t, pairs[i].ValidatorFn("foo"))
 			require.Error(t, pairs[i].ValidatorFn(-1000))
 			require.NoError(t, pairs[i].ValidatorFn(uint32(1000)))
+		case string(ParamStoreKeyLeaseDuration):
+			require.Error(t, pairs[i].ValidatorFn("foo"))
+			require.Error(t, pairs[i].ValidatorFn(-time.Hour))
+			require.NoError(t, pairs[i].ValidatorFn(time.Duration(0)))
+			require.NoError(t, pairs[i].ValidatorFn(365*24*time.Hour))
+		case string(ParamStoreKeyRenewalFees):
+			fee := sdk.NewCoins(sdk.NewInt64Coin("nhash", 100))
+			require.Error(t, pairs[i].ValidatorFn("foo"))
+			require.Error(t, pairs[i].ValidatorFn([]RenewalFee{{MaxSegmentLength: 0, Fee: fee}}))
+			require.Error(t, pairs[i].ValidatorFn([]RenewalFee{{MaxSegmentLength: 3, Fee: fee}, {MaxSegmentLength: 3, Fee: fee}}))
+			require.Error(t, pairs[i].ValidatorFn([]RenewalFee{{MaxSegmentLength: 3, Fee: sdk.Coins{sdk.Coin{Denom: "nhash", Amount: sdk.NewInt(-1)}}}}))
+			require.NoError(t, pairs[i].ValidatorFn([]RenewalFee{}))
+			require.NoError(t, pairs[i].ValidatorFn([]RenewalFee{{MaxSegmentLength: 3, Fee: fee}, {MaxSegmentLength: 6, Fee: fee}}))
 		default:
 			require.Fail(t, "unexpected param set pair")
 		}
 	}
 }
+
+func TestRenewalFeeFor(t *testing.T) {
+	short := sdk.NewCoins(sdk.NewInt64Coin("nhash", 1000))
+	medium := sdk.NewCoins(sdk.NewInt64Coin("nhash", 100))
+	fees := []RenewalFee{{MaxSegmentLength: 8, Fee: medium}, {MaxSegmentLength: 3, Fee: short}}
+
+	require.Equal(t, short, RenewalFeeFor(fees, 2))
+	require.Equal(t, short, RenewalFeeFor(fees, 3))
+	require.Equal(t, medium, RenewalFeeFor(fees, 4))
+	require.Equal(t, medium, RenewalFeeFor(fees, 8))
+	require.True(t, RenewalFeeFor(fees, 9).IsZero())
+	require.True(t, RenewalFeeFor(nil, 3).IsZero())
+}
@@ -11,14 +11,21 @@ import (
 const (
 	// ProposalTypeCreateRootName defines the type for a CreateRootNameProposal
 	ProposalTypeCreateRootName = "CreateRootName"
+	// ProposalTypeModifyName defines the type for a ModifyNameProposal
+	ProposalTypeModifyName = "ModifyName"
 )
 
-// Assert CreateRootNameProposal implements govtypes.Content at compile-time
-var _ govtypes.Content = &CreateRootNameProposal{}
+// Assert CreateRootNameProposal and ModifyNameProposal implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &CreateRootNameProposal{}
+	_ govtypes.Content = &ModifyNameProposal{}
+)
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeCreateRootName)
 	govtypes.RegisterProposalTypeCodec(&CreateRootNameProposal{}, "provenance/CreateRootNameProposal")
+	govtypes.RegisterProposalType(ProposalTypeModifyName)
+	govtypes.RegisterProposalTypeCodec(&ModifyNameProposal{}, "provenance/ModifyNameProposal")
 }
 
 // NewCreateRootNameProposal create a new governance proposal request to create a root name
@@ -75,6 +82,57 @@ func (crnp CreateRootNameProposal) String() string {
   Owner:       %s
   Name:        %s
   Restricted:  %v
-`, crnp.Title, crnp.Description, crnp.Owner, crnp.Name, crnp.Restricted))
+  Permanent:   %v
+`, crnp.Title, crnp.Description, crnp.Owner, crnp.Name, crnp.Restricted, crnp.Permanent))
+	return b.String()
+}
+
+// NewModifyNameProposal create a new governance proposal request to change the restriction on an existing name
+func NewModifyNameProposal(title, description, name string, restricted bool) *ModifyNameProposal {
+	return &ModifyNameProposal{
+		Title:       title,
+		Description: description,
+		Name:        name,
+		Restricted:  restricted,
+	}
+}
+
+// GetTitle returns the title of a modify name proposal.
+func (mnp ModifyNameProposal) GetTitle() string { return mnp.Title }
+
+// GetDescription returns the description of a modify name proposal.
+func (mnp ModifyNameProposal) GetDescription() string { return mnp.Description }
+
+// ProposalRoute returns the routing key of a modify name proposal.
+func (mnp ModifyNameProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a modify name proposal.
+func (mnp ModifyNameProposal) ProposalType() string { return ProposalTypeModifyName }
+
+// ValidateBasic runs basic stateless validity checks
+func (mnp ModifyNameProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(mnp)
+	if err != nil {
+		return err
+	}
+	if strings.TrimSpace(mnp.Name) == "" {
+		return ErrInvalidLengthName
+	}
+	if strings.Contains(mnp.Name, ".") {
+		return ErrNameContainsSegments
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (mnp ModifyNameProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Modify Name Proposal:
+  Title:       %s
+  Description: %s
+  Name:        %s
+  Restricted:  %v
+`, mnp.Title, mnp.Description, mnp.Name, mnp.Restricted))
 	return b.String()
 }
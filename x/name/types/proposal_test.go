@@ -27,9 +27,29 @@ func TestParameterChangeProposal(t *testing.T) {
   Owner:       
   Name:        root
   Restricted:  false
+  Permanent:   false
 `, crnp.String())
 }
 
+func TestModifyNameProposal(t *testing.T) {
+	mnp := NewModifyNameProposal("test title", "test description", "root", true)
+
+	require.Equal(t, "test title", mnp.GetTitle())
+	require.Equal(t, "test description", mnp.GetDescription())
+	require.Equal(t, RouterKey, mnp.ProposalRoute())
+	require.Equal(t, ProposalTypeModifyName, mnp.ProposalType())
+	require.Equal(t, true, mnp.Restricted)
+	require.Nil(t, mnp.ValidateBasic())
+	require.Equal(t, ErrInvalidLengthName, NewModifyNameProposal("test title", "test description", " ", true).ValidateBasic())
+	require.Equal(t, ErrNameContainsSegments, NewModifyNameProposal("test title", "test description", "sub.root", true).ValidateBasic())
+	require.Equal(t, `Modify Name Proposal:
+  Title:       test title
+  Description: test description
+  Name:        root
+  Restricted:  true
+`, mnp.String())
+}
+
 type IntegrationTestSuite struct {
 	suite.Suite
 }
@@ -1,5 +1,7 @@
 package types
 
+import "time"
+
 // querier keys
 const (
 	// The query base for getting the module params
@@ -12,9 +14,11 @@ const (
 
 // QueryNameResult contains the address from a name query.
 type QueryNameResult struct {
-	Name       string `json:"name"`
-	Address    string `json:"address"`
-	Restricted bool   `json:"restricted"`
+	Name       string      `json:"name"`
+	Address    string      `json:"address"`
+	Restricted bool        `json:"restricted"`
+	Expiration *time.Time  `json:"expiration,omitempty"`
+	Target     *NameTarget `json:"target,omitempty"`
 }
 
 // String implements fmt.Stringer
@@ -156,6 +156,8 @@ var xxx_messageInfo_QueryResolveRequest proto.InternalMessageInfo
 type QueryResolveResponse struct {
 	// a string containing the address the name resolves to
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// the typed value the name resolves to, the owning account when the name has no target
+	Target *NameTarget `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
 }
 
 func (m *QueryResolveResponse) Reset()         { *m = QueryResolveResponse{} }
@@ -198,6 +200,13 @@ func (m *QueryResolveResponse) GetAddress() string {
 	return ""
 }
 
+func (m *QueryResolveResponse) GetTarget() *NameTarget {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
 // QueryReverseLookupRequest is the request type for the Query/ReverseLookup method.
 type QueryReverseLookupRequest struct {
 	// address to find name records for
@@ -280,6 +289,172 @@ func (m *QueryReverseLookupResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryReverseLookupResponse proto.InternalMessageInfo
 
+// QueryChildrenRequest is the request type for the Query/Children method.
+type QueryChildrenRequest struct {
+	// name to find the child names of
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryChildrenRequest) Reset()         { *m = QueryChildrenRequest{} }
+func (m *QueryChildrenRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChildrenRequest) ProtoMessage()    {}
+func (*QueryChildrenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{6}
+}
+func (m *QueryChildrenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChildrenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChildrenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChildrenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChildrenRequest.Merge(m, src)
+}
+func (m *QueryChildrenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChildrenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChildrenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChildrenRequest proto.InternalMessageInfo
+
+// QueryChildrenResponse is the response type for the Query/Children method.
+type QueryChildrenResponse struct {
+	// an array of the names directly below the given name
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryChildrenResponse) Reset()         { *m = QueryChildrenResponse{} }
+func (m *QueryChildrenResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChildrenResponse) ProtoMessage()    {}
+func (*QueryChildrenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{7}
+}
+func (m *QueryChildrenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChildrenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChildrenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChildrenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChildrenResponse.Merge(m, src)
+}
+func (m *QueryChildrenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChildrenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChildrenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChildrenResponse proto.InternalMessageInfo
+
+// QueryReverseLookupTargetRequest is the request type for the Query/ReverseLookupTarget method.
+type QueryReverseLookupTargetRequest struct {
+	// the kind of target to find name records for
+	Type TargetType `protobuf:"varint,1,opt,name=type,proto3,enum=provenance.name.v1.TargetType" json:"type,omitempty"`
+	// the bech32 address or marker denom of the target to find name records for
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryReverseLookupTargetRequest) Reset()         { *m = QueryReverseLookupTargetRequest{} }
+func (m *QueryReverseLookupTargetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReverseLookupTargetRequest) ProtoMessage()    {}
+func (*QueryReverseLookupTargetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{8}
+}
+func (m *QueryReverseLookupTargetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReverseLookupTargetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReverseLookupTargetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReverseLookupTargetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReverseLookupTargetRequest.Merge(m, src)
+}
+func (m *QueryReverseLookupTargetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReverseLookupTargetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReverseLookupTargetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReverseLookupTargetRequest proto.InternalMessageInfo
+
+// QueryReverseLookupTargetResponse is the response type for the Query/ReverseLookupTarget method.
+type QueryReverseLookupTargetResponse struct {
+	// an array of the names that resolve to the given target
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryReverseLookupTargetResponse) Reset()         { *m = QueryReverseLookupTargetResponse{} }
+func (m *QueryReverseLookupTargetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReverseLookupTargetResponse) ProtoMessage()    {}
+func (*QueryReverseLookupTargetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4e9b0d5536fc961a, []int{9}
+}
+func (m *QueryReverseLookupTargetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReverseLookupTargetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReverseLookupTargetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReverseLookupTargetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReverseLookupTargetResponse.Merge(m, src)
+}
+func (m *QueryReverseLookupTargetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReverseLookupTargetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReverseLookupTargetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReverseLookupTargetResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.name.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.name.v1.QueryParamsResponse")
@@ -287,45 +462,60 @@ func init() {
 	proto.RegisterType((*QueryResolveResponse)(nil), "provenance.name.v1.QueryResolveResponse")
 	proto.RegisterType((*QueryReverseLookupRequest)(nil), "provenance.name.v1.QueryReverseLookupRequest")
 	proto.RegisterType((*QueryReverseLookupResponse)(nil), "provenance.name.v1.QueryReverseLookupResponse")
+	proto.RegisterType((*QueryChildrenRequest)(nil), "provenance.name.v1.QueryChildrenRequest")
+	proto.RegisterType((*QueryChildrenResponse)(nil), "provenance.name.v1.QueryChildrenResponse")
+	proto.RegisterType((*QueryReverseLookupTargetRequest)(nil), "provenance.name.v1.QueryReverseLookupTargetRequest")
+	proto.RegisterType((*QueryReverseLookupTargetResponse)(nil), "provenance.name.v1.QueryReverseLookupTargetResponse")
 }
 
 func init() { proto.RegisterFile("provenance/name/v1/query.proto", fileDescriptor_4e9b0d5536fc961a) }
 
 var fileDescriptor_4e9b0d5536fc961a = []byte{
-	// 515 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xb1, 0x6e, 0x13, 0x41,
-	0x10, 0xbd, 0x4d, 0x82, 0x03, 0x8b, 0x68, 0x06, 0x23, 0x99, 0x53, 0x38, 0xa3, 0x53, 0xe4, 0x44,
-	0x11, 0xd9, 0x8d, 0x93, 0x06, 0x51, 0xa6, 0x80, 0x06, 0x09, 0x73, 0x25, 0xdd, 0xda, 0x59, 0x1d,
-	0x27, 0xec, 0x9b, 0xcb, 0xed, 0xf9, 0x44, 0x14, 0xb9, 0x81, 0x82, 0x94, 0x48, 0xb4, 0x14, 0xf9,
-	0x04, 0x3e, 0x23, 0x65, 0x24, 0x1a, 0x2a, 0x84, 0x6c, 0x0a, 0x3e, 0x03, 0xdd, 0xee, 0x5a, 0xb6,
-	0xe5, 0x0d, 0xa6, 0x5b, 0xcf, 0xbc, 0x37, 0xef, 0x8d, 0xdf, 0x1c, 0x0d, 0xb2, 0x1c, 0x4b, 0x99,
-	0x8a, 0xb4, 0x27, 0x79, 0x2a, 0x06, 0x92, 0x97, 0x6d, 0x7e, 0x3a, 0x94, 0xf9, 0x19, 0xcb, 0x72,
-	0x2c, 0x10, 0x60, 0xd6, 0x67, 0x55, 0x9f, 0x95, 0x6d, 0x7f, 0xaf, 0x87, 0x6a, 0x80, 0x8a, 0x77,
-	0x85, 0x92, 0x06, 0xcc, 0xcb, 0x76, 0x57, 0x16, 0xa2, 0xcd, 0x33, 0x11, 0x27, 0xa9, 0x28, 0x12,
-	0x4c, 0x0d, 0xdf, 0xaf, 0xc7, 0x18, 0xa3, 0x7e, 0xf2, 0xea, 0x65, 0xab, 0x5b, 0x31, 0x62, 0xdc,
-	0x97, 0x5c, 0x64, 0x09, 0x17, 0x69, 0x8a, 0x85, 0xa6, 0x28, 0xdb, 0x7d, 0xe4, 0xf0, 0xa4, 0xb5,
-	0x75, 0x3b, 0xac, 0x53, 0x78, 0x5d, 0x89, 0x76, 0x44, 0x2e, 0x06, 0x2a, 0x92, 0xa7, 0x43, 0xa9,
-	0x8a, 0xf0, 0x15, 0xbd, 0xbf, 0x50, 0x55, 0x19, 0xa6, 0x4a, 0xc2, 0x53, 0x5a, 0xcb, 0x74, 0xa5,
-	0x41, 0x1e, 0x93, 0xdd, 0xbb, 0x87, 0x3e, 0x5b, 0x5e, 0x88, 0x19, 0xce, 0xf1, 0xc6, 0xd5, 0xcf,
-	0xa6, 0x17, 0x59, 0x7c, 0x78, 0x64, 0x07, 0x46, 0x52, 0x61, 0xbf, 0x94, 0x56, 0x07, 0x80, 0x6e,
-	0x54, 0x34, 0x3d, 0xee, 0x4e, 0xa4, 0xdf, 0xcf, 0x6e, 0x5f, 0x5c, 0x36, 0xbd, 0x3f, 0x97, 0x4d,
-	0x2f, 0x3c, 0xa0, 0xf5, 0x45, 0x92, 0xb5, 0xd1, 0xa0, 0x9b, 0xe2, 0xe4, 0x24, 0x97, 0x4a, 0x59,
-	0xe2, 0xf4, 0x67, 0xf8, 0x89, 0xd0, 0x87, 0x96, 0x52, 0xca, 0x5c, 0xc9, 0x97, 0x88, 0xef, 0x86,
-	0xd9, 0x54, 0xed, 0x46, 0x1e, 0x3c, 0xa7, 0x74, 0xf6, 0x67, 0x37, 0xd6, 0xf4, 0x72, 0x2d, 0x66,
-	0x92, 0x61, 0x55, 0x32, 0xcc, 0xc4, 0x68, 0x93, 0x61, 0x1d, 0x11, 0x4f, 0x77, 0x88, 0xe6, 0x98,
-	0x73, 0xde, 0x3f, 0x12, 0xea, 0xbb, 0x9c, 0xd8, 0x15, 0x66, 0x8b, 0xaf, 0x4f, 0x17, 0x87, 0x17,
-	0x0e, 0x13, 0x3b, 0x2b, 0x4d, 0x98, 0x81, 0x6e, 0x17, 0x87, 0xdf, 0xd6, 0xe9, 0x2d, 0xed, 0x02,
-	0x46, 0xb4, 0x66, 0x82, 0x81, 0x96, 0x2b, 0xb4, 0xe5, 0x1b, 0xf0, 0x77, 0x56, 0xe2, 0x8c, 0x74,
-	0x18, 0x7e, 0xf8, 0xfe, 0xfb, 0xcb, 0xda, 0x16, 0xf8, 0xdc, 0x71, 0x6a, 0x26, 0x7f, 0xb8, 0x20,
-	0x74, 0xd3, 0xc6, 0x08, 0x37, 0x0f, 0x5e, 0xbc, 0x0e, 0x7f, 0x77, 0x35, 0xd0, 0x5a, 0xd8, 0xd3,
-	0x16, 0xb6, 0x21, 0x74, 0x59, 0xc8, 0x0d, 0x98, 0x9f, 0x57, 0x85, 0x11, 0x7c, 0x25, 0xf4, 0xde,
-	0x42, 0x28, 0xb0, 0xff, 0x0f, 0x9d, 0xe5, 0x33, 0xf2, 0xd9, 0xff, 0xc2, 0xad, 0xb9, 0x27, 0xda,
-	0x5c, 0x0b, 0xb6, 0x5d, 0xe6, 0xfa, 0x1a, 0xcb, 0xcf, 0xed, 0x25, 0x8e, 0x8e, 0x7b, 0x57, 0xe3,
-	0x80, 0x5c, 0x8f, 0x03, 0xf2, 0x6b, 0x1c, 0x90, 0xcf, 0x93, 0xc0, 0xbb, 0x9e, 0x04, 0xde, 0x8f,
-	0x49, 0xe0, 0xd1, 0x07, 0x09, 0x3a, 0x94, 0x3b, 0xe4, 0xcd, 0x41, 0x9c, 0x14, 0x6f, 0x87, 0x5d,
-	0xd6, 0xc3, 0xc1, 0x9c, 0xc4, 0x7e, 0x82, 0xf3, 0x82, 0xef, 0x8d, 0x64, 0x71, 0x96, 0x49, 0xd5,
-	0xad, 0xe9, 0x8f, 0xff, 0xe8, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x66, 0xb0, 0x67, 0x48, 0xb1,
-	0x04, 0x00, 0x00,
+	// 702 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x95, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xc7, 0x3b, 0xfc, 0x28, 0x30, 0x46, 0x0f, 0x43, 0x49, 0x70, 0x83, 0x5b, 0xb2, 0x22, 0x20,
+	0xca, 0x0e, 0x2d, 0xc6, 0x10, 0x8f, 0x98, 0xe8, 0xc5, 0x28, 0x6e, 0x38, 0x79, 0x9b, 0x96, 0xc9,
+	0xb2, 0xb1, 0xdd, 0x59, 0x76, 0xb6, 0x1b, 0x49, 0x6d, 0x62, 0xf4, 0x20, 0x89, 0x1e, 0x4c, 0xbc,
+	0x7a, 0xe0, 0xef, 0x30, 0xf1, 0xce, 0x91, 0xc4, 0x8b, 0x27, 0x63, 0xc0, 0x83, 0x7f, 0x84, 0x07,
+	0xb3, 0x33, 0xaf, 0xa1, 0xa5, 0x53, 0xa8, 0x89, 0x78, 0xdb, 0x9d, 0x79, 0x6f, 0xbe, 0x9f, 0xf7,
+	0x7d, 0x3b, 0x6f, 0xb1, 0x1d, 0xc5, 0x22, 0xe5, 0x21, 0x0b, 0xab, 0x9c, 0x86, 0xac, 0xce, 0x69,
+	0x5a, 0xa2, 0x3b, 0x0d, 0x1e, 0xef, 0xba, 0x51, 0x2c, 0x12, 0x41, 0xc8, 0xc9, 0xbe, 0x9b, 0xed,
+	0xbb, 0x69, 0xc9, 0x5a, 0xaa, 0x0a, 0x59, 0x17, 0x92, 0x56, 0x98, 0xe4, 0x3a, 0x98, 0xa6, 0xa5,
+	0x0a, 0x4f, 0x58, 0x89, 0x46, 0xcc, 0x0f, 0x42, 0x96, 0x04, 0x22, 0xd4, 0xf9, 0x56, 0xc1, 0x17,
+	0xbe, 0x50, 0x8f, 0x34, 0x7b, 0x82, 0xd5, 0x19, 0x5f, 0x08, 0xbf, 0xc6, 0x29, 0x8b, 0x02, 0xca,
+	0xc2, 0x50, 0x24, 0x2a, 0x45, 0xc2, 0xee, 0x35, 0x03, 0x93, 0xd2, 0x56, 0xdb, 0x4e, 0x01, 0x93,
+	0xa7, 0x99, 0xe8, 0x06, 0x8b, 0x59, 0x5d, 0x7a, 0x7c, 0xa7, 0xc1, 0x65, 0xe2, 0x3c, 0xc1, 0x93,
+	0x5d, 0xab, 0x32, 0x12, 0xa1, 0xe4, 0x64, 0x0d, 0xe7, 0x23, 0xb5, 0x32, 0x8d, 0x66, 0xd1, 0xe2,
+	0xa5, 0xb2, 0xe5, 0xf6, 0x16, 0xe4, 0xea, 0x9c, 0xf5, 0x91, 0x83, 0xef, 0xc5, 0x9c, 0x07, 0xf1,
+	0xce, 0x2a, 0x1c, 0xe8, 0x71, 0x29, 0x6a, 0x29, 0x07, 0x1d, 0x42, 0xf0, 0x48, 0x96, 0xa6, 0x8e,
+	0x9b, 0xf0, 0xd4, 0xf3, 0xbd, 0xf1, 0xbd, 0xfd, 0x62, 0xee, 0xd7, 0x7e, 0x31, 0xe7, 0x6c, 0xe3,
+	0x42, 0x77, 0x12, 0x60, 0x4c, 0xe3, 0x31, 0xb6, 0xb5, 0x15, 0x73, 0x29, 0x21, 0xb1, 0xfd, 0x4a,
+	0xee, 0xe2, 0x7c, 0xc2, 0x62, 0x9f, 0x27, 0xd3, 0x43, 0x0a, 0xd0, 0x36, 0x01, 0x3e, 0x66, 0x75,
+	0xbe, 0xa9, 0xa2, 0x3c, 0x88, 0x76, 0xde, 0x22, 0x7c, 0x15, 0xa4, 0x52, 0x1e, 0x4b, 0xfe, 0x48,
+	0x88, 0xe7, 0x8d, 0xa8, 0x4d, 0xd9, 0x5f, 0xef, 0x01, 0xc6, 0x27, 0x4d, 0x02, 0xcd, 0x79, 0x57,
+	0x77, 0xd4, 0xcd, 0x3a, 0xea, 0xea, 0xf6, 0x43, 0x47, 0xdd, 0x0d, 0xe6, 0xb7, 0x6b, 0xf7, 0x3a,
+	0x32, 0x3b, 0x6a, 0x7e, 0x83, 0xb0, 0x65, 0x22, 0x81, 0xd2, 0x4f, 0x0c, 0x1b, 0x6e, 0x1b, 0x46,
+	0x1e, 0x1a, 0x20, 0x16, 0xce, 0x85, 0xd0, 0x07, 0xf6, 0xa1, 0x78, 0x09, 0xce, 0xdf, 0xdf, 0x0e,
+	0x6a, 0x5b, 0x31, 0x0f, 0xcf, 0xe8, 0xd7, 0x05, 0x78, 0xf0, 0x0a, 0xe1, 0xa9, 0x53, 0xf2, 0x50,
+	0x7e, 0x01, 0x8f, 0x66, 0x9a, 0x12, 0xea, 0xd7, 0x2f, 0x17, 0x61, 0xc0, 0x17, 0x84, 0x8b, 0xbd,
+	0x6d, 0x80, 0xaf, 0x06, 0xcc, 0x28, 0xe3, 0x91, 0x64, 0x37, 0xd2, 0x66, 0x5c, 0x31, 0x7f, 0x6a,
+	0x3a, 0x61, 0x73, 0x37, 0xe2, 0x9e, 0x8a, 0xcd, 0x0a, 0x48, 0x59, 0xad, 0xc1, 0x15, 0xe5, 0x84,
+	0xa7, 0x5f, 0x4e, 0x59, 0x38, 0xfc, 0x0f, 0x2c, 0x7c, 0x87, 0xf0, 0x6c, 0x7f, 0xfe, 0xff, 0xec,
+	0x66, 0xf9, 0xf7, 0x28, 0x1e, 0x55, 0x34, 0xa4, 0x85, 0xf3, 0x7a, 0x3e, 0x90, 0x79, 0x93, 0x5f,
+	0xbd, 0xa3, 0xc8, 0x5a, 0x38, 0x37, 0x4e, 0x4b, 0x3b, 0xce, 0xeb, 0xaf, 0x3f, 0x3f, 0x0e, 0xcd,
+	0x10, 0x8b, 0x1a, 0x26, 0x9e, 0x1e, 0x43, 0x64, 0x0f, 0xe1, 0x31, 0x98, 0x26, 0xa4, 0xff, 0xc1,
+	0xdd, 0x43, 0xca, 0x5a, 0x3c, 0x3f, 0x10, 0x10, 0x96, 0x14, 0xc2, 0x1c, 0x71, 0x4c, 0x08, 0xb1,
+	0x0e, 0xa6, 0xcd, 0x6c, 0xa1, 0x45, 0x3e, 0x21, 0x7c, 0xb9, 0xab, 0x39, 0x64, 0xf9, 0x0c, 0x9d,
+	0xde, 0xa9, 0x64, 0xb9, 0x83, 0x86, 0x03, 0xdc, 0x6d, 0x05, 0x37, 0x4f, 0xe6, 0x4c, 0x70, 0x35,
+	0x15, 0x4b, 0x9b, 0x30, 0xd8, 0x5a, 0xe4, 0x3d, 0xc2, 0xe3, 0xed, 0xeb, 0x47, 0xfa, 0x3b, 0x70,
+	0x6a, 0x40, 0x58, 0x37, 0x07, 0x88, 0x04, 0x9e, 0x5b, 0x8a, 0xe7, 0x06, 0xb9, 0x6e, 0xe2, 0xa9,
+	0x42, 0x74, 0xdb, 0xad, 0xcf, 0x08, 0x4f, 0x1a, 0x3e, 0x65, 0xb2, 0x3a, 0x98, 0x09, 0x5d, 0x17,
+	0xd7, 0xba, 0xf3, 0x77, 0x49, 0xc0, 0xbb, 0xa6, 0x78, 0xcb, 0x64, 0xe5, 0x0c, 0xff, 0xf4, 0xef,
+	0x84, 0x36, 0xb3, 0xbb, 0xde, 0xa2, 0x4d, 0x75, 0xbb, 0x5b, 0xeb, 0xd5, 0x83, 0x23, 0x1b, 0x1d,
+	0x1e, 0xd9, 0xe8, 0xc7, 0x91, 0x8d, 0x3e, 0x1c, 0xdb, 0xb9, 0xc3, 0x63, 0x3b, 0xf7, 0xed, 0xd8,
+	0xce, 0xe1, 0xa9, 0x40, 0x18, 0x58, 0x36, 0xd0, 0xb3, 0x15, 0x3f, 0x48, 0xb6, 0x1b, 0x15, 0xb7,
+	0x2a, 0xea, 0x1d, 0x72, 0xcb, 0x81, 0xe8, 0x14, 0x7f, 0xa1, 0xe5, 0x33, 0x31, 0x59, 0xc9, 0xab,
+	0xff, 0xf9, 0xea, 0x9f, 0x01, 0x00, 0x0d, 0x29, 0xb9, 0x7c, 0x84, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -346,6 +536,10 @@ type QueryClient interface {
 	Resolve(ctx context.Context, in *QueryResolveRequest, opts ...grpc.CallOption) (*QueryResolveResponse, error)
 	// ReverseLookup queries for all names bound against a given address
 	ReverseLookup(ctx context.Context, in *QueryReverseLookupRequest, opts ...grpc.CallOption) (*QueryReverseLookupResponse, error)
+	// Children queries for the names directly below a given name
+	Children(ctx context.Context, in *QueryChildrenRequest, opts ...grpc.CallOption) (*QueryChildrenResponse, error)
+	// ReverseLookupTarget queries for all names that resolve to a given typed target, such as a metadata scope
+	ReverseLookupTarget(ctx context.Context, in *QueryReverseLookupTargetRequest, opts ...grpc.CallOption) (*QueryReverseLookupTargetResponse, error)
 }
 
 type queryClient struct {
@@ -383,6 +577,24 @@ func (c *queryClient) ReverseLookup(ctx context.Context, in *QueryReverseLookupR
 	return out, nil
 }
 
+func (c *queryClient) Children(ctx context.Context, in *QueryChildrenRequest, opts ...grpc.CallOption) (*QueryChildrenResponse, error) {
+	out := new(QueryChildrenResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/Children", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReverseLookupTarget(ctx context.Context, in *QueryReverseLookupTargetRequest, opts ...grpc.CallOption) (*QueryReverseLookupTargetResponse, error) {
+	out := new(QueryReverseLookupTargetResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Query/ReverseLookupTarget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries params of the name module.
@@ -391,6 +603,10 @@ type QueryServer interface {
 	Resolve(context.Context, *QueryResolveRequest) (*QueryResolveResponse, error)
 	// ReverseLookup queries for all names bound against a given address
 	ReverseLookup(context.Context, *QueryReverseLookupRequest) (*QueryReverseLookupResponse, error)
+	// Children queries for the names directly below a given name
+	Children(context.Context, *QueryChildrenRequest) (*QueryChildrenResponse, error)
+	// ReverseLookupTarget queries for all names that resolve to a given typed target, such as a metadata scope
+	ReverseLookupTarget(context.Context, *QueryReverseLookupTargetRequest) (*QueryReverseLookupTargetResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -406,6 +622,12 @@ func (*UnimplementedQueryServer) Resolve(ctx context.Context, req *QueryResolveR
 func (*UnimplementedQueryServer) ReverseLookup(ctx context.Context, req *QueryReverseLookupRequest) (*QueryReverseLookupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReverseLookup not implemented")
 }
+func (*UnimplementedQueryServer) Children(ctx context.Context, req *QueryChildrenRequest) (*QueryChildrenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Children not implemented")
+}
+func (*UnimplementedQueryServer) ReverseLookupTarget(ctx context.Context, req *QueryReverseLookupTargetRequest) (*QueryReverseLookupTargetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseLookupTarget not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -465,6 +687,42 @@ func _Query_ReverseLookup_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Children_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChildrenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Children(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Query/Children",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Children(ctx, req.(*QueryChildrenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReverseLookupTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReverseLookupTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReverseLookupTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Query/ReverseLookupTarget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReverseLookupTarget(ctx, req.(*QueryReverseLookupTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.name.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -481,6 +739,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReverseLookup",
 			Handler:    _Query_ReverseLookup_Handler,
 		},
+		{
+			MethodName: "Children",
+			Handler:    _Query_Children_Handler,
+		},
+		{
+			MethodName: "ReverseLookupTarget",
+			Handler:    _Query_ReverseLookupTarget_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/name/v1/query.proto",
@@ -592,6 +858,18 @@ func (m *QueryResolveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Target != nil {
+		{
+			size, err := m.Target.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
@@ -688,106 +966,695 @@ func (m *QueryReverseLookupResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryChildrenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryChildrenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryResolveRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryChildrenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryResolveResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryChildrenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryReverseLookupRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryChildrenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryReverseLookupResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryChildrenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Name) > 0 {
-		for _, s := range m.Name {
-			l = len(s)
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Names[iNdEx])
+			copy(dAtA[i:], m.Names[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Names[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReverseLookupTargetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReverseLookupTargetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReverseLookupTargetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReverseLookupTargetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReverseLookupTargetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReverseLookupTargetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Names[iNdEx])
+			copy(dAtA[i:], m.Names[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Names[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryResolveRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResolveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Target != nil {
+		l = m.Target.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReverseLookupRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReverseLookupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		for _, s := range m.Name {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChildrenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChildrenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReverseLookupTargetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReverseLookupTargetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Target == nil {
+				m.Target = &NameTarget{}
+			}
+			if err := m.Target.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -810,12 +1677,80 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReverseLookupRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReverseLookupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -837,7 +1772,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReverseLookupResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -860,15 +1795,47 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReverseLookupResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReverseLookupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = append(m.Name, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -895,7 +1862,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -920,7 +1890,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryChildrenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -943,10 +1913,10 @@ func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryResolveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChildrenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryResolveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChildrenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -981,6 +1951,42 @@ func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1002,7 +2008,7 @@ func (m *QueryResolveRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryChildrenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1025,15 +2031,15 @@ func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryResolveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChildrenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryResolveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChildrenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1061,7 +2067,43 @@ func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1084,7 +2126,7 @@ func (m *QueryResolveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReverseLookupTargetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1107,15 +2149,34 @@ func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReverseLookupRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReverseLookupTargetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReverseLookupRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReverseLookupTargetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= TargetType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1143,9 +2204,9 @@ func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1202,7 +2263,7 @@ func (m *QueryReverseLookupRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReverseLookupResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReverseLookupTargetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1225,15 +2286,15 @@ func (m *QueryReverseLookupResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReverseLookupResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReverseLookupTargetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReverseLookupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReverseLookupTargetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1261,7 +2322,7 @@ func (m *QueryReverseLookupResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = append(m.Name, string(dAtA[iNdEx:postIndex]))
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -22,6 +22,12 @@ type NameMsgParams struct {
 	Bind *BindNameParams `json:"bind_name,omitempty"`
 	// Encode a MsgUnBindName
 	Delete *DeleteNameParams `json:"delete_name,omitempty"`
+	// Encode a MsgTransferNameRequest
+	Transfer *TransferNameParams `json:"transfer_name,omitempty"`
+	// Encode a MsgModifyNameRequest
+	Modify *ModifyNameParams `json:"modify_name,omitempty"`
+	// Encode a MsgRenewNameRequest
+	Renew *RenewNameParams `json:"renew_name,omitempty"`
 }
 
 // BindNameParams are params for encoding a MsgBindName.
@@ -32,6 +38,8 @@ type BindNameParams struct {
 	Address string `json:"address"`
 	// Whether to restrict binding child names to the owner
 	Restrict bool `json:"restrict"`
+	// The typed target the name resolves to in place of the address, the address remains the owner
+	Target *NameTarget `json:"target,omitempty"`
 }
 
 // DeleteNameParams are params for encoding a MsgDeleteNameRequest.
@@ -40,6 +48,28 @@ type DeleteNameParams struct {
 	Name string `json:"name"`
 }
 
+// TransferNameParams are params for encoding a MsgTransferNameRequest.
+type TransferNameParams struct {
+	// The name bound to the contract address to transfer.
+	Name string `json:"name"`
+	// The address to bind the name to.
+	NewOwner string `json:"new_owner"`
+}
+
+// ModifyNameParams are params for encoding a MsgModifyNameRequest.
+type ModifyNameParams struct {
+	// The name bound to the contract address to modify.
+	Name string `json:"name"`
+	// Whether to restrict binding child names to the owner
+	Restrict bool `json:"restrict"`
+}
+
+// RenewNameParams are params for encoding a MsgRenewNameRequest.
+type RenewNameParams struct {
+	// The name bound to the contract address to renew, the contract pays the renewal fee.
+	Name string `json:"name"`
+}
+
 // Encoder returns a smart contract message encoder for the name module.
 func Encoder(contract sdk.AccAddress, msg json.RawMessage, version string) ([]sdk.Msg, error) {
 	wrapper := struct {
@@ -57,6 +87,12 @@ func Encoder(contract sdk.AccAddress, msg json.RawMessage, version string) ([]sd
 		return params.Bind.Encode(contract)
 	case params.Delete != nil:
 		return params.Delete.Encode(contract)
+	case params.Transfer != nil:
+		return params.Transfer.Encode(contract)
+	case params.Modify != nil:
+		return params.Modify.Encode(contract)
+	case params.Renew != nil:
+		return params.Renew.Encode(contract)
 	default:
 		return nil, fmt.Errorf("wasm: invalid name encode request: %s", string(msg))
 	}
@@ -77,6 +113,11 @@ func (params *BindNameParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error)
 	}
 	// Create message request
 	record := types.NewNameRecord(names[0], address, params.Restrict)
+	if params.Target != nil {
+		if record.Target, err = params.Target.toNameTarget(); err != nil {
+			return nil, fmt.Errorf("wasm: invalid bind target: %w", err)
+		}
+	}
 	parent := types.NewNameRecord(names[1], contract, false)
 	msg := types.NewMsgBindNameRequest(record, parent)
 	return []sdk.Msg{msg}, nil
@@ -88,3 +129,28 @@ func (params *DeleteNameParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, erro
 	msg := types.NewMsgDeleteNameRequest(record)
 	return []sdk.Msg{msg}, nil
 }
+
+// Encode creates a MsgTransferNameRequest.
+// The name must be bound to the contract address.
+func (params *TransferNameParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	newOwner, err := sdk.AccAddressFromBech32(params.NewOwner)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid new owner address: %w", err)
+	}
+	msg := types.NewMsgTransferNameRequest(params.Name, contract, newOwner)
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgModifyNameRequest.
+// The name must be bound to the contract address.
+func (params *ModifyNameParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	msg := types.NewMsgModifyNameRequest(params.Name, contract, params.Restrict)
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgRenewNameRequest.
+// The name must be bound to the contract address.
+func (params *RenewNameParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	msg := types.NewMsgRenewNameRequest(params.Name, contract)
+	return []sdk.Msg{msg}, nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/provenance-io/provenance/x/name/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // NameQueryParams represents the request type for the name module sent by a smart contracts.
@@ -19,6 +20,10 @@ type NameQueryParams struct {
 	Resolve *ResolveQueryParams `json:"resolve,omitempty"`
 	// Lookup all names an address is bound to.
 	Lookup *LookupQueryParams `json:"lookup,omitempty"`
+	// List the names bound directly below a name.
+	Children *ChildrenQueryParams `json:"children,omitempty"`
+	// Lookup all names that resolve to a typed target.
+	LookupTarget *LookupTargetQueryParams `json:"lookup_target,omitempty"`
 }
 
 // ResolveQueryParams are the inputs for a resolve name query.
@@ -33,6 +38,20 @@ type LookupQueryParams struct {
 	Address string `json:"address"`
 }
 
+// ChildrenQueryParams are the inputs for a children query.
+type ChildrenQueryParams struct {
+	// The name to list the child names of.
+	Name string `json:"name"`
+	// The page of child names to return, all child names are returned when not set.
+	Pagination *PageRequest `json:"pagination,omitempty"`
+}
+
+// LookupTargetQueryParams are the inputs for a lookup target query.
+type LookupTargetQueryParams struct {
+	// Find all names that resolve to this target.
+	Target NameTarget `json:"target"`
+}
+
 // Querier returns a smart contract querier for the name module.
 func Querier(keeper keeper.Keeper) provwasm.Querier {
 	return func(ctx sdk.Context, query json.RawMessage, version string) ([]byte, error) {
@@ -51,6 +70,10 @@ func Querier(keeper keeper.Keeper) provwasm.Querier {
 			return params.Resolve.Run(ctx, keeper)
 		case params.Lookup != nil:
 			return params.Lookup.Run(ctx, keeper)
+		case params.Children != nil:
+			return params.Children.Run(ctx, keeper)
+		case params.LookupTarget != nil:
+			return params.LookupTarget.Run(ctx, keeper)
 		default:
 			return nil, fmt.Errorf("wasm: invalid name query: %s", string(query))
 		}
@@ -79,8 +102,75 @@ func (params *LookupQueryParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]b
 	return createResponse(records)
 }
 
+// Run lists the names bound directly below a name.
+func (params *ChildrenQueryParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	if p := params.Pagination; p != nil {
+		page := &query.PageRequest{Key: p.Key, Offset: p.Offset, Limit: p.Limit}
+		records, pageRes, err := childRecords(ctx, keeper, params.Name, page)
+		if err != nil {
+			return nil, err
+		}
+		rep := createResponseType(records)
+		if pageRes != nil {
+			rep.Pagination = &PageResponse{NextKey: pageRes.NextKey}
+		}
+		return marshalResponse(rep)
+	}
+	// Without pagination the children are read a page at a time until all child names have been returned.
+	records := types.NameRecords{}
+	page := &query.PageRequest{}
+	for {
+		pageRecords, pageRes, err := childRecords(ctx, keeper, params.Name, page)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, pageRecords...)
+		if pageRes == nil || len(pageRes.NextKey) == 0 {
+			return createResponse(records)
+		}
+		page = &query.PageRequest{Key: pageRes.NextKey}
+	}
+}
+
+// childRecords gets a page of the records bound directly below a name.
+func childRecords(
+	ctx sdk.Context, keeper keeper.Keeper, name string, page *query.PageRequest,
+) (types.NameRecords, *query.PageResponse, error) {
+	res, err := keeper.Children(sdk.WrapSDKContext(ctx), &types.QueryChildrenRequest{Name: name, Pagination: page})
+	if err != nil {
+		return nil, nil, fmt.Errorf("wasm: children query failed: %w", err)
+	}
+	records := types.NameRecords{}
+	for _, child := range res.Names {
+		record, err := keeper.GetRecordByName(ctx, child)
+		if err != nil {
+			return nil, nil, fmt.Errorf("wasm: children query failed: %w", err)
+		}
+		records = append(records, *record)
+	}
+	return records, res.Pagination, nil
+}
+
+// Run looks up all names that resolve to a typed target.
+func (params *LookupTargetQueryParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	target, err := params.Target.toNameTarget()
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid target: %w", err)
+	}
+	records, err := keeper.GetRecordsByTarget(ctx, *target)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: lookup target query failed: %w", err)
+	}
+	return createResponse(records)
+}
+
 // A helper function for converting name module record types into local query response types.
 func createResponse(records types.NameRecords) ([]byte, error) {
+	return marshalResponse(createResponseType(records))
+}
+
+// A helper function for converting name module record types into the local query response type.
+func createResponseType(records types.NameRecords) *QueryResNames {
 	rep := &QueryResNames{}
 	for _, r := range records {
 		rep.Records = append(
@@ -89,12 +179,41 @@ func createResponse(records types.NameRecords) ([]byte, error) {
 				Name:       r.Name,
 				Address:    r.Address,
 				Restricted: r.Restricted,
+				Target:     fromNameTarget(r.Target),
 			},
 		)
 	}
+	return rep
+}
+
+// A helper function for encoding query responses.
+func marshalResponse(rep *QueryResNames) ([]byte, error) {
 	bz, err := json.Marshal(rep)
 	if err != nil {
 		return nil, fmt.Errorf("wasm: marshal response failed: %w", err)
 	}
 	return bz, nil
 }
+
+// A helper function for converting a name module target into the local target type.
+func fromNameTarget(target *types.NameTarget) *NameTarget {
+	if target == nil {
+		return nil
+	}
+	targetType := map[types.TargetType]string{
+		types.TargetTypeAccount:            "account",
+		types.TargetTypeScope:              "scope",
+		types.TargetTypeScopeSpecification: "scopespec",
+		types.TargetTypeMarker:             "marker",
+	}[target.Type]
+	return &NameTarget{Type: targetType, Value: target.Value}
+}
+
+// A helper function for converting a local target into the name module target type.
+func (target NameTarget) toNameTarget() (*types.NameTarget, error) {
+	targetType, err := types.TargetTypeFromString(target.Type)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewNameTarget(targetType, target.Value), nil
+}
@@ -0,0 +1,56 @@
+package wasm_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/name/wasm"
+)
+
+func TestChildrenQuery(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	owner := sdk.AccAddress("owner_______________")
+	require.NoError(t, app.NameKeeper.SetNameRecord(ctx, "parent", owner, false))
+	// more children than the default page size of a paginated query.
+	for i := 0; i < 150; i++ {
+		require.NoError(t, app.NameKeeper.SetNameRecord(ctx, fmt.Sprintf("child%03d.parent", i), owner, false))
+	}
+	querier := wasm.Querier(app.NameKeeper)
+	children := func(pagination string) wasm.QueryResNames {
+		params := `{"name":"parent"}`
+		if pagination != "" {
+			params = `{"name":"parent","pagination":` + pagination + `}`
+		}
+		bz, err := querier(ctx, json.RawMessage(`{"name":{"children":`+params+`}}`), "")
+		require.NoError(t, err)
+		var res wasm.QueryResNames
+		require.NoError(t, json.Unmarshal(bz, &res))
+		return res
+	}
+
+	res := children("")
+	require.Len(t, res.Records, 150, "all children are returned without pagination")
+	require.Nil(t, res.Pagination)
+	require.Equal(t, "child000.parent", res.Records[0].Name)
+	require.Equal(t, "child149.parent", res.Records[149].Name)
+
+	res = children(`{"limit":100}`)
+	require.Len(t, res.Records, 100)
+	require.NotNil(t, res.Pagination)
+	require.NotEmpty(t, res.Pagination.NextKey)
+	nextKey, err := json.Marshal(res.Pagination.NextKey)
+	require.NoError(t, err)
+	res = children(`{"key":` + string(nextKey) + `}`)
+	require.Len(t, res.Records, 50)
+	require.Equal(t, "child100.parent", res.Records[0].Name)
+	require.NotNil(t, res.Pagination)
+	require.Empty(t, res.Pagination.NextKey)
+}
@@ -6,9 +6,38 @@ type QueryResName struct {
 	Name       string `json:"name"`
 	Address    string `json:"address"`
 	Restricted bool   `json:"restricted"`
+	// Set when the name resolves to a typed target in place of the address.
+	Target *NameTarget `json:"target,omitempty"`
+}
+
+// NameTarget is a typed value a name resolves to in place of its owner.
+type NameTarget struct {
+	// One of account, scope, scopespec or marker
+	Type string `json:"type"`
+	// The bech32 address or marker denom
+	Value string `json:"value"`
 }
 
 // QueryResNames contains a sequence of name records.
 type QueryResNames struct {
 	Records []QueryResName `json:"records,omitempty"`
+	// Set for paginated queries only.
+	Pagination *PageResponse `json:"pagination,omitempty"`
+}
+
+// PageRequest selects a page of results from a query.  Either the key returned with the previous page or an offset
+// may be set.
+type PageRequest struct {
+	// The next_key returned with the previous page
+	Key []byte `json:"key,omitempty"`
+	// The number of results to skip
+	Offset uint64 `json:"offset,omitempty"`
+	// The maximum number of results to return
+	Limit uint64 `json:"limit,omitempty"`
+}
+
+// PageResponse describes the page of results returned by a query.
+type PageResponse struct {
+	// The key for the next page of results, empty on the last page
+	NextKey []byte `json:"next_key,omitempty"`
 }